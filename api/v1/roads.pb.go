@@ -248,6 +248,59 @@ func (CongestionLevel) EnumDescriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{3}
 }
 
+// TrafficSpeed is Google's traffic speed category for a route segment.
+type TrafficSpeed int32
+
+const (
+	TrafficSpeed_TRAFFIC_SPEED_UNSPECIFIED TrafficSpeed = 0
+	TrafficSpeed_SPEED_NORMAL              TrafficSpeed = 1
+	TrafficSpeed_SPEED_SLOW                TrafficSpeed = 2
+	TrafficSpeed_SPEED_TRAFFIC_JAM         TrafficSpeed = 3
+)
+
+// Enum value maps for TrafficSpeed.
+var (
+	TrafficSpeed_name = map[int32]string{
+		0: "TRAFFIC_SPEED_UNSPECIFIED",
+		1: "SPEED_NORMAL",
+		2: "SPEED_SLOW",
+		3: "SPEED_TRAFFIC_JAM",
+	}
+	TrafficSpeed_value = map[string]int32{
+		"TRAFFIC_SPEED_UNSPECIFIED": 0,
+		"SPEED_NORMAL":              1,
+		"SPEED_SLOW":                2,
+		"SPEED_TRAFFIC_JAM":         3,
+	}
+)
+
+func (x TrafficSpeed) Enum() *TrafficSpeed {
+	p := new(TrafficSpeed)
+	*p = x
+	return p
+}
+
+func (x TrafficSpeed) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficSpeed) Descriptor() protoreflect.EnumDescriptor {
+	return file_roads_proto_enumTypes[4].Descriptor()
+}
+
+func (TrafficSpeed) Type() protoreflect.EnumType {
+	return &file_roads_proto_enumTypes[4]
+}
+
+func (x TrafficSpeed) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficSpeed.Descriptor instead.
+func (TrafficSpeed) EnumDescriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{4}
+}
+
 type AlertType int32
 
 const (
@@ -287,11 +340,11 @@ func (x AlertType) String() string {
 }
 
 func (AlertType) Descriptor() protoreflect.EnumDescriptor {
-	return file_roads_proto_enumTypes[4].Descriptor()
+	return file_roads_proto_enumTypes[5].Descriptor()
 }
 
 func (AlertType) Type() protoreflect.EnumType {
-	return &file_roads_proto_enumTypes[4]
+	return &file_roads_proto_enumTypes[5]
 }
 
 func (x AlertType) Number() protoreflect.EnumNumber {
@@ -300,7 +353,7 @@ func (x AlertType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertType.Descriptor instead.
 func (AlertType) EnumDescriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{4}
+	return file_roads_proto_rawDescGZIP(), []int{5}
 }
 
 type AlertClassification int32
@@ -339,11 +392,11 @@ func (x AlertClassification) String() string {
 }
 
 func (AlertClassification) Descriptor() protoreflect.EnumDescriptor {
-	return file_roads_proto_enumTypes[5].Descriptor()
+	return file_roads_proto_enumTypes[6].Descriptor()
 }
 
 func (AlertClassification) Type() protoreflect.EnumType {
-	return &file_roads_proto_enumTypes[5]
+	return &file_roads_proto_enumTypes[6]
 }
 
 func (x AlertClassification) Number() protoreflect.EnumNumber {
@@ -352,7 +405,7 @@ func (x AlertClassification) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertClassification.Descriptor instead.
 func (AlertClassification) EnumDescriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{5}
+	return file_roads_proto_rawDescGZIP(), []int{6}
 }
 
 // Request messages
@@ -360,6 +413,27 @@ type ListRoadsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Maximum roads per page. 0 (the default) returns every road in configured
+	// order; any positive value pages through roads sorted by id.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return roads in these states (e.g. ?status_filter=CLOSED&status_filter=RESTRICTED).
+	// Empty returns roads in every state.
+	StatusFilter []RoadStatus `protobuf:"varint,3,rep,packed,name=status_filter,json=statusFilter,proto3,enum=api.v1.RoadStatus" json:"status_filter,omitempty"`
+	// Only return alerts at least this severe (e.g. ?min_severity=CRITICAL).
+	// Unspecified returns alerts of every severity.
+	MinSeverity AlertSeverity `protobuf:"varint,4,opt,name=min_severity,json=minSeverity,proto3,enum=api.v1.AlertSeverity" json:"min_severity,omitempty"`
+	// Only return alerts with these classifications (e.g. ?classification=ON_ROUTE).
+	// Empty returns alerts of every classification.
+	Classification []AlertClassification `protobuf:"varint,5,rep,packed,name=classification,proto3,enum=api.v1.AlertClassification" json:"classification,omitempty"`
+	// "metric" (default) or "imperial": the unit system of each road's
+	// converted distances (Road.distance, RoadAlert.distance_to_route).
+	Units string `protobuf:"bytes,6,opt,name=units,proto3" json:"units,omitempty"`
+	// Only return roads whose data changed after this time (Road.changed_at),
+	// e.g. the server_time of the previous poll. Unset returns every road.
+	Since *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"`
 }
 
 func (x *ListRoadsRequest) Reset() {
@@ -394,12 +468,65 @@ func (*ListRoadsRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *ListRoadsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoadsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRoadsRequest) GetStatusFilter() []RoadStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListRoadsRequest) GetMinSeverity() AlertSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+}
+
+func (x *ListRoadsRequest) GetClassification() []AlertClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+func (x *ListRoadsRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *ListRoadsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
 type GetRoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RoadId string `protobuf:"bytes,1,opt,name=road_id,json=roadId,proto3" json:"road_id,omitempty"`
+	// Alert filters and units, as on ListRoadsRequest
+	MinSeverity    AlertSeverity         `protobuf:"varint,2,opt,name=min_severity,json=minSeverity,proto3,enum=api.v1.AlertSeverity" json:"min_severity,omitempty"`
+	Classification []AlertClassification `protobuf:"varint,3,rep,packed,name=classification,proto3,enum=api.v1.AlertClassification" json:"classification,omitempty"`
+	Units          string                `protobuf:"bytes,4,opt,name=units,proto3" json:"units,omitempty"`
 }
 
 func (x *GetRoadRequest) Reset() {
@@ -441,14 +568,37 @@ func (x *GetRoadRequest) GetRoadId() string {
 	return ""
 }
 
-type GetProcessingMetricsRequest struct {
+func (x *GetRoadRequest) GetMinSeverity() AlertSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+}
+
+func (x *GetRoadRequest) GetClassification() []AlertClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+func (x *GetRoadRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+type GetRouteGeometryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RoadId string `protobuf:"bytes,1,opt,name=road_id,json=roadId,proto3" json:"road_id,omitempty"`
 }
 
-func (x *GetProcessingMetricsRequest) Reset() {
-	*x = GetProcessingMetricsRequest{}
+func (x *GetRouteGeometryRequest) Reset() {
+	*x = GetRouteGeometryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,13 +606,13 @@ func (x *GetProcessingMetricsRequest) Reset() {
 	}
 }
 
-func (x *GetProcessingMetricsRequest) String() string {
+func (x *GetRouteGeometryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProcessingMetricsRequest) ProtoMessage() {}
+func (*GetRouteGeometryRequest) ProtoMessage() {}
 
-func (x *GetProcessingMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRouteGeometryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -474,22 +624,28 @@ func (x *GetProcessingMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProcessingMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetProcessingMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRouteGeometryRequest.ProtoReflect.Descriptor instead.
+func (*GetRouteGeometryRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{2}
 }
 
-// ListIncidentsRequest selects the configured area whose incidents to return.
-type ListIncidentsRequest struct {
+func (x *GetRouteGeometryRequest) GetRoadId() string {
+	if x != nil {
+		return x.RoadId
+	}
+	return ""
+}
+
+type GetCorridorStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"` // Area id path param (e.g. "mother-lode"). Unknown id -> 404.
+	RoadId string `protobuf:"bytes,1,opt,name=road_id,json=roadId,proto3" json:"road_id,omitempty"`
 }
 
-func (x *ListIncidentsRequest) Reset() {
-	*x = ListIncidentsRequest{}
+func (x *GetCorridorStatusRequest) Reset() {
+	*x = GetCorridorStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +653,13 @@ func (x *ListIncidentsRequest) Reset() {
 	}
 }
 
-func (x *ListIncidentsRequest) String() string {
+func (x *GetCorridorStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIncidentsRequest) ProtoMessage() {}
+func (*GetCorridorStatusRequest) ProtoMessage() {}
 
-func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCorridorStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,30 +671,26 @@ func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
-func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCorridorStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCorridorStatusRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListIncidentsRequest) GetArea() string {
+func (x *GetCorridorStatusRequest) GetRoadId() string {
 	if x != nil {
-		return x.Area
+		return x.RoadId
 	}
 	return ""
 }
 
-// Response messages
-type ListRoadsResponse struct {
+type GetProcessingMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Roads       []*Road                `protobuf:"bytes,1,rep,name=roads,proto3" json:"roads,omitempty"`
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 }
 
-func (x *ListRoadsResponse) Reset() {
-	*x = ListRoadsResponse{}
+func (x *GetProcessingMetricsRequest) Reset() {
+	*x = GetProcessingMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,13 +698,13 @@ func (x *ListRoadsResponse) Reset() {
 	}
 }
 
-func (x *ListRoadsResponse) String() string {
+func (x *GetProcessingMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoadsResponse) ProtoMessage() {}
+func (*GetProcessingMetricsRequest) ProtoMessage() {}
 
-func (x *ListRoadsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProcessingMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -564,36 +716,22 @@ func (x *ListRoadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoadsResponse.ProtoReflect.Descriptor instead.
-func (*ListRoadsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProcessingMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessingMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListRoadsResponse) GetRoads() []*Road {
-	if x != nil {
-		return x.Roads
-	}
-	return nil
-}
-
-func (x *ListRoadsResponse) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
-}
-
-type GetRoadResponse struct {
+// ListIncidentsRequest selects the configured area whose incidents to return.
+type ListIncidentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Road        *Road                  `protobuf:"bytes,1,opt,name=road,proto3" json:"road,omitempty"`
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"` // Area id path param (e.g. "mother-lode"). Unknown id -> 404.
 }
 
-func (x *GetRoadResponse) Reset() {
-	*x = GetRoadResponse{}
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,13 +739,13 @@ func (x *GetRoadResponse) Reset() {
 	}
 }
 
-func (x *GetRoadResponse) String() string {
+func (x *ListIncidentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoadResponse) ProtoMessage() {}
+func (*ListIncidentsRequest) ProtoMessage() {}
 
-func (x *GetRoadResponse) ProtoReflect() protoreflect.Message {
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,37 +757,26 @@ func (x *GetRoadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoadResponse.ProtoReflect.Descriptor instead.
-func (*GetRoadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetRoadResponse) GetRoad() *Road {
-	if x != nil {
-		return x.Road
-	}
-	return nil
-}
-
-func (x *GetRoadResponse) GetLastUpdated() *timestamppb.Timestamp {
+func (x *ListIncidentsRequest) GetArea() string {
 	if x != nil {
-		return x.LastUpdated
+		return x.Area
 	}
-	return nil
+	return ""
 }
 
-type ListIncidentsResponse struct {
+type ListAllAlertsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Incidents   []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	Area        string                 `protobuf:"bytes,3,opt,name=area,proto3" json:"area,omitempty"` // Echoes the resolved area id
 }
 
-func (x *ListIncidentsResponse) Reset() {
-	*x = ListIncidentsResponse{}
+func (x *ListAllAlertsRequest) Reset() {
+	*x = ListAllAlertsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,13 +784,13 @@ func (x *ListIncidentsResponse) Reset() {
 	}
 }
 
-func (x *ListIncidentsResponse) String() string {
+func (x *ListAllAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIncidentsResponse) ProtoMessage() {}
+func (*ListAllAlertsRequest) ProtoMessage() {}
 
-func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListAllAlertsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -675,54 +802,19 @@ func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
-func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAllAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllAlertsRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListIncidentsResponse) GetIncidents() []*Incident {
-	if x != nil {
-		return x.Incidents
-	}
-	return nil
-}
-
-func (x *ListIncidentsResponse) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
-}
-
-func (x *ListIncidentsResponse) GetArea() string {
-	if x != nil {
-		return x.Area
-	}
-	return ""
-}
-
-// Incident is a region-wide CHP/Caltrans dispatch incident, surfaced
-// independently of any monitored road.
-type Incident struct {
+type StreamRoadUpdatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // Stable id (CHP log number when available)
-	Type                AlertType              `protobuf:"varint,2,opt,name=type,proto3,enum=api.v1.AlertType" json:"type,omitempty"`                                   // INCIDENT, CLOSURE, CONSTRUCTION
-	Severity            AlertSeverity          `protobuf:"varint,3,opt,name=severity,proto3,enum=api.v1.AlertSeverity" json:"severity,omitempty"`                       // Heuristic severity from incident text
-	Location            *Coordinates           `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                                                  // Point location
-	LocationDescription string                 `protobuf:"bytes,5,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty"` // Human-readable location (e.g. "Sr132 Ofr / I580 E")
-	Description         string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                            // Incident detail text from the feed
-	Status              IncidentStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=api.v1.IncidentStatus" json:"status,omitempty"`                          // Lifecycle state (feeds only list active incidents)
-	LogNumber           string                 `protobuf:"bytes,8,opt,name=log_number,json=logNumber,proto3" json:"log_number,omitempty"`                               // CHP/CAD log number (e.g. "250916ST0066")
-	Started             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started,proto3" json:"started,omitempty"`                                                    // When the incident was first reported
-	LastUpdated         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`                        // Last update from the feed
-	Area                string                 `protobuf:"bytes,11,opt,name=area,proto3" json:"area,omitempty"`                                                         // Area id this incident was matched to
 }
 
-func (x *Incident) Reset() {
-	*x = Incident{}
+func (x *StreamRoadUpdatesRequest) Reset() {
+	*x = StreamRoadUpdatesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roads_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,13 +822,13 @@ func (x *Incident) Reset() {
 	}
 }
 
-func (x *Incident) String() string {
+func (x *StreamRoadUpdatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Incident) ProtoMessage() {}
+func (*StreamRoadUpdatesRequest) ProtoMessage() {}
 
-func (x *Incident) ProtoReflect() protoreflect.Message {
+func (x *StreamRoadUpdatesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roads_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,104 +840,999 @@ func (x *Incident) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
-func (*Incident) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamRoadUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*StreamRoadUpdatesRequest) Descriptor() ([]byte, []int) {
 	return file_roads_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Incident) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type GetServiceHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Incident) GetType() AlertType {
-	if x != nil {
-		return x.Type
+func (x *GetServiceHealthRequest) Reset() {
+	*x = GetServiceHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHealthRequest) ProtoMessage() {}
+
+func (x *GetServiceHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceHealthRequest) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{8}
+}
+
+// Response messages
+type ListRoadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roads         []*Road                `protobuf:"bytes,1,rep,name=roads,proto3" json:"roads,omitempty"`
+	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Set when more roads remain; pass back as page_token
+	ServerTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`            // When the served roads were cached; pass back as `since` on the next poll
+}
+
+func (x *ListRoadsResponse) Reset() {
+	*x = ListRoadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoadsResponse) ProtoMessage() {}
+
+func (x *ListRoadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoadsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoadsResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRoadsResponse) GetRoads() []*Road {
+	if x != nil {
+		return x.Roads
+	}
+	return nil
+}
+
+func (x *ListRoadsResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *ListRoadsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRoadsResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+type GetRoadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Road        *Road                  `protobuf:"bytes,1,opt,name=road,proto3" json:"road,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *GetRoadResponse) Reset() {
+	*x = GetRoadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoadResponse) ProtoMessage() {}
+
+func (x *GetRoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoadResponse.ProtoReflect.Descriptor instead.
+func (*GetRoadResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRoadResponse) GetRoad() *Road {
+	if x != nil {
+		return x.Road
+	}
+	return nil
+}
+
+func (x *GetRoadResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type GetCorridorStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Road *Road `protobuf:"bytes,1,opt,name=road,proto3" json:"road,omitempty"` // Status, congestion and chain control
+	// Current weather at the configured weather location nearest the road's
+	// midpoint, or at the midpoint itself when none is close. Its alerts are the
+	// active weather alerts there. Unset when weather is unavailable.
+	Weather     *WeatherData           `protobuf:"bytes,2,opt,name=weather,proto3" json:"weather,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"` // When the road data was refreshed
+}
+
+func (x *GetCorridorStatusResponse) Reset() {
+	*x = GetCorridorStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCorridorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCorridorStatusResponse) ProtoMessage() {}
+
+func (x *GetCorridorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCorridorStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCorridorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCorridorStatusResponse) GetRoad() *Road {
+	if x != nil {
+		return x.Road
+	}
+	return nil
+}
+
+func (x *GetCorridorStatusResponse) GetWeather() *WeatherData {
+	if x != nil {
+		return x.Weather
+	}
+	return nil
+}
+
+func (x *GetCorridorStatusResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type GetRouteGeometryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoadId          string                 `protobuf:"bytes,1,opt,name=road_id,json=roadId,proto3" json:"road_id,omitempty"`
+	EncodedPolyline string                 `protobuf:"bytes,2,opt,name=encoded_polyline,json=encodedPolyline,proto3" json:"encoded_polyline,omitempty"` // Google encoded polyline of the route (straight origin->destination line when Google Routes is unavailable)
+	Origin          *Coordinates           `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination     *Coordinates           `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	Alerts          []*AlertGeometry       `protobuf:"bytes,5,rep,name=alerts,proto3" json:"alerts,omitempty"` // Alerts classified against this road (ON_ROUTE and NEARBY)
+	LastUpdated     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	TrafficSegments []*TrafficSegment      `protobuf:"bytes,7,rep,name=traffic_segments,json=trafficSegments,proto3" json:"traffic_segments,omitempty"` // Traffic speed along the polyline; empty unless googleRoutes.speedReadings is enabled
+}
+
+func (x *GetRouteGeometryResponse) Reset() {
+	*x = GetRouteGeometryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRouteGeometryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteGeometryResponse) ProtoMessage() {}
+
+func (x *GetRouteGeometryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteGeometryResponse.ProtoReflect.Descriptor instead.
+func (*GetRouteGeometryResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRouteGeometryResponse) GetRoadId() string {
+	if x != nil {
+		return x.RoadId
+	}
+	return ""
+}
+
+func (x *GetRouteGeometryResponse) GetEncodedPolyline() string {
+	if x != nil {
+		return x.EncodedPolyline
+	}
+	return ""
+}
+
+func (x *GetRouteGeometryResponse) GetOrigin() *Coordinates {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *GetRouteGeometryResponse) GetDestination() *Coordinates {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *GetRouteGeometryResponse) GetAlerts() []*AlertGeometry {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *GetRouteGeometryResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *GetRouteGeometryResponse) GetTrafficSegments() []*TrafficSegment {
+	if x != nil {
+		return x.TrafficSegments
+	}
+	return nil
+}
+
+// AlertGeometry is the spatial footprint of one road alert.
+type AlertGeometry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`       // Matches RoadAlert.id
+	Title                 string              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // Matches RoadAlert.title
+	Classification        AlertClassification `protobuf:"varint,3,opt,name=classification,proto3,enum=api.v1.AlertClassification" json:"classification,omitempty"`
+	Location              *Coordinates        `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	AffectedPolyline      string              `protobuf:"bytes,5,opt,name=affected_polyline,json=affectedPolyline,proto3" json:"affected_polyline,omitempty"` // Encoded polyline of the closure extent; empty for point incidents
+	DistanceToRouteMeters float64             `protobuf:"fixed64,6,opt,name=distance_to_route_meters,json=distanceToRouteMeters,proto3" json:"distance_to_route_meters,omitempty"`
+}
+
+func (x *AlertGeometry) Reset() {
+	*x = AlertGeometry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertGeometry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertGeometry) ProtoMessage() {}
+
+func (x *AlertGeometry) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertGeometry.ProtoReflect.Descriptor instead.
+func (*AlertGeometry) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AlertGeometry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertGeometry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AlertGeometry) GetClassification() AlertClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return AlertClassification_ALERT_CLASSIFICATION_UNSPECIFIED
+}
+
+func (x *AlertGeometry) GetLocation() *Coordinates {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AlertGeometry) GetAffectedPolyline() string {
+	if x != nil {
+		return x.AffectedPolyline
+	}
+	return ""
+}
+
+func (x *AlertGeometry) GetDistanceToRouteMeters() float64 {
+	if x != nil {
+		return x.DistanceToRouteMeters
+	}
+	return 0
+}
+
+// TrafficSegment is the traffic speed over a stretch of the route polyline,
+// for color-coding the route on a map.
+type TrafficSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartIndex int32        `protobuf:"varint,1,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"` // Index of the first point in the decoded encoded_polyline
+	EndIndex   int32        `protobuf:"varint,2,opt,name=end_index,json=endIndex,proto3" json:"end_index,omitempty"`       // Index of the last point in the decoded encoded_polyline
+	Start      *Coordinates `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                              // Polyline point at start_index
+	End        *Coordinates `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                                  // Polyline point at end_index
+	Speed      TrafficSpeed `protobuf:"varint,5,opt,name=speed,proto3,enum=api.v1.TrafficSpeed" json:"speed,omitempty"`
+}
+
+func (x *TrafficSegment) Reset() {
+	*x = TrafficSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSegment) ProtoMessage() {}
+
+func (x *TrafficSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSegment.ProtoReflect.Descriptor instead.
+func (*TrafficSegment) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TrafficSegment) GetStartIndex() int32 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+func (x *TrafficSegment) GetEndIndex() int32 {
+	if x != nil {
+		return x.EndIndex
+	}
+	return 0
+}
+
+func (x *TrafficSegment) GetStart() *Coordinates {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TrafficSegment) GetEnd() *Coordinates {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *TrafficSegment) GetSpeed() TrafficSpeed {
+	if x != nil {
+		return x.Speed
+	}
+	return TrafficSpeed_TRAFFIC_SPEED_UNSPECIFIED
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incidents   []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Area        string                 `protobuf:"bytes,3,opt,name=area,proto3" json:"area,omitempty"` // Echoes the resolved area id
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *ListIncidentsResponse) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+// Incident is a region-wide CHP/Caltrans dispatch incident, surfaced
+// independently of any monitored road.
+type Incident struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // Stable id (CHP log number when available)
+	Type                AlertType              `protobuf:"varint,2,opt,name=type,proto3,enum=api.v1.AlertType" json:"type,omitempty"`                                   // INCIDENT, CLOSURE, CONSTRUCTION
+	Severity            AlertSeverity          `protobuf:"varint,3,opt,name=severity,proto3,enum=api.v1.AlertSeverity" json:"severity,omitempty"`                       // Heuristic severity from incident text
+	Location            *Coordinates           `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                                                  // Point location
+	LocationDescription string                 `protobuf:"bytes,5,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty"` // Human-readable location (e.g. "Sr132 Ofr / I580 E")
+	Description         string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                            // Incident detail text from the feed
+	Status              IncidentStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=api.v1.IncidentStatus" json:"status,omitempty"`                          // Lifecycle state (feeds only list active incidents)
+	LogNumber           string                 `protobuf:"bytes,8,opt,name=log_number,json=logNumber,proto3" json:"log_number,omitempty"`                               // CHP/CAD log number (e.g. "250916ST0066")
+	Started             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started,proto3" json:"started,omitempty"`                                                    // When the incident was first reported
+	LastUpdated         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`                        // Last update from the feed
+	Area                string                 `protobuf:"bytes,11,opt,name=area,proto3" json:"area,omitempty"`                                                         // Area id this incident was matched to
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Incident) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Incident) GetType() AlertType {
+	if x != nil {
+		return x.Type
 	}
 	return AlertType_ALERT_TYPE_UNSPECIFIED
 }
 
-func (x *Incident) GetSeverity() AlertSeverity {
+func (x *Incident) GetSeverity() AlertSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Incident) GetLocation() *Coordinates {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Incident) GetLocationDescription() string {
+	if x != nil {
+		return x.LocationDescription
+	}
+	return ""
+}
+
+func (x *Incident) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Incident) GetStatus() IncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *Incident) GetLogNumber() string {
+	if x != nil {
+		return x.LogNumber
+	}
+	return ""
+}
+
+func (x *Incident) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Incident) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *Incident) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+type ListAllAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts      []*AggregatedRoadAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *ListAllAlertsResponse) Reset() {
+	*x = ListAllAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllAlertsResponse) ProtoMessage() {}
+
+func (x *ListAllAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAllAlertsResponse) GetAlerts() []*AggregatedRoadAlert {
 	if x != nil {
-		return x.Severity
+		return x.Alerts
 	}
-	return AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+	return nil
 }
 
-func (x *Incident) GetLocation() *Coordinates {
+func (x *ListAllAlertsResponse) GetLastUpdated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Location
+		return x.LastUpdated
 	}
 	return nil
 }
 
-func (x *Incident) GetLocationDescription() string {
+// AggregatedRoadAlert is a road alert together with every monitored road it
+// applies to.
+type AggregatedRoadAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alert   *RoadAlert `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`                    // Closest/most direct classification across the roads
+	RoadIds []string   `protobuf:"bytes,2,rep,name=road_ids,json=roadIds,proto3" json:"road_ids,omitempty"` // Roads this alert applies to, in configured order
+}
+
+func (x *AggregatedRoadAlert) Reset() {
+	*x = AggregatedRoadAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregatedRoadAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatedRoadAlert) ProtoMessage() {}
+
+func (x *AggregatedRoadAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatedRoadAlert.ProtoReflect.Descriptor instead.
+func (*AggregatedRoadAlert) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AggregatedRoadAlert) GetAlert() *RoadAlert {
 	if x != nil {
-		return x.LocationDescription
+		return x.Alert
 	}
-	return ""
+	return nil
 }
 
-func (x *Incident) GetDescription() string {
+func (x *AggregatedRoadAlert) GetRoadIds() []string {
 	if x != nil {
-		return x.Description
+		return x.RoadIds
+	}
+	return nil
+}
+
+type GetServiceHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources   []*DataSourceHealth    `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Ready     bool                   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`                         // True once road data is cached and can be served without a blocking refresh
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // When this report was generated
+}
+
+func (x *GetServiceHealthResponse) Reset() {
+	*x = GetServiceHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHealthResponse) ProtoMessage() {}
+
+func (x *GetServiceHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceHealthResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetServiceHealthResponse) GetSources() []*DataSourceHealth {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *GetServiceHealthResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *GetServiceHealthResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// DataSourceHealth is the freshness and error state of one upstream source.
+type DataSourceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                                      // "google_routes", "caltrans", "openweather", or "nws"
+	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`                                   // False when the most recent fetch failed
+	Staleness     string                 `protobuf:"bytes,3,opt,name=staleness,proto3" json:"staleness,omitempty"`                                // Cached data: "fresh", "stale", "very_stale", or "unknown" (nothing cached)
+	LastSuccess   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`         // Last successful fetch (unset if never)
+	LastErrorTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"` // Last failed fetch (unset if never)
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`               // Error message from the last failed fetch
+	Degraded      bool                   `protobuf:"varint,7,opt,name=degraded,proto3" json:"degraded,omitempty"`                                 // True while calls are paused after repeated failures and last-known data is served
+}
+
+func (x *DataSourceHealth) Reset() {
+	*x = DataSourceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceHealth) ProtoMessage() {}
+
+func (x *DataSourceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSourceHealth.ProtoReflect.Descriptor instead.
+func (*DataSourceHealth) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DataSourceHealth) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *Incident) GetStatus() IncidentStatus {
+func (x *DataSourceHealth) GetHealthy() bool {
 	if x != nil {
-		return x.Status
+		return x.Healthy
 	}
-	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
+	return false
 }
 
-func (x *Incident) GetLogNumber() string {
+func (x *DataSourceHealth) GetStaleness() string {
 	if x != nil {
-		return x.LogNumber
+		return x.Staleness
 	}
 	return ""
 }
 
-func (x *Incident) GetStarted() *timestamppb.Timestamp {
+func (x *DataSourceHealth) GetLastSuccess() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Started
+		return x.LastSuccess
 	}
 	return nil
 }
 
-func (x *Incident) GetLastUpdated() *timestamppb.Timestamp {
+func (x *DataSourceHealth) GetLastErrorTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastUpdated
+		return x.LastErrorTime
 	}
 	return nil
 }
 
-func (x *Incident) GetArea() string {
+func (x *DataSourceHealth) GetLastError() string {
 	if x != nil {
-		return x.Area
+		return x.LastError
 	}
 	return ""
 }
 
+func (x *DataSourceHealth) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+// ProcessingMetrics reports road-alert AI enhancement activity since the server
+// started. Cached enhancements (same content within 24h) make no AI call and
+// are not counted. Also reports Google Routes API usage against its daily budget.
 type ProcessingMetrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalRawAlerts      int64   `protobuf:"varint,1,opt,name=total_raw_alerts,json=totalRawAlerts,proto3" json:"total_raw_alerts,omitempty"`
-	FilteredAlerts      int64   `protobuf:"varint,2,opt,name=filtered_alerts,json=filteredAlerts,proto3" json:"filtered_alerts,omitempty"`
-	EnhancedAlerts      int64   `protobuf:"varint,3,opt,name=enhanced_alerts,json=enhancedAlerts,proto3" json:"enhanced_alerts,omitempty"`
-	EnhancementFailures int64   `protobuf:"varint,4,opt,name=enhancement_failures,json=enhancementFailures,proto3" json:"enhancement_failures,omitempty"`
-	AvgProcessingTimeMs float64 `protobuf:"fixed64,5,opt,name=avg_processing_time_ms,json=avgProcessingTimeMs,proto3" json:"avg_processing_time_ms,omitempty"`
+	TotalRawAlerts              int64   `protobuf:"varint,1,opt,name=total_raw_alerts,json=totalRawAlerts,proto3" json:"total_raw_alerts,omitempty"`                                           // Not tracked yet (always 0)
+	FilteredAlerts              int64   `protobuf:"varint,2,opt,name=filtered_alerts,json=filteredAlerts,proto3" json:"filtered_alerts,omitempty"`                                             // Not tracked yet (always 0)
+	EnhancedAlerts              int64   `protobuf:"varint,3,opt,name=enhanced_alerts,json=enhancedAlerts,proto3" json:"enhanced_alerts,omitempty"`                                             // Successful AI enhancement calls
+	EnhancementFailures         int64   `protobuf:"varint,4,opt,name=enhancement_failures,json=enhancementFailures,proto3" json:"enhancement_failures,omitempty"`                              // Failed AI enhancement calls
+	AvgProcessingTimeMs         float64 `protobuf:"fixed64,5,opt,name=avg_processing_time_ms,json=avgProcessingTimeMs,proto3" json:"avg_processing_time_ms,omitempty"`                         // Mean duration of an enhancement call
+	PromptTokens                int64   `protobuf:"varint,6,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`                                                   // Cumulative LLM prompt tokens
+	CompletionTokens            int64   `protobuf:"varint,7,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`                                       // Cumulative LLM completion tokens
+	EstimatedCostUsd            float64 `protobuf:"fixed64,8,opt,name=estimated_cost_usd,json=estimatedCostUsd,proto3" json:"estimated_cost_usd,omitempty"`                                    // From configured per-1k token pricing (0 if unpriced)
+	JsonRepairs                 int64   `protobuf:"varint,9,opt,name=json_repairs,json=jsonRepairs,proto3" json:"json_repairs,omitempty"`                                                      // Responses that only parsed after stripping fences/prose
+	P95ProcessingTimeMs         float64 `protobuf:"fixed64,10,opt,name=p95_processing_time_ms,json=p95ProcessingTimeMs,proto3" json:"p95_processing_time_ms,omitempty"`                        // 95th percentile enhancement call duration over the last 200 calls
+	CacheHits_24H               int64   `protobuf:"varint,11,opt,name=cache_hits_24h,json=cacheHits24h,proto3" json:"cache_hits_24h,omitempty"`                                                // Enhanced-alert cache lookups served from cache, last 24h
+	CacheMisses_24H             int64   `protobuf:"varint,12,opt,name=cache_misses_24h,json=cacheMisses24h,proto3" json:"cache_misses_24h,omitempty"`                                          // Enhanced-alert cache lookups that needed an AI call, last 24h
+	CacheHitRate_24H            float64 `protobuf:"fixed64,13,opt,name=cache_hit_rate_24h,json=cacheHitRate24h,proto3" json:"cache_hit_rate_24h,omitempty"`                                    // cache_hits_24h / (hits + misses); 0 with no lookups
+	CachedEnhancements          int64   `protobuf:"varint,14,opt,name=cached_enhancements,json=cachedEnhancements,proto3" json:"cached_enhancements,omitempty"`                                // Fresh enhanced alerts currently cached
+	GoogleRoutesCalls_24H       int64   `protobuf:"varint,15,opt,name=google_routes_calls_24h,json=googleRoutesCalls24h,proto3" json:"google_routes_calls_24h,omitempty"`                      // Google Routes API calls, last 24h
+	GoogleRoutesBudgetRemaining int64   `protobuf:"varint,16,opt,name=google_routes_budget_remaining,json=googleRoutesBudgetRemaining,proto3" json:"google_routes_budget_remaining,omitempty"` // Calls left in googleRoutes.dailyCallBudget; -1 when unlimited
 }
 
 func (x *ProcessingMetrics) Reset() {
 	*x = ProcessingMetrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roads_proto_msgTypes[8]
+		mi := &file_roads_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -858,7 +1845,7 @@ func (x *ProcessingMetrics) String() string {
 func (*ProcessingMetrics) ProtoMessage() {}
 
 func (x *ProcessingMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_roads_proto_msgTypes[8]
+	mi := &file_roads_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1858,7 @@ func (x *ProcessingMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessingMetrics.ProtoReflect.Descriptor instead.
 func (*ProcessingMetrics) Descriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{8}
+	return file_roads_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ProcessingMetrics) GetTotalRawAlerts() int64 {
@@ -909,6 +1896,83 @@ func (x *ProcessingMetrics) GetAvgProcessingTimeMs() float64 {
 	return 0
 }
 
+func (x *ProcessingMetrics) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetEstimatedCostUsd() float64 {
+	if x != nil {
+		return x.EstimatedCostUsd
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetJsonRepairs() int64 {
+	if x != nil {
+		return x.JsonRepairs
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetP95ProcessingTimeMs() float64 {
+	if x != nil {
+		return x.P95ProcessingTimeMs
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetCacheHits_24H() int64 {
+	if x != nil {
+		return x.CacheHits_24H
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetCacheMisses_24H() int64 {
+	if x != nil {
+		return x.CacheMisses_24H
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetCacheHitRate_24H() float64 {
+	if x != nil {
+		return x.CacheHitRate_24H
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetCachedEnhancements() int64 {
+	if x != nil {
+		return x.CachedEnhancements
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetGoogleRoutesCalls_24H() int64 {
+	if x != nil {
+		return x.GoogleRoutesCalls_24H
+	}
+	return 0
+}
+
+func (x *ProcessingMetrics) GetGoogleRoutesBudgetRemaining() int64 {
+	if x != nil {
+		return x.GoogleRoutesBudgetRemaining
+	}
+	return 0
+}
+
 // Data models
 type Road struct {
 	state         protoimpl.MessageState
@@ -927,12 +1991,19 @@ type Road struct {
 	ChainControl      ChainControlStatus `protobuf:"varint,10,opt,name=chain_control,json=chainControl,proto3,enum=api.v1.ChainControlStatus" json:"chain_control,omitempty"`      // Chain control requirements (legacy, use chain_control_info)
 	Alerts            []*RoadAlert       `protobuf:"bytes,11,rep,name=alerts,proto3" json:"alerts,omitempty"`                                                                      // Combined from multiple sources
 	ChainControlInfo  *ChainControlInfo  `protobuf:"bytes,12,opt,name=chain_control_info,json=chainControlInfo,proto3" json:"chain_control_info,omitempty"`                        // Detailed chain control information
+	// Unit system of the converted distances below and on the road's alerts:
+	// "metric" (km) or "imperial" (miles), per the request's `units`. The
+	// *_km / *_meters fields are always metric regardless.
+	Units         string                 `protobuf:"bytes,13,opt,name=units,proto3" json:"units,omitempty"`
+	Distance      float32                `protobuf:"fixed32,14,opt,name=distance,proto3" json:"distance,omitempty"`                                  // Route distance in `units`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`                 // When the road's data last changed (alert last_seen aside)
+	RouteLengthKm float64                `protobuf:"fixed64,16,opt,name=route_length_km,json=routeLengthKm,proto3" json:"route_length_km,omitempty"` // Length of the road's geometry from Google Routes in km, 0 when unavailable
 }
 
 func (x *Road) Reset() {
 	*x = Road{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roads_proto_msgTypes[9]
+		mi := &file_roads_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +2016,7 @@ func (x *Road) String() string {
 func (*Road) ProtoMessage() {}
 
 func (x *Road) ProtoReflect() protoreflect.Message {
-	mi := &file_roads_proto_msgTypes[9]
+	mi := &file_roads_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +2029,7 @@ func (x *Road) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Road.ProtoReflect.Descriptor instead.
 func (*Road) Descriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{9}
+	return file_roads_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Road) GetId() string {
@@ -1017,32 +2088,60 @@ func (x *Road) GetCongestionLevel() CongestionLevel {
 	return CongestionLevel_CONGESTION_LEVEL_UNSPECIFIED
 }
 
-func (x *Road) GetDelayMinutes() int32 {
+func (x *Road) GetDelayMinutes() int32 {
+	if x != nil {
+		return x.DelayMinutes
+	}
+	return 0
+}
+
+func (x *Road) GetChainControl() ChainControlStatus {
+	if x != nil {
+		return x.ChainControl
+	}
+	return ChainControlStatus_CHAIN_CONTROL_UNSPECIFIED
+}
+
+func (x *Road) GetAlerts() []*RoadAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *Road) GetChainControlInfo() *ChainControlInfo {
+	if x != nil {
+		return x.ChainControlInfo
+	}
+	return nil
+}
+
+func (x *Road) GetUnits() string {
 	if x != nil {
-		return x.DelayMinutes
+		return x.Units
 	}
-	return 0
+	return ""
 }
 
-func (x *Road) GetChainControl() ChainControlStatus {
+func (x *Road) GetDistance() float32 {
 	if x != nil {
-		return x.ChainControl
+		return x.Distance
 	}
-	return ChainControlStatus_CHAIN_CONTROL_UNSPECIFIED
+	return 0
 }
 
-func (x *Road) GetAlerts() []*RoadAlert {
+func (x *Road) GetChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Alerts
+		return x.ChangedAt
 	}
 	return nil
 }
 
-func (x *Road) GetChainControlInfo() *ChainControlInfo {
+func (x *Road) GetRouteLengthKm() float64 {
 	if x != nil {
-		return x.ChainControlInfo
+		return x.RouteLengthKm
 	}
-	return nil
+	return 0
 }
 
 // ChainControlInfo provides detailed chain control status for a road
@@ -1063,7 +2162,7 @@ type ChainControlInfo struct {
 func (x *ChainControlInfo) Reset() {
 	*x = ChainControlInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roads_proto_msgTypes[10]
+		mi := &file_roads_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +2175,7 @@ func (x *ChainControlInfo) String() string {
 func (*ChainControlInfo) ProtoMessage() {}
 
 func (x *ChainControlInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_roads_proto_msgTypes[10]
+	mi := &file_roads_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +2188,7 @@ func (x *ChainControlInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainControlInfo.ProtoReflect.Descriptor instead.
 func (*ChainControlInfo) Descriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{10}
+	return file_roads_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ChainControlInfo) GetLevel() ChainControlLevel {
@@ -1162,13 +2261,17 @@ type RoadAlert struct {
 	TimeReported          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=time_reported,json=timeReported,proto3" json:"time_reported,omitempty"`                                                             // When incident was first reported
 	Metadata              map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Additional AI-generated key-value pairs only
 	DistanceToRouteMeters float64                `protobuf:"fixed64,16,opt,name=distance_to_route_meters,json=distanceToRouteMeters,proto3" json:"distance_to_route_meters,omitempty"`                            // Distance from alert location to route in meters (for NEARBY alerts)
-	Id                    string                 `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`                                                                                                     // Stable CHP log / closure id; matches Incident.id for the same event (empty if none)
+	Id                    string                 `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`                                                                                                     // Stable id: the CHP log / closure number, else a hash of the alert content; matches Incident.id for the same event
+	FirstSeen             *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`                                                                      // When the server first saw the alert in the feeds
+	LastSeen              *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                                                                         // The latest refresh that saw it
+	DistanceToRoute       float64                `protobuf:"fixed64,20,opt,name=distance_to_route,json=distanceToRoute,proto3" json:"distance_to_route,omitempty"`                                                // distance_to_route_meters in the road's `units` (km or miles)
+	RoutePositionMeters   float64                `protobuf:"fixed64,21,opt,name=route_position_meters,json=routePositionMeters,proto3" json:"route_position_meters,omitempty"`                                    // Meters along the road from its origin, by mile marker when known, for ordering alerts along the drive
 }
 
 func (x *RoadAlert) Reset() {
 	*x = RoadAlert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roads_proto_msgTypes[11]
+		mi := &file_roads_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1181,7 +2284,7 @@ func (x *RoadAlert) String() string {
 func (*RoadAlert) ProtoMessage() {}
 
 func (x *RoadAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_roads_proto_msgTypes[11]
+	mi := &file_roads_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,7 +2297,7 @@ func (x *RoadAlert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoadAlert.ProtoReflect.Descriptor instead.
 func (*RoadAlert) Descriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{11}
+	return file_roads_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RoadAlert) GetType() AlertType {
@@ -1316,6 +2419,34 @@ func (x *RoadAlert) GetId() string {
 	return ""
 }
 
+func (x *RoadAlert) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *RoadAlert) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *RoadAlert) GetDistanceToRoute() float64 {
+	if x != nil {
+		return x.DistanceToRoute
+	}
+	return 0
+}
+
+func (x *RoadAlert) GetRoutePositionMeters() float64 {
+	if x != nil {
+		return x.RoutePositionMeters
+	}
+	return 0
+}
+
 type TrafficIncident struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1330,7 +2461,7 @@ type TrafficIncident struct {
 func (x *TrafficIncident) Reset() {
 	*x = TrafficIncident{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roads_proto_msgTypes[12]
+		mi := &file_roads_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1343,7 +2474,310 @@ func (x *TrafficIncident) String() string {
 func (*TrafficIncident) ProtoMessage() {}
 
 func (x *TrafficIncident) ProtoReflect() protoreflect.Message {
-	mi := &file_roads_proto_msgTypes[12]
+	mi := &file_roads_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficIncident.ProtoReflect.Descriptor instead.
+func (*TrafficIncident) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TrafficIncident) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrafficIncident) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TrafficIncident) GetLocationMileMarker() float32 {
+	if x != nil {
+		return x.LocationMileMarker
+	}
+	return 0
+}
+
+func (x *TrafficIncident) GetEstimatedDelayMinutes() int32 {
+	if x != nil {
+		return x.EstimatedDelayMinutes
+	}
+	return 0
+}
+
+type GetVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionRequest) ProtoMessage() {}
+
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{26}
+}
+
+type GetVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     string       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // Release version, or "dev" for local builds
+	Commit      string       `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`                        // Git commit the server was built from; empty when unknown
+	BuildTime   string       `protobuf:"bytes,3,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // RFC 3339; empty when unknown
+	GoVersion   string       `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"` // Go toolchain, e.g. "go1.24.3"
+	DataSources *DataSources `protobuf:"bytes,5,opt,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+}
+
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionResponse) ProtoMessage() {}
+
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetVersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetDataSources() *DataSources {
+	if x != nil {
+		return x.DataSources
+	}
+	return nil
+}
+
+type ForceRefreshRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForceRefreshRequest) Reset() {
+	*x = ForceRefreshRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRefreshRequest) ProtoMessage() {}
+
+func (x *ForceRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRefreshRequest.ProtoReflect.Descriptor instead.
+func (*ForceRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{28}
+}
+
+type ForceRefreshResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoadCount   int32                  `protobuf:"varint,1,opt,name=road_count,json=roadCount,proto3" json:"road_count,omitempty"`    // Roads in the refreshed set
+	AlertCount  int32                  `protobuf:"varint,2,opt,name=alert_count,json=alertCount,proto3" json:"alert_count,omitempty"` // Alerts across those roads
+	DurationMs  int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // How long the refresh took
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *ForceRefreshResponse) Reset() {
+	*x = ForceRefreshResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRefreshResponse) ProtoMessage() {}
+
+func (x *ForceRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRefreshResponse.ProtoReflect.Descriptor instead.
+func (*ForceRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForceRefreshResponse) GetRoadCount() int32 {
+	if x != nil {
+		return x.RoadCount
+	}
+	return 0
+}
+
+func (x *ForceRefreshResponse) GetAlertCount() int32 {
+	if x != nil {
+		return x.AlertCount
+	}
+	return 0
+}
+
+func (x *ForceRefreshResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ForceRefreshResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+// DataSources are the upstream feeds and models the server is configured with
+type DataSources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainControlsUrl  string   `protobuf:"bytes,1,opt,name=chain_controls_url,json=chainControlsUrl,proto3" json:"chain_controls_url,omitempty"`    // Caltrans chain control KML
+	LaneClosuresUrl   string   `protobuf:"bytes,2,opt,name=lane_closures_url,json=laneClosuresUrl,proto3" json:"lane_closures_url,omitempty"`       // Caltrans lane closure KML
+	ChpIncidentsUrl   string   `protobuf:"bytes,3,opt,name=chp_incidents_url,json=chpIncidentsUrl,proto3" json:"chp_incidents_url,omitempty"`       // CHP incident KML
+	RoadConditionsUrl string   `protobuf:"bytes,4,opt,name=road_conditions_url,json=roadConditionsUrl,proto3" json:"road_conditions_url,omitempty"` // Caltrans highway conditions page; %s is the highway number
+	LlmProvider       string   `protobuf:"bytes,5,opt,name=llm_provider,json=llmProvider,proto3" json:"llm_provider,omitempty"`                     // Road-alert enhancement backend: "openai" or "openai-compatible"
+	LlmModel          string   `protobuf:"bytes,6,opt,name=llm_model,json=llmModel,proto3" json:"llm_model,omitempty"`
+	LlmFallbackModel  string   `protobuf:"bytes,7,opt,name=llm_fallback_model,json=llmFallbackModel,proto3" json:"llm_fallback_model,omitempty"` // Empty when none
+	WeatherProviders  []string `protobuf:"bytes,8,rep,name=weather_providers,json=weatherProviders,proto3" json:"weather_providers,omitempty"`   // In the order they are tried, e.g. ["openweather", "nws"]
+	Offline           bool     `protobuf:"varint,9,opt,name=offline,proto3" json:"offline,omitempty"`                                            // Upstreams are answered from local fixtures
+}
+
+func (x *DataSources) Reset() {
+	*x = DataSources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roads_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSources) ProtoMessage() {}
+
+func (x *DataSources) ProtoReflect() protoreflect.Message {
+	mi := &file_roads_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,37 +2788,72 @@ func (x *TrafficIncident) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficIncident.ProtoReflect.Descriptor instead.
-func (*TrafficIncident) Descriptor() ([]byte, []int) {
-	return file_roads_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DataSources.ProtoReflect.Descriptor instead.
+func (*DataSources) Descriptor() ([]byte, []int) {
+	return file_roads_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TrafficIncident) GetId() string {
+func (x *DataSources) GetChainControlsUrl() string {
 	if x != nil {
-		return x.Id
+		return x.ChainControlsUrl
 	}
 	return ""
 }
 
-func (x *TrafficIncident) GetDescription() string {
+func (x *DataSources) GetLaneClosuresUrl() string {
 	if x != nil {
-		return x.Description
+		return x.LaneClosuresUrl
 	}
 	return ""
 }
 
-func (x *TrafficIncident) GetLocationMileMarker() float32 {
+func (x *DataSources) GetChpIncidentsUrl() string {
 	if x != nil {
-		return x.LocationMileMarker
+		return x.ChpIncidentsUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *TrafficIncident) GetEstimatedDelayMinutes() int32 {
+func (x *DataSources) GetRoadConditionsUrl() string {
 	if x != nil {
-		return x.EstimatedDelayMinutes
+		return x.RoadConditionsUrl
 	}
-	return 0
+	return ""
+}
+
+func (x *DataSources) GetLlmProvider() string {
+	if x != nil {
+		return x.LlmProvider
+	}
+	return ""
+}
+
+func (x *DataSources) GetLlmModel() string {
+	if x != nil {
+		return x.LlmModel
+	}
+	return ""
+}
+
+func (x *DataSources) GetLlmFallbackModel() string {
+	if x != nil {
+		return x.LlmFallbackModel
+	}
+	return ""
+}
+
+func (x *DataSources) GetWeatherProviders() []string {
+	if x != nil {
+		return x.WeatherProviders
+	}
+	return nil
+}
+
+func (x *DataSources) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
 }
 
 var File_roads_proto protoreflect.FileDescriptor
@@ -1399,189 +2868,399 @@ var file_roads_proto_rawDesc = []byte{
 	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x61, 0x64, 0x49,
-	0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x76, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x52, 0x05,
-	0x72, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x22, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52,
+	0x74, 0x6f, 0x1a, 0x0d, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xce, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x1a, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x61, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x72, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x61, 0x64, 0x73,
+	0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x61, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x6f, 0x61, 0x64, 0x52, 0x04, 0x72, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
 	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x61, 0x64, 0x52, 0x04, 0x72, 0x6f, 0x61, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xf3, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x91, 0x02, 0x0a,
+	0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xcc, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22,
+	0x9a, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0xd2, 0x03, 0x0a,
+	0x08, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65,
+	0x61, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x6f, 0x61, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x59, 0x0a, 0x13, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x61,
+	0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x61, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa0, 0x02, 0x0a,
+	0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x22,
+	0xf9, 0x05, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72,
+	0x61, 0x77, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x77, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x68, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x61, 0x76, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x70, 0x39, 0x35, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x70, 0x39,
+	0x35, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x5f,
+	0x32, 0x34, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x48, 0x69, 0x74, 0x73, 0x32, 0x34, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x32, 0x34,
+	0x68, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x48, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x32, 0x34, 0x68, 0x12, 0x2f,
+	0x0a, 0x13, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x35, 0x0a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x43, 0x61,
+	0x6c, 0x6c, 0x73, 0x32, 0x34, 0x68, 0x12, 0x43, 0x0a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x9d, 0x05, 0x0a, 0x04,
+	0x52, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61,
+	0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x46,
+	0x0a, 0x12, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x5f, 0x6b, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4b, 0x6d, 0x22, 0xa5, 0x02, 0x0a, 0x10,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0xd2, 0x03, 0x0a, 0x08, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76,
-	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
-	0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x08, 0x0a, 0x09, 0x52, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0e, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x64,
+	0x65, 0x6e, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x73, 0x65, 0x64, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0xf7, 0x01, 0x0a, 0x11, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x6c,
-	0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x61, 0x77, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x65,
-	0x72, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x5f,
-	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e,
-	0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14,
-	0x65, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x6e, 0x68, 0x61,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12,
-	0x33, 0x0a, 0x16, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x13, 0x61, 0x76, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69,
-	0x6d, 0x65, 0x4d, 0x73, 0x22, 0x88, 0x04, 0x0a, 0x04, 0x52, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x4b, 0x6d, 0x12, 0x42, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
-	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x06,
-	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
-	0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0xa5, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x07, 0x0a, 0x09, 0x52, 0x6f, 0x61, 0x64,
-	0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65,
-	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08,
-	0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x76,
-	0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
-	0x63, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x73,
-	0x65, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x69, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52,
+	0x06, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
-	0x0a, 0x06, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x52, 0x06, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
-	0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12,
-	0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x61, 0x64, 0x41,
-	0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x18,
-	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15,
-	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x70, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
 	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
@@ -1596,96 +3275,202 @@ var file_roads_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69,
 	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x65, 0x73, 0x74,
 	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74,
-	0x65, 0x73, 0x2a, 0x60, 0x0a, 0x0a, 0x52, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e,
-	0x43, 0x45, 0x10, 0x04, 0x2a, 0x68, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
-	0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e,
-	0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xaa,
-	0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41,
-	0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52,
-	0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x32, 0x10, 0x03, 0x12,
-	0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x33, 0x10, 0x04, 0x2a, 0x6e, 0x0a, 0x0f, 0x43,
-	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20,
-	0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56,
-	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
-	0x54, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x04, 0x12,
-	0x0a, 0x0a, 0x06, 0x53, 0x45, 0x56, 0x45, 0x52, 0x45, 0x10, 0x05, 0x2a, 0x61, 0x0a, 0x09, 0x41,
-	0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4c, 0x45, 0x52,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x10,
-	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x62,
-	0x0a, 0x13, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43,
-	0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f,
-	0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x41,
-	0x52, 0x42, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x54,
-	0x10, 0x03, 0x32, 0xa5, 0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73,
-	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
-	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x5b, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x2f,
-	0x7b, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x72, 0x65, 0x61, 0x7d, 0x42, 0xb1, 0x02, 0x92, 0x41, 0x80,
-	0x02, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x52, 0x6f, 0x61, 0x64, 0x73,
-	0x20, 0x41, 0x50, 0x49, 0x12, 0x4d, 0x52, 0x65, 0x61, 0x6c, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20,
-	0x72, 0x6f, 0x61, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-	0x61, 0x6e, 0x64, 0x20, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x20, 0x69, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x45, 0x62, 0x62, 0x65, 0x74, 0x74, 0x73, 0x20, 0x50, 0x61, 0x73, 0x73, 0x20, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f,
-	0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x15, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
-	0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x32, 0x03,
-	0x31, 0x2e, 0x30, 0x2a, 0x02, 0x02, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x1b, 0x4d,
-	0x6f, 0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49,
-	0x6e, 0x66, 0x6f, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70,
-	0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
-	0x70, 0x75, 0x70, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65,
-	0x74, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70,
+	0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb6, 0x01,
+	0x0a, 0x14, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xf8, 0x02, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x55, 0x72, 0x6c,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x68, 0x70, 0x5f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x70,
+	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6c, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x6c, 0x6d, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6c, 0x6d, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x2a, 0x60, 0x0a, 0x0a, 0x52, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43,
+	0x45, 0x10, 0x04, 0x2a, 0x68, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a,
+	0x0a, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xaa, 0x01,
+	0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x31,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x52, 0x4f, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x32, 0x10, 0x03, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x33, 0x10, 0x04, 0x2a, 0x6e, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a,
+	0x1c, 0x43, 0x4f, 0x4e, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x45, 0x41, 0x56, 0x59, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x45, 0x56, 0x45, 0x52, 0x45, 0x10, 0x05, 0x2a, 0x66, 0x0a, 0x0c, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52,
+	0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x50, 0x45,
+	0x45, 0x44, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x50, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x50, 0x45, 0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x4a, 0x41, 0x4d,
+	0x10, 0x03, 0x2a, 0x61, 0x0a, 0x09, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e,
+	0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x41, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x62, 0x0a, 0x13, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20,
+	0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x41, 0x52, 0x42, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x03, 0x32, 0xbd, 0x09, 0x0a, 0x0c, 0x52, 0x6f,
+	0x61, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x5b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x12, 0x16,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x2f, 0x7b,
+	0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f,
+	0x61, 0x64, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f,
+	0x72, 0x72, 0x69, 0x64, 0x6f, 0x72, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x61, 0x72, 0x65, 0x61, 0x7d, 0x12, 0x64, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x70, 0x0a,
+	0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x6f, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x72, 0x6f, 0x61, 0x64, 0x73, 0x30, 0x01, 0x12,
+	0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x5c,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x0c,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
+	0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x42, 0xb1, 0x02, 0x92, 0x41, 0x80, 0x02,
+	0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x52, 0x6f, 0x61, 0x64, 0x73, 0x20,
+	0x41, 0x50, 0x49, 0x12, 0x4d, 0x52, 0x65, 0x61, 0x6c, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x72,
+	0x6f, 0x61, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45,
+	0x62, 0x62, 0x65, 0x74, 0x74, 0x73, 0x20, 0x50, 0x61, 0x73, 0x73, 0x20, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x20,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x15, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x32, 0x03, 0x31,
+	0x2e, 0x30, 0x2a, 0x02, 0x02, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x1b, 0x4d, 0x6f,
+	0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e,
+	0x66, 0x6f, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70,
 	0x75, 0x70, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70, 0x75,
+	0x70, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1700,80 +3485,146 @@ func file_roads_proto_rawDescGZIP() []byte {
 	return file_roads_proto_rawDescData
 }
 
-var file_roads_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_roads_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_roads_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_roads_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_roads_proto_goTypes = []interface{}{
 	(RoadStatus)(0),                     // 0: api.v1.RoadStatus
 	(ChainControlStatus)(0),             // 1: api.v1.ChainControlStatus
 	(ChainControlLevel)(0),              // 2: api.v1.ChainControlLevel
 	(CongestionLevel)(0),                // 3: api.v1.CongestionLevel
-	(AlertType)(0),                      // 4: api.v1.AlertType
-	(AlertClassification)(0),            // 5: api.v1.AlertClassification
-	(*ListRoadsRequest)(nil),            // 6: api.v1.ListRoadsRequest
-	(*GetRoadRequest)(nil),              // 7: api.v1.GetRoadRequest
-	(*GetProcessingMetricsRequest)(nil), // 8: api.v1.GetProcessingMetricsRequest
-	(*ListIncidentsRequest)(nil),        // 9: api.v1.ListIncidentsRequest
-	(*ListRoadsResponse)(nil),           // 10: api.v1.ListRoadsResponse
-	(*GetRoadResponse)(nil),             // 11: api.v1.GetRoadResponse
-	(*ListIncidentsResponse)(nil),       // 12: api.v1.ListIncidentsResponse
-	(*Incident)(nil),                    // 13: api.v1.Incident
-	(*ProcessingMetrics)(nil),           // 14: api.v1.ProcessingMetrics
-	(*Road)(nil),                        // 15: api.v1.Road
-	(*ChainControlInfo)(nil),            // 16: api.v1.ChainControlInfo
-	(*RoadAlert)(nil),                   // 17: api.v1.RoadAlert
-	(*TrafficIncident)(nil),             // 18: api.v1.TrafficIncident
-	nil,                                 // 19: api.v1.RoadAlert.MetadataEntry
-	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
-	(AlertSeverity)(0),                  // 21: api.v1.AlertSeverity
-	(*Coordinates)(nil),                 // 22: api.v1.Coordinates
-	(IncidentStatus)(0),                 // 23: api.v1.IncidentStatus
-	(AlertImpact)(0),                    // 24: api.v1.AlertImpact
-	(AlertDuration)(0),                  // 25: api.v1.AlertDuration
+	(TrafficSpeed)(0),                   // 4: api.v1.TrafficSpeed
+	(AlertType)(0),                      // 5: api.v1.AlertType
+	(AlertClassification)(0),            // 6: api.v1.AlertClassification
+	(*ListRoadsRequest)(nil),            // 7: api.v1.ListRoadsRequest
+	(*GetRoadRequest)(nil),              // 8: api.v1.GetRoadRequest
+	(*GetRouteGeometryRequest)(nil),     // 9: api.v1.GetRouteGeometryRequest
+	(*GetCorridorStatusRequest)(nil),    // 10: api.v1.GetCorridorStatusRequest
+	(*GetProcessingMetricsRequest)(nil), // 11: api.v1.GetProcessingMetricsRequest
+	(*ListIncidentsRequest)(nil),        // 12: api.v1.ListIncidentsRequest
+	(*ListAllAlertsRequest)(nil),        // 13: api.v1.ListAllAlertsRequest
+	(*StreamRoadUpdatesRequest)(nil),    // 14: api.v1.StreamRoadUpdatesRequest
+	(*GetServiceHealthRequest)(nil),     // 15: api.v1.GetServiceHealthRequest
+	(*ListRoadsResponse)(nil),           // 16: api.v1.ListRoadsResponse
+	(*GetRoadResponse)(nil),             // 17: api.v1.GetRoadResponse
+	(*GetCorridorStatusResponse)(nil),   // 18: api.v1.GetCorridorStatusResponse
+	(*GetRouteGeometryResponse)(nil),    // 19: api.v1.GetRouteGeometryResponse
+	(*AlertGeometry)(nil),               // 20: api.v1.AlertGeometry
+	(*TrafficSegment)(nil),              // 21: api.v1.TrafficSegment
+	(*ListIncidentsResponse)(nil),       // 22: api.v1.ListIncidentsResponse
+	(*Incident)(nil),                    // 23: api.v1.Incident
+	(*ListAllAlertsResponse)(nil),       // 24: api.v1.ListAllAlertsResponse
+	(*AggregatedRoadAlert)(nil),         // 25: api.v1.AggregatedRoadAlert
+	(*GetServiceHealthResponse)(nil),    // 26: api.v1.GetServiceHealthResponse
+	(*DataSourceHealth)(nil),            // 27: api.v1.DataSourceHealth
+	(*ProcessingMetrics)(nil),           // 28: api.v1.ProcessingMetrics
+	(*Road)(nil),                        // 29: api.v1.Road
+	(*ChainControlInfo)(nil),            // 30: api.v1.ChainControlInfo
+	(*RoadAlert)(nil),                   // 31: api.v1.RoadAlert
+	(*TrafficIncident)(nil),             // 32: api.v1.TrafficIncident
+	(*GetVersionRequest)(nil),           // 33: api.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),          // 34: api.v1.GetVersionResponse
+	(*ForceRefreshRequest)(nil),         // 35: api.v1.ForceRefreshRequest
+	(*ForceRefreshResponse)(nil),        // 36: api.v1.ForceRefreshResponse
+	(*DataSources)(nil),                 // 37: api.v1.DataSources
+	nil,                                 // 38: api.v1.RoadAlert.MetadataEntry
+	(AlertSeverity)(0),                  // 39: api.v1.AlertSeverity
+	(*timestamppb.Timestamp)(nil),       // 40: google.protobuf.Timestamp
+	(*WeatherData)(nil),                 // 41: api.v1.WeatherData
+	(*Coordinates)(nil),                 // 42: api.v1.Coordinates
+	(IncidentStatus)(0),                 // 43: api.v1.IncidentStatus
+	(AlertImpact)(0),                    // 44: api.v1.AlertImpact
+	(AlertDuration)(0),                  // 45: api.v1.AlertDuration
 }
 var file_roads_proto_depIdxs = []int32{
-	15, // 0: api.v1.ListRoadsResponse.roads:type_name -> api.v1.Road
-	20, // 1: api.v1.ListRoadsResponse.last_updated:type_name -> google.protobuf.Timestamp
-	15, // 2: api.v1.GetRoadResponse.road:type_name -> api.v1.Road
-	20, // 3: api.v1.GetRoadResponse.last_updated:type_name -> google.protobuf.Timestamp
-	13, // 4: api.v1.ListIncidentsResponse.incidents:type_name -> api.v1.Incident
-	20, // 5: api.v1.ListIncidentsResponse.last_updated:type_name -> google.protobuf.Timestamp
-	4,  // 6: api.v1.Incident.type:type_name -> api.v1.AlertType
-	21, // 7: api.v1.Incident.severity:type_name -> api.v1.AlertSeverity
-	22, // 8: api.v1.Incident.location:type_name -> api.v1.Coordinates
-	23, // 9: api.v1.Incident.status:type_name -> api.v1.IncidentStatus
-	20, // 10: api.v1.Incident.started:type_name -> google.protobuf.Timestamp
-	20, // 11: api.v1.Incident.last_updated:type_name -> google.protobuf.Timestamp
-	0,  // 12: api.v1.Road.status:type_name -> api.v1.RoadStatus
-	3,  // 13: api.v1.Road.congestion_level:type_name -> api.v1.CongestionLevel
-	1,  // 14: api.v1.Road.chain_control:type_name -> api.v1.ChainControlStatus
-	17, // 15: api.v1.Road.alerts:type_name -> api.v1.RoadAlert
-	16, // 16: api.v1.Road.chain_control_info:type_name -> api.v1.ChainControlInfo
-	2,  // 17: api.v1.ChainControlInfo.level:type_name -> api.v1.ChainControlLevel
-	20, // 18: api.v1.ChainControlInfo.effective_time:type_name -> google.protobuf.Timestamp
-	4,  // 19: api.v1.RoadAlert.type:type_name -> api.v1.AlertType
-	21, // 20: api.v1.RoadAlert.severity:type_name -> api.v1.AlertSeverity
-	5,  // 21: api.v1.RoadAlert.classification:type_name -> api.v1.AlertClassification
-	20, // 22: api.v1.RoadAlert.start_time:type_name -> google.protobuf.Timestamp
-	20, // 23: api.v1.RoadAlert.end_time:type_name -> google.protobuf.Timestamp
-	20, // 24: api.v1.RoadAlert.last_updated:type_name -> google.protobuf.Timestamp
-	22, // 25: api.v1.RoadAlert.location:type_name -> api.v1.Coordinates
-	24, // 26: api.v1.RoadAlert.impact:type_name -> api.v1.AlertImpact
-	25, // 27: api.v1.RoadAlert.duration:type_name -> api.v1.AlertDuration
-	20, // 28: api.v1.RoadAlert.time_reported:type_name -> google.protobuf.Timestamp
-	19, // 29: api.v1.RoadAlert.metadata:type_name -> api.v1.RoadAlert.MetadataEntry
-	6,  // 30: api.v1.RoadsService.ListRoads:input_type -> api.v1.ListRoadsRequest
-	7,  // 31: api.v1.RoadsService.GetRoad:input_type -> api.v1.GetRoadRequest
-	8,  // 32: api.v1.RoadsService.GetProcessingMetrics:input_type -> api.v1.GetProcessingMetricsRequest
-	9,  // 33: api.v1.RoadsService.ListIncidents:input_type -> api.v1.ListIncidentsRequest
-	10, // 34: api.v1.RoadsService.ListRoads:output_type -> api.v1.ListRoadsResponse
-	11, // 35: api.v1.RoadsService.GetRoad:output_type -> api.v1.GetRoadResponse
-	14, // 36: api.v1.RoadsService.GetProcessingMetrics:output_type -> api.v1.ProcessingMetrics
-	12, // 37: api.v1.RoadsService.ListIncidents:output_type -> api.v1.ListIncidentsResponse
-	34, // [34:38] is the sub-list for method output_type
-	30, // [30:34] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	0,  // 0: api.v1.ListRoadsRequest.status_filter:type_name -> api.v1.RoadStatus
+	39, // 1: api.v1.ListRoadsRequest.min_severity:type_name -> api.v1.AlertSeverity
+	6,  // 2: api.v1.ListRoadsRequest.classification:type_name -> api.v1.AlertClassification
+	40, // 3: api.v1.ListRoadsRequest.since:type_name -> google.protobuf.Timestamp
+	39, // 4: api.v1.GetRoadRequest.min_severity:type_name -> api.v1.AlertSeverity
+	6,  // 5: api.v1.GetRoadRequest.classification:type_name -> api.v1.AlertClassification
+	29, // 6: api.v1.ListRoadsResponse.roads:type_name -> api.v1.Road
+	40, // 7: api.v1.ListRoadsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	40, // 8: api.v1.ListRoadsResponse.server_time:type_name -> google.protobuf.Timestamp
+	29, // 9: api.v1.GetRoadResponse.road:type_name -> api.v1.Road
+	40, // 10: api.v1.GetRoadResponse.last_updated:type_name -> google.protobuf.Timestamp
+	29, // 11: api.v1.GetCorridorStatusResponse.road:type_name -> api.v1.Road
+	41, // 12: api.v1.GetCorridorStatusResponse.weather:type_name -> api.v1.WeatherData
+	40, // 13: api.v1.GetCorridorStatusResponse.last_updated:type_name -> google.protobuf.Timestamp
+	42, // 14: api.v1.GetRouteGeometryResponse.origin:type_name -> api.v1.Coordinates
+	42, // 15: api.v1.GetRouteGeometryResponse.destination:type_name -> api.v1.Coordinates
+	20, // 16: api.v1.GetRouteGeometryResponse.alerts:type_name -> api.v1.AlertGeometry
+	40, // 17: api.v1.GetRouteGeometryResponse.last_updated:type_name -> google.protobuf.Timestamp
+	21, // 18: api.v1.GetRouteGeometryResponse.traffic_segments:type_name -> api.v1.TrafficSegment
+	6,  // 19: api.v1.AlertGeometry.classification:type_name -> api.v1.AlertClassification
+	42, // 20: api.v1.AlertGeometry.location:type_name -> api.v1.Coordinates
+	42, // 21: api.v1.TrafficSegment.start:type_name -> api.v1.Coordinates
+	42, // 22: api.v1.TrafficSegment.end:type_name -> api.v1.Coordinates
+	4,  // 23: api.v1.TrafficSegment.speed:type_name -> api.v1.TrafficSpeed
+	23, // 24: api.v1.ListIncidentsResponse.incidents:type_name -> api.v1.Incident
+	40, // 25: api.v1.ListIncidentsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	5,  // 26: api.v1.Incident.type:type_name -> api.v1.AlertType
+	39, // 27: api.v1.Incident.severity:type_name -> api.v1.AlertSeverity
+	42, // 28: api.v1.Incident.location:type_name -> api.v1.Coordinates
+	43, // 29: api.v1.Incident.status:type_name -> api.v1.IncidentStatus
+	40, // 30: api.v1.Incident.started:type_name -> google.protobuf.Timestamp
+	40, // 31: api.v1.Incident.last_updated:type_name -> google.protobuf.Timestamp
+	25, // 32: api.v1.ListAllAlertsResponse.alerts:type_name -> api.v1.AggregatedRoadAlert
+	40, // 33: api.v1.ListAllAlertsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	31, // 34: api.v1.AggregatedRoadAlert.alert:type_name -> api.v1.RoadAlert
+	27, // 35: api.v1.GetServiceHealthResponse.sources:type_name -> api.v1.DataSourceHealth
+	40, // 36: api.v1.GetServiceHealthResponse.checked_at:type_name -> google.protobuf.Timestamp
+	40, // 37: api.v1.DataSourceHealth.last_success:type_name -> google.protobuf.Timestamp
+	40, // 38: api.v1.DataSourceHealth.last_error_time:type_name -> google.protobuf.Timestamp
+	0,  // 39: api.v1.Road.status:type_name -> api.v1.RoadStatus
+	3,  // 40: api.v1.Road.congestion_level:type_name -> api.v1.CongestionLevel
+	1,  // 41: api.v1.Road.chain_control:type_name -> api.v1.ChainControlStatus
+	31, // 42: api.v1.Road.alerts:type_name -> api.v1.RoadAlert
+	30, // 43: api.v1.Road.chain_control_info:type_name -> api.v1.ChainControlInfo
+	40, // 44: api.v1.Road.changed_at:type_name -> google.protobuf.Timestamp
+	2,  // 45: api.v1.ChainControlInfo.level:type_name -> api.v1.ChainControlLevel
+	40, // 46: api.v1.ChainControlInfo.effective_time:type_name -> google.protobuf.Timestamp
+	5,  // 47: api.v1.RoadAlert.type:type_name -> api.v1.AlertType
+	39, // 48: api.v1.RoadAlert.severity:type_name -> api.v1.AlertSeverity
+	6,  // 49: api.v1.RoadAlert.classification:type_name -> api.v1.AlertClassification
+	40, // 50: api.v1.RoadAlert.start_time:type_name -> google.protobuf.Timestamp
+	40, // 51: api.v1.RoadAlert.end_time:type_name -> google.protobuf.Timestamp
+	40, // 52: api.v1.RoadAlert.last_updated:type_name -> google.protobuf.Timestamp
+	42, // 53: api.v1.RoadAlert.location:type_name -> api.v1.Coordinates
+	44, // 54: api.v1.RoadAlert.impact:type_name -> api.v1.AlertImpact
+	45, // 55: api.v1.RoadAlert.duration:type_name -> api.v1.AlertDuration
+	40, // 56: api.v1.RoadAlert.time_reported:type_name -> google.protobuf.Timestamp
+	38, // 57: api.v1.RoadAlert.metadata:type_name -> api.v1.RoadAlert.MetadataEntry
+	40, // 58: api.v1.RoadAlert.first_seen:type_name -> google.protobuf.Timestamp
+	40, // 59: api.v1.RoadAlert.last_seen:type_name -> google.protobuf.Timestamp
+	37, // 60: api.v1.GetVersionResponse.data_sources:type_name -> api.v1.DataSources
+	40, // 61: api.v1.ForceRefreshResponse.last_updated:type_name -> google.protobuf.Timestamp
+	7,  // 62: api.v1.RoadsService.ListRoads:input_type -> api.v1.ListRoadsRequest
+	8,  // 63: api.v1.RoadsService.GetRoad:input_type -> api.v1.GetRoadRequest
+	9,  // 64: api.v1.RoadsService.GetRouteGeometry:input_type -> api.v1.GetRouteGeometryRequest
+	10, // 65: api.v1.RoadsService.GetCorridorStatus:input_type -> api.v1.GetCorridorStatusRequest
+	11, // 66: api.v1.RoadsService.GetProcessingMetrics:input_type -> api.v1.GetProcessingMetricsRequest
+	12, // 67: api.v1.RoadsService.ListIncidents:input_type -> api.v1.ListIncidentsRequest
+	13, // 68: api.v1.RoadsService.ListAllAlerts:input_type -> api.v1.ListAllAlertsRequest
+	14, // 69: api.v1.RoadsService.StreamRoadUpdates:input_type -> api.v1.StreamRoadUpdatesRequest
+	15, // 70: api.v1.RoadsService.GetServiceHealth:input_type -> api.v1.GetServiceHealthRequest
+	33, // 71: api.v1.RoadsService.GetVersion:input_type -> api.v1.GetVersionRequest
+	35, // 72: api.v1.RoadsService.ForceRefresh:input_type -> api.v1.ForceRefreshRequest
+	16, // 73: api.v1.RoadsService.ListRoads:output_type -> api.v1.ListRoadsResponse
+	17, // 74: api.v1.RoadsService.GetRoad:output_type -> api.v1.GetRoadResponse
+	19, // 75: api.v1.RoadsService.GetRouteGeometry:output_type -> api.v1.GetRouteGeometryResponse
+	18, // 76: api.v1.RoadsService.GetCorridorStatus:output_type -> api.v1.GetCorridorStatusResponse
+	28, // 77: api.v1.RoadsService.GetProcessingMetrics:output_type -> api.v1.ProcessingMetrics
+	22, // 78: api.v1.RoadsService.ListIncidents:output_type -> api.v1.ListIncidentsResponse
+	24, // 79: api.v1.RoadsService.ListAllAlerts:output_type -> api.v1.ListAllAlertsResponse
+	16, // 80: api.v1.RoadsService.StreamRoadUpdates:output_type -> api.v1.ListRoadsResponse
+	26, // 81: api.v1.RoadsService.GetServiceHealth:output_type -> api.v1.GetServiceHealthResponse
+	34, // 82: api.v1.RoadsService.GetVersion:output_type -> api.v1.GetVersionResponse
+	36, // 83: api.v1.RoadsService.ForceRefresh:output_type -> api.v1.ForceRefreshResponse
+	73, // [73:84] is the sub-list for method output_type
+	62, // [62:73] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_roads_proto_init() }
@@ -1782,6 +3633,7 @@ func file_roads_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_weather_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_roads_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRoadsRequest); i {
@@ -1808,7 +3660,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessingMetricsRequest); i {
+			switch v := v.(*GetRouteGeometryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,7 +3672,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIncidentsRequest); i {
+			switch v := v.(*GetCorridorStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,7 +3684,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoadsResponse); i {
+			switch v := v.(*GetProcessingMetricsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,7 +3696,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoadResponse); i {
+			switch v := v.(*ListIncidentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1856,7 +3708,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIncidentsResponse); i {
+			switch v := v.(*ListAllAlertsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1868,7 +3720,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Incident); i {
+			switch v := v.(*StreamRoadUpdatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1880,7 +3732,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessingMetrics); i {
+			switch v := v.(*GetServiceHealthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1892,7 +3744,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Road); i {
+			switch v := v.(*ListRoadsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1904,7 +3756,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainControlInfo); i {
+			switch v := v.(*GetRoadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1916,7 +3768,7 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoadAlert); i {
+			switch v := v.(*GetCorridorStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1928,6 +3780,162 @@ func file_roads_proto_init() {
 			}
 		}
 		file_roads_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRouteGeometryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertGeometry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncidentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Incident); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregatedRoadAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Road); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainControlInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoadAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficIncident); i {
 			case 0:
 				return &v.state
@@ -1939,14 +3947,74 @@ func file_roads_proto_init() {
 				return nil
 			}
 		}
+		file_roads_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceRefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceRefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roads_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_roads_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   14,
+			NumEnums:      7,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
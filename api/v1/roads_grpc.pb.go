@@ -21,8 +21,15 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	RoadsService_ListRoads_FullMethodName            = "/api.v1.RoadsService/ListRoads"
 	RoadsService_GetRoad_FullMethodName              = "/api.v1.RoadsService/GetRoad"
+	RoadsService_GetRouteGeometry_FullMethodName     = "/api.v1.RoadsService/GetRouteGeometry"
+	RoadsService_GetCorridorStatus_FullMethodName    = "/api.v1.RoadsService/GetCorridorStatus"
 	RoadsService_GetProcessingMetrics_FullMethodName = "/api.v1.RoadsService/GetProcessingMetrics"
 	RoadsService_ListIncidents_FullMethodName        = "/api.v1.RoadsService/ListIncidents"
+	RoadsService_ListAllAlerts_FullMethodName        = "/api.v1.RoadsService/ListAllAlerts"
+	RoadsService_StreamRoadUpdates_FullMethodName    = "/api.v1.RoadsService/StreamRoadUpdates"
+	RoadsService_GetServiceHealth_FullMethodName     = "/api.v1.RoadsService/GetServiceHealth"
+	RoadsService_GetVersion_FullMethodName           = "/api.v1.RoadsService/GetVersion"
+	RoadsService_ForceRefresh_FullMethodName         = "/api.v1.RoadsService/ForceRefresh"
 )
 
 // RoadsServiceClient is the client API for RoadsService service.
@@ -33,7 +40,17 @@ type RoadsServiceClient interface {
 	ListRoads(ctx context.Context, in *ListRoadsRequest, opts ...grpc.CallOption) (*ListRoadsResponse, error)
 	// GetRoad returns current conditions for a specific road
 	GetRoad(ctx context.Context, in *GetRoadRequest, opts ...grpc.CallOption) (*GetRoadResponse, error)
-	// GetProcessingMetrics returns alert processing metrics.
+	// GetRouteGeometry returns the geometry behind a road's alert classification:
+	// the encoded route polyline, its origin/destination, and each alert's
+	// location, affected extent and distance to the route. Lets map clients draw
+	// the corridor and closure overlaps that GetRoad keeps internal.
+	GetRouteGeometry(ctx context.Context, in *GetRouteGeometryRequest, opts ...grpc.CallOption) (*GetRouteGeometryResponse, error)
+	// GetCorridorStatus returns a road's conditions together with the current
+	// weather and active weather alerts along it, for a single "pass status"
+	// view without merging GetRoad and weather calls.
+	GetCorridorStatus(ctx context.Context, in *GetCorridorStatusRequest, opts ...grpc.CallOption) (*GetCorridorStatusResponse, error)
+	// GetProcessingMetrics returns road-alert AI enhancement metrics, including
+	// token usage and estimated spend, and Google Routes API usage.
 	// Mapped to /api/v1/metrics (not /api/v1/roads/metrics) so it does not collide
 	// with the /api/v1/roads/{road_id} id space.
 	GetProcessingMetrics(ctx context.Context, in *GetProcessingMetricsRequest, opts ...grpc.CallOption) (*ProcessingMetrics, error)
@@ -45,6 +62,29 @@ type RoadsServiceClient interface {
 	// area as a flat, structured list without per-route classification or AI
 	// enhancement (kept lightweight for a region-wide view).
 	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	// ListAllAlerts returns every road alert across all monitored roads as one
+	// flat feed. An alert that applies to several roads appears once, listing
+	// each road it affects. Sorted by severity (most severe first), then by
+	// distance to the route.
+	ListAllAlerts(ctx context.Context, in *ListAllAlertsRequest, opts ...grpc.CallOption) (*ListAllAlertsResponse, error)
+	// StreamRoadUpdates pushes road conditions as they change: the current road
+	// set immediately, then a new message whenever a background refresh produces
+	// different data (refreshes that change nothing are not sent). Over HTTP the
+	// gateway emits newline-delimited JSON, one {"result": ...} object per update.
+	StreamRoadUpdates(ctx context.Context, in *StreamRoadUpdatesRequest, opts ...grpc.CallOption) (RoadsService_StreamRoadUpdatesClient, error)
+	// GetServiceHealth reports, per upstream data source (Google Routes,
+	// Caltrans, OpenWeather, NWS), when data was last fetched successfully, how stale
+	// the cached copy is, and the most recent error. Intended for monitoring and
+	// load-balancer readiness checks; never cached.
+	GetServiceHealth(ctx context.Context, in *GetServiceHealthRequest, opts ...grpc.CallOption) (*GetServiceHealthResponse, error)
+	// GetVersion identifies the running build and the upstream feeds and models
+	// it is configured with, for diagnosing stale data or classification
+	// behavior in the field. Also served at /version.
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// ForceRefresh refetches road data now instead of waiting for the periodic
+	// refresh, for operators debugging classifications. Requires the admin API
+	// key (admin.apiKey) as "Authorization: admin_<key>".
+	ForceRefresh(ctx context.Context, in *ForceRefreshRequest, opts ...grpc.CallOption) (*ForceRefreshResponse, error)
 }
 
 type roadsServiceClient struct {
@@ -73,6 +113,24 @@ func (c *roadsServiceClient) GetRoad(ctx context.Context, in *GetRoadRequest, op
 	return out, nil
 }
 
+func (c *roadsServiceClient) GetRouteGeometry(ctx context.Context, in *GetRouteGeometryRequest, opts ...grpc.CallOption) (*GetRouteGeometryResponse, error) {
+	out := new(GetRouteGeometryResponse)
+	err := c.cc.Invoke(ctx, RoadsService_GetRouteGeometry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadsServiceClient) GetCorridorStatus(ctx context.Context, in *GetCorridorStatusRequest, opts ...grpc.CallOption) (*GetCorridorStatusResponse, error) {
+	out := new(GetCorridorStatusResponse)
+	err := c.cc.Invoke(ctx, RoadsService_GetCorridorStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roadsServiceClient) GetProcessingMetrics(ctx context.Context, in *GetProcessingMetricsRequest, opts ...grpc.CallOption) (*ProcessingMetrics, error) {
 	out := new(ProcessingMetrics)
 	err := c.cc.Invoke(ctx, RoadsService_GetProcessingMetrics_FullMethodName, in, out, opts...)
@@ -91,6 +149,74 @@ func (c *roadsServiceClient) ListIncidents(ctx context.Context, in *ListIncident
 	return out, nil
 }
 
+func (c *roadsServiceClient) ListAllAlerts(ctx context.Context, in *ListAllAlertsRequest, opts ...grpc.CallOption) (*ListAllAlertsResponse, error) {
+	out := new(ListAllAlertsResponse)
+	err := c.cc.Invoke(ctx, RoadsService_ListAllAlerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadsServiceClient) StreamRoadUpdates(ctx context.Context, in *StreamRoadUpdatesRequest, opts ...grpc.CallOption) (RoadsService_StreamRoadUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RoadsService_ServiceDesc.Streams[0], RoadsService_StreamRoadUpdates_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roadsServiceStreamRoadUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RoadsService_StreamRoadUpdatesClient interface {
+	Recv() (*ListRoadsResponse, error)
+	grpc.ClientStream
+}
+
+type roadsServiceStreamRoadUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *roadsServiceStreamRoadUpdatesClient) Recv() (*ListRoadsResponse, error) {
+	m := new(ListRoadsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *roadsServiceClient) GetServiceHealth(ctx context.Context, in *GetServiceHealthRequest, opts ...grpc.CallOption) (*GetServiceHealthResponse, error) {
+	out := new(GetServiceHealthResponse)
+	err := c.cc.Invoke(ctx, RoadsService_GetServiceHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadsServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, RoadsService_GetVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roadsServiceClient) ForceRefresh(ctx context.Context, in *ForceRefreshRequest, opts ...grpc.CallOption) (*ForceRefreshResponse, error) {
+	out := new(ForceRefreshResponse)
+	err := c.cc.Invoke(ctx, RoadsService_ForceRefresh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoadsServiceServer is the server API for RoadsService service.
 // All implementations must embed UnimplementedRoadsServiceServer
 // for forward compatibility
@@ -99,7 +225,17 @@ type RoadsServiceServer interface {
 	ListRoads(context.Context, *ListRoadsRequest) (*ListRoadsResponse, error)
 	// GetRoad returns current conditions for a specific road
 	GetRoad(context.Context, *GetRoadRequest) (*GetRoadResponse, error)
-	// GetProcessingMetrics returns alert processing metrics.
+	// GetRouteGeometry returns the geometry behind a road's alert classification:
+	// the encoded route polyline, its origin/destination, and each alert's
+	// location, affected extent and distance to the route. Lets map clients draw
+	// the corridor and closure overlaps that GetRoad keeps internal.
+	GetRouteGeometry(context.Context, *GetRouteGeometryRequest) (*GetRouteGeometryResponse, error)
+	// GetCorridorStatus returns a road's conditions together with the current
+	// weather and active weather alerts along it, for a single "pass status"
+	// view without merging GetRoad and weather calls.
+	GetCorridorStatus(context.Context, *GetCorridorStatusRequest) (*GetCorridorStatusResponse, error)
+	// GetProcessingMetrics returns road-alert AI enhancement metrics, including
+	// token usage and estimated spend, and Google Routes API usage.
 	// Mapped to /api/v1/metrics (not /api/v1/roads/metrics) so it does not collide
 	// with the /api/v1/roads/{road_id} id space.
 	GetProcessingMetrics(context.Context, *GetProcessingMetricsRequest) (*ProcessingMetrics, error)
@@ -111,6 +247,29 @@ type RoadsServiceServer interface {
 	// area as a flat, structured list without per-route classification or AI
 	// enhancement (kept lightweight for a region-wide view).
 	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	// ListAllAlerts returns every road alert across all monitored roads as one
+	// flat feed. An alert that applies to several roads appears once, listing
+	// each road it affects. Sorted by severity (most severe first), then by
+	// distance to the route.
+	ListAllAlerts(context.Context, *ListAllAlertsRequest) (*ListAllAlertsResponse, error)
+	// StreamRoadUpdates pushes road conditions as they change: the current road
+	// set immediately, then a new message whenever a background refresh produces
+	// different data (refreshes that change nothing are not sent). Over HTTP the
+	// gateway emits newline-delimited JSON, one {"result": ...} object per update.
+	StreamRoadUpdates(*StreamRoadUpdatesRequest, RoadsService_StreamRoadUpdatesServer) error
+	// GetServiceHealth reports, per upstream data source (Google Routes,
+	// Caltrans, OpenWeather, NWS), when data was last fetched successfully, how stale
+	// the cached copy is, and the most recent error. Intended for monitoring and
+	// load-balancer readiness checks; never cached.
+	GetServiceHealth(context.Context, *GetServiceHealthRequest) (*GetServiceHealthResponse, error)
+	// GetVersion identifies the running build and the upstream feeds and models
+	// it is configured with, for diagnosing stale data or classification
+	// behavior in the field. Also served at /version.
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// ForceRefresh refetches road data now instead of waiting for the periodic
+	// refresh, for operators debugging classifications. Requires the admin API
+	// key (admin.apiKey) as "Authorization: admin_<key>".
+	ForceRefresh(context.Context, *ForceRefreshRequest) (*ForceRefreshResponse, error)
 	mustEmbedUnimplementedRoadsServiceServer()
 }
 
@@ -124,12 +283,33 @@ func (UnimplementedRoadsServiceServer) ListRoads(context.Context, *ListRoadsRequ
 func (UnimplementedRoadsServiceServer) GetRoad(context.Context, *GetRoadRequest) (*GetRoadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoad not implemented")
 }
+func (UnimplementedRoadsServiceServer) GetRouteGeometry(context.Context, *GetRouteGeometryRequest) (*GetRouteGeometryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteGeometry not implemented")
+}
+func (UnimplementedRoadsServiceServer) GetCorridorStatus(context.Context, *GetCorridorStatusRequest) (*GetCorridorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorridorStatus not implemented")
+}
 func (UnimplementedRoadsServiceServer) GetProcessingMetrics(context.Context, *GetProcessingMetricsRequest) (*ProcessingMetrics, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProcessingMetrics not implemented")
 }
 func (UnimplementedRoadsServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
 }
+func (UnimplementedRoadsServiceServer) ListAllAlerts(context.Context, *ListAllAlertsRequest) (*ListAllAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllAlerts not implemented")
+}
+func (UnimplementedRoadsServiceServer) StreamRoadUpdates(*StreamRoadUpdatesRequest, RoadsService_StreamRoadUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRoadUpdates not implemented")
+}
+func (UnimplementedRoadsServiceServer) GetServiceHealth(context.Context, *GetServiceHealthRequest) (*GetServiceHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceHealth not implemented")
+}
+func (UnimplementedRoadsServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedRoadsServiceServer) ForceRefresh(context.Context, *ForceRefreshRequest) (*ForceRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceRefresh not implemented")
+}
 func (UnimplementedRoadsServiceServer) mustEmbedUnimplementedRoadsServiceServer() {}
 
 // UnsafeRoadsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -179,6 +359,42 @@ func _RoadsService_GetRoad_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoadsService_GetRouteGeometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteGeometryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).GetRouteGeometry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_GetRouteGeometry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).GetRouteGeometry(ctx, req.(*GetRouteGeometryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadsService_GetCorridorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCorridorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).GetCorridorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_GetCorridorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).GetCorridorStatus(ctx, req.(*GetCorridorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoadsService_GetProcessingMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProcessingMetricsRequest)
 	if err := dec(in); err != nil {
@@ -215,6 +431,99 @@ func _RoadsService_ListIncidents_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoadsService_ListAllAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).ListAllAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_ListAllAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).ListAllAlerts(ctx, req.(*ListAllAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadsService_StreamRoadUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRoadUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoadsServiceServer).StreamRoadUpdates(m, &roadsServiceStreamRoadUpdatesServer{stream})
+}
+
+type RoadsService_StreamRoadUpdatesServer interface {
+	Send(*ListRoadsResponse) error
+	grpc.ServerStream
+}
+
+type roadsServiceStreamRoadUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *roadsServiceStreamRoadUpdatesServer) Send(m *ListRoadsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RoadsService_GetServiceHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).GetServiceHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_GetServiceHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).GetServiceHealth(ctx, req.(*GetServiceHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadsService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoadsService_ForceRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoadsServiceServer).ForceRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoadsService_ForceRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoadsServiceServer).ForceRefresh(ctx, req.(*ForceRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoadsService_ServiceDesc is the grpc.ServiceDesc for RoadsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +539,14 @@ var RoadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoad",
 			Handler:    _RoadsService_GetRoad_Handler,
 		},
+		{
+			MethodName: "GetRouteGeometry",
+			Handler:    _RoadsService_GetRouteGeometry_Handler,
+		},
+		{
+			MethodName: "GetCorridorStatus",
+			Handler:    _RoadsService_GetCorridorStatus_Handler,
+		},
 		{
 			MethodName: "GetProcessingMetrics",
 			Handler:    _RoadsService_GetProcessingMetrics_Handler,
@@ -238,7 +555,29 @@ var RoadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIncidents",
 			Handler:    _RoadsService_ListIncidents_Handler,
 		},
+		{
+			MethodName: "ListAllAlerts",
+			Handler:    _RoadsService_ListAllAlerts_Handler,
+		},
+		{
+			MethodName: "GetServiceHealth",
+			Handler:    _RoadsService_GetServiceHealth_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _RoadsService_GetVersion_Handler,
+		},
+		{
+			MethodName: "ForceRefresh",
+			Handler:    _RoadsService_ForceRefresh_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRoadUpdates",
+			Handler:       _RoadsService_StreamRoadUpdates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "roads.proto",
 }
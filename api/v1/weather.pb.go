@@ -23,6 +23,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// WeatherAlertCategory groups weather alerts by the hazard they warn of.
+type WeatherAlertCategory int32
+
+const (
+	WeatherAlertCategory_WEATHER_ALERT_CATEGORY_UNSPECIFIED WeatherAlertCategory = 0 // Any other hazard (heat, wind, flooding, ...)
+	WeatherAlertCategory_WINTER                             WeatherAlertCategory = 1 // Winter storms, blizzards, ice storms, freezing rain
+)
+
+// Enum value maps for WeatherAlertCategory.
+var (
+	WeatherAlertCategory_name = map[int32]string{
+		0: "WEATHER_ALERT_CATEGORY_UNSPECIFIED",
+		1: "WINTER",
+	}
+	WeatherAlertCategory_value = map[string]int32{
+		"WEATHER_ALERT_CATEGORY_UNSPECIFIED": 0,
+		"WINTER":                             1,
+	}
+)
+
+func (x WeatherAlertCategory) Enum() *WeatherAlertCategory {
+	p := new(WeatherAlertCategory)
+	*p = x
+	return p
+}
+
+func (x WeatherAlertCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WeatherAlertCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[0].Descriptor()
+}
+
+func (WeatherAlertCategory) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[0]
+}
+
+func (x WeatherAlertCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WeatherAlertCategory.Descriptor instead.
+func (WeatherAlertCategory) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{0}
+}
+
 // Request messages
 type ListWeatherRequest struct {
 	state         protoimpl.MessageState
@@ -109,6 +156,116 @@ func (x *GetLocationWeatherRequest) GetLocationId() string {
 	return ""
 }
 
+type GetLocationForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId    string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	IncludeHourly bool   `protobuf:"varint,2,opt,name=include_hourly,json=includeHourly,proto3" json:"include_hourly,omitempty"` // Only return the hourly forecast (unless include_daily is also set)
+	IncludeDaily  bool   `protobuf:"varint,3,opt,name=include_daily,json=includeDaily,proto3" json:"include_daily,omitempty"`    // Only return the daily forecast (unless include_hourly is also set)
+}
+
+func (x *GetLocationForecastRequest) Reset() {
+	*x = GetLocationForecastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocationForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationForecastRequest) ProtoMessage() {}
+
+func (x *GetLocationForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationForecastRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLocationForecastRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetLocationForecastRequest) GetIncludeHourly() bool {
+	if x != nil {
+		return x.IncludeHourly
+	}
+	return false
+}
+
+func (x *GetLocationForecastRequest) GetIncludeDaily() bool {
+	if x != nil {
+		return x.IncludeDaily
+	}
+	return false
+}
+
+type GetLocationAirQualityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+}
+
+func (x *GetLocationAirQualityRequest) Reset() {
+	*x = GetLocationAirQualityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocationAirQualityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationAirQualityRequest) ProtoMessage() {}
+
+func (x *GetLocationAirQualityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationAirQualityRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationAirQualityRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLocationAirQualityRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
 type ListWeatherAlertsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -124,7 +281,7 @@ type ListWeatherAlertsRequest struct {
 func (x *ListWeatherAlertsRequest) Reset() {
 	*x = ListWeatherAlertsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[2]
+		mi := &file_weather_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -137,7 +294,7 @@ func (x *ListWeatherAlertsRequest) String() string {
 func (*ListWeatherAlertsRequest) ProtoMessage() {}
 
 func (x *ListWeatherAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[2]
+	mi := &file_weather_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,7 +307,7 @@ func (x *ListWeatherAlertsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWeatherAlertsRequest.ProtoReflect.Descriptor instead.
 func (*ListWeatherAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{2}
+	return file_weather_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListWeatherAlertsRequest) GetZones() []string {
@@ -174,7 +331,7 @@ type ListWeatherResponse struct {
 func (x *ListWeatherResponse) Reset() {
 	*x = ListWeatherResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[3]
+		mi := &file_weather_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -187,7 +344,7 @@ func (x *ListWeatherResponse) String() string {
 func (*ListWeatherResponse) ProtoMessage() {}
 
 func (x *ListWeatherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[3]
+	mi := &file_weather_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +357,7 @@ func (x *ListWeatherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWeatherResponse.ProtoReflect.Descriptor instead.
 func (*ListWeatherResponse) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{3}
+	return file_weather_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListWeatherResponse) GetWeatherData() []*WeatherData {
@@ -221,36 +378,494 @@ func (x *ListWeatherResponse) GetFireWeather() *FireWeather {
 	if x != nil {
 		return x.FireWeather
 	}
-	return nil
+	return nil
+}
+
+type GetLocationWeatherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeatherData *WeatherData           `protobuf:"bytes,1,opt,name=weather_data,json=weatherData,proto3" json:"weather_data,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	FireWeather *FireWeather           `protobuf:"bytes,3,opt,name=fire_weather,json=fireWeather,proto3" json:"fire_weather,omitempty"` // Region-wide fire-weather classification (NWS-derived)
+}
+
+func (x *GetLocationWeatherResponse) Reset() {
+	*x = GetLocationWeatherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocationWeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationWeatherResponse) ProtoMessage() {}
+
+func (x *GetLocationWeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationWeatherResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationWeatherResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLocationWeatherResponse) GetWeatherData() *WeatherData {
+	if x != nil {
+		return x.WeatherData
+	}
+	return nil
+}
+
+func (x *GetLocationWeatherResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *GetLocationWeatherResponse) GetFireWeather() *FireWeather {
+	if x != nil {
+		return x.FireWeather
+	}
+	return nil
+}
+
+type GetLocationForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId   string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName string                 `protobuf:"bytes,2,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	Forecast     *WeatherForecast       `protobuf:"bytes,3,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	LastUpdated  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *GetLocationForecastResponse) Reset() {
+	*x = GetLocationForecastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocationForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationForecastResponse) ProtoMessage() {}
+
+func (x *GetLocationForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationForecastResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLocationForecastResponse) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetLocationForecastResponse) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *GetLocationForecastResponse) GetForecast() *WeatherForecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (x *GetLocationForecastResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type GetLocationAirQualityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId   string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName string                 `protobuf:"bytes,2,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	AirQuality   *AirQuality            `protobuf:"bytes,3,opt,name=air_quality,json=airQuality,proto3" json:"air_quality,omitempty"`
+	LastUpdated  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *GetLocationAirQualityResponse) Reset() {
+	*x = GetLocationAirQualityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocationAirQualityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationAirQualityResponse) ProtoMessage() {}
+
+func (x *GetLocationAirQualityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationAirQualityResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationAirQualityResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLocationAirQualityResponse) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetLocationAirQualityResponse) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *GetLocationAirQualityResponse) GetAirQuality() *AirQuality {
+	if x != nil {
+		return x.AirQuality
+	}
+	return nil
+}
+
+func (x *GetLocationAirQualityResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type ListWeatherAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts      []*WeatherAlert        `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *ListWeatherAlertsResponse) Reset() {
+	*x = ListWeatherAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWeatherAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWeatherAlertsResponse) ProtoMessage() {}
+
+func (x *ListWeatherAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWeatherAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListWeatherAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListWeatherAlertsResponse) GetAlerts() []*WeatherAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ListWeatherAlertsResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+// Data models
+type WeatherData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId           string          `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName         string          `protobuf:"bytes,2,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	WeatherMain          string          `protobuf:"bytes,3,opt,name=weather_main,json=weatherMain,proto3" json:"weather_main,omitempty"`                                // "Clear", "Rain", "Snow", etc.
+	WeatherDescription   string          `protobuf:"bytes,4,opt,name=weather_description,json=weatherDescription,proto3" json:"weather_description,omitempty"`           // "light rain", "clear sky", etc.
+	WeatherIcon          string          `protobuf:"bytes,5,opt,name=weather_icon,json=weatherIcon,proto3" json:"weather_icon,omitempty"`                                // Icon code for display
+	TemperatureCelsius   int32           `protobuf:"varint,6,opt,name=temperature_celsius,json=temperatureCelsius,proto3" json:"temperature_celsius,omitempty"`          // Temperature in Celsius (rounded)
+	FeelsLikeCelsius     int32           `protobuf:"varint,7,opt,name=feels_like_celsius,json=feelsLikeCelsius,proto3" json:"feels_like_celsius,omitempty"`              // Feels like temperature in Celsius (rounded)
+	HumidityPercent      int32           `protobuf:"varint,8,opt,name=humidity_percent,json=humidityPercent,proto3" json:"humidity_percent,omitempty"`                   // Humidity percentage (0-100)
+	WindSpeedKmh         int32           `protobuf:"varint,9,opt,name=wind_speed_kmh,json=windSpeedKmh,proto3" json:"wind_speed_kmh,omitempty"`                          // Wind speed in km/h (more user-friendly)
+	WindDirectionDegrees int32           `protobuf:"varint,10,opt,name=wind_direction_degrees,json=windDirectionDegrees,proto3" json:"wind_direction_degrees,omitempty"` // Wind direction in degrees (0-360)
+	VisibilityKm         int32           `protobuf:"varint,11,opt,name=visibility_km,json=visibilityKm,proto3" json:"visibility_km,omitempty"`                           // Visibility distance in kilometers
+	Alerts               []*WeatherAlert `protobuf:"bytes,12,rep,name=alerts,proto3" json:"alerts,omitempty"`                                                            // Active weather alerts
+	// Unit system of the unrounded values below: "metric" (°C, m/s), "imperial"
+	// (°F, mph) or "standard" (K, m/s). The *_celsius / *_kmh fields above are
+	// always metric regardless.
+	Units       string  `protobuf:"bytes,14,opt,name=units,proto3" json:"units,omitempty"`
+	Temperature float32 `protobuf:"fixed32,15,opt,name=temperature,proto3" json:"temperature,omitempty"`              // Temperature in `units`
+	FeelsLike   float32 `protobuf:"fixed32,16,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"` // Feels like temperature in `units`
+	WindSpeed   float32 `protobuf:"fixed32,17,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"` // Wind speed in `units`
+	// Ground conditions for the passes. Snowfall is 0 when not reported; wind
+	// gust and dew point are unset, since 0 is a real reading for either.
+	SnowLastHourMm  float32 `protobuf:"fixed32,18,opt,name=snow_last_hour_mm,json=snowLastHourMm,proto3" json:"snow_last_hour_mm,omitempty"`       // Snowfall over the last hour in mm
+	WindGustKmh     *int32  `protobuf:"varint,19,opt,name=wind_gust_kmh,json=windGustKmh,proto3,oneof" json:"wind_gust_kmh,omitempty"`             // Wind gust in km/h
+	DewPointCelsius *int32  `protobuf:"varint,20,opt,name=dew_point_celsius,json=dewPointCelsius,proto3,oneof" json:"dew_point_celsius,omitempty"` // Dew point in Celsius (rounded); at or below freezing, frost and black ice can form
+}
+
+func (x *WeatherData) Reset() {
+	*x = WeatherData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherData) ProtoMessage() {}
+
+func (x *WeatherData) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherData.ProtoReflect.Descriptor instead.
+func (*WeatherData) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WeatherData) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *WeatherData) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *WeatherData) GetWeatherMain() string {
+	if x != nil {
+		return x.WeatherMain
+	}
+	return ""
+}
+
+func (x *WeatherData) GetWeatherDescription() string {
+	if x != nil {
+		return x.WeatherDescription
+	}
+	return ""
+}
+
+func (x *WeatherData) GetWeatherIcon() string {
+	if x != nil {
+		return x.WeatherIcon
+	}
+	return ""
+}
+
+func (x *WeatherData) GetTemperatureCelsius() int32 {
+	if x != nil {
+		return x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *WeatherData) GetFeelsLikeCelsius() int32 {
+	if x != nil {
+		return x.FeelsLikeCelsius
+	}
+	return 0
+}
+
+func (x *WeatherData) GetHumidityPercent() int32 {
+	if x != nil {
+		return x.HumidityPercent
+	}
+	return 0
+}
+
+func (x *WeatherData) GetWindSpeedKmh() int32 {
+	if x != nil {
+		return x.WindSpeedKmh
+	}
+	return 0
+}
+
+func (x *WeatherData) GetWindDirectionDegrees() int32 {
+	if x != nil {
+		return x.WindDirectionDegrees
+	}
+	return 0
+}
+
+func (x *WeatherData) GetVisibilityKm() int32 {
+	if x != nil {
+		return x.VisibilityKm
+	}
+	return 0
+}
+
+func (x *WeatherData) GetAlerts() []*WeatherAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *WeatherData) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *WeatherData) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *WeatherData) GetFeelsLike() float32 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *WeatherData) GetWindSpeed() float32 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *WeatherData) GetSnowLastHourMm() float32 {
+	if x != nil {
+		return x.SnowLastHourMm
+	}
+	return 0
+}
+
+func (x *WeatherData) GetWindGustKmh() int32 {
+	if x != nil && x.WindGustKmh != nil {
+		return *x.WindGustKmh
+	}
+	return 0
+}
+
+func (x *WeatherData) GetDewPointCelsius() int32 {
+	if x != nil && x.DewPointCelsius != nil {
+		return *x.DewPointCelsius
+	}
+	return 0
 }
 
-type GetLocationWeatherResponse struct {
+// WeatherForecast holds upcoming conditions at a location. Either list may be
+// empty when it was not requested.
+type WeatherForecast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WeatherData *WeatherData           `protobuf:"bytes,1,opt,name=weather_data,json=weatherData,proto3" json:"weather_data,omitempty"`
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	FireWeather *FireWeather           `protobuf:"bytes,3,opt,name=fire_weather,json=fireWeather,proto3" json:"fire_weather,omitempty"` // Region-wide fire-weather classification (NWS-derived)
+	Hourly []*ForecastPeriod `protobuf:"bytes,1,rep,name=hourly,proto3" json:"hourly,omitempty"` // Next 48 hours, one entry per hour
+	Daily  []*ForecastPeriod `protobuf:"bytes,2,rep,name=daily,proto3" json:"daily,omitempty"`   // Next 8 days, one entry per day
 }
 
-func (x *GetLocationWeatherResponse) Reset() {
-	*x = GetLocationWeatherResponse{}
+func (x *WeatherForecast) Reset() {
+	*x = WeatherForecast{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[4]
+		mi := &file_weather_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLocationWeatherResponse) String() string {
+func (x *WeatherForecast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLocationWeatherResponse) ProtoMessage() {}
+func (*WeatherForecast) ProtoMessage() {}
 
-func (x *GetLocationWeatherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[4]
+func (x *WeatherForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,58 +876,61 @@ func (x *GetLocationWeatherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLocationWeatherResponse.ProtoReflect.Descriptor instead.
-func (*GetLocationWeatherResponse) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetLocationWeatherResponse) GetWeatherData() *WeatherData {
-	if x != nil {
-		return x.WeatherData
-	}
-	return nil
+// Deprecated: Use WeatherForecast.ProtoReflect.Descriptor instead.
+func (*WeatherForecast) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetLocationWeatherResponse) GetLastUpdated() *timestamppb.Timestamp {
+func (x *WeatherForecast) GetHourly() []*ForecastPeriod {
 	if x != nil {
-		return x.LastUpdated
+		return x.Hourly
 	}
 	return nil
 }
 
-func (x *GetLocationWeatherResponse) GetFireWeather() *FireWeather {
+func (x *WeatherForecast) GetDaily() []*ForecastPeriod {
 	if x != nil {
-		return x.FireWeather
+		return x.Daily
 	}
 	return nil
 }
 
-type ListWeatherAlertsResponse struct {
+// ForecastPeriod is the forecast for one hour or one day.
+type ForecastPeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Alerts      []*WeatherAlert        `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-}
-
-func (x *ListWeatherAlertsResponse) Reset() {
-	*x = ListWeatherAlertsResponse{}
+	Time                            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                                                                                 // Start of the hour / day (forecast reference time)
+	TemperatureCelsius              int32                  `protobuf:"varint,2,opt,name=temperature_celsius,json=temperatureCelsius,proto3" json:"temperature_celsius,omitempty"`                                          // Hourly temperature; for daily periods the daytime temperature
+	TemperatureMinCelsius           int32                  `protobuf:"varint,3,opt,name=temperature_min_celsius,json=temperatureMinCelsius,proto3" json:"temperature_min_celsius,omitempty"`                               // Daily low (daily periods only)
+	TemperatureMaxCelsius           int32                  `protobuf:"varint,4,opt,name=temperature_max_celsius,json=temperatureMaxCelsius,proto3" json:"temperature_max_celsius,omitempty"`                               // Daily high (daily periods only)
+	PrecipitationProbabilityPercent int32                  `protobuf:"varint,5,opt,name=precipitation_probability_percent,json=precipitationProbabilityPercent,proto3" json:"precipitation_probability_percent,omitempty"` // Chance of precipitation (0-100)
+	WindSpeedKmh                    int32                  `protobuf:"varint,6,opt,name=wind_speed_kmh,json=windSpeedKmh,proto3" json:"wind_speed_kmh,omitempty"`                                                          // Wind speed in km/h
+	WindDirectionDegrees            int32                  `protobuf:"varint,7,opt,name=wind_direction_degrees,json=windDirectionDegrees,proto3" json:"wind_direction_degrees,omitempty"`                                  // Wind direction in degrees (0-360)
+	WeatherMain                     string                 `protobuf:"bytes,8,opt,name=weather_main,json=weatherMain,proto3" json:"weather_main,omitempty"`                                                                // "Clear", "Rain", "Snow", etc.
+	WeatherDescription              string                 `protobuf:"bytes,9,opt,name=weather_description,json=weatherDescription,proto3" json:"weather_description,omitempty"`                                           // "light rain", "clear sky", etc.
+	WeatherIcon                     string                 `protobuf:"bytes,10,opt,name=weather_icon,json=weatherIcon,proto3" json:"weather_icon,omitempty"`                                                               // Icon code for display
+	Summary                         string                 `protobuf:"bytes,11,opt,name=summary,proto3" json:"summary,omitempty"`                                                                                          // Human-readable day summary (daily periods only)
+}
+
+func (x *ForecastPeriod) Reset() {
+	*x = ForecastPeriod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[5]
+		mi := &file_weather_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWeatherAlertsResponse) String() string {
+func (x *ForecastPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWeatherAlertsResponse) ProtoMessage() {}
+func (*ForecastPeriod) ProtoMessage() {}
 
-func (x *ListWeatherAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[5]
+func (x *ForecastPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,157 +941,173 @@ func (x *ListWeatherAlertsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWeatherAlertsResponse.ProtoReflect.Descriptor instead.
-func (*ListWeatherAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ForecastPeriod.ProtoReflect.Descriptor instead.
+func (*ForecastPeriod) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListWeatherAlertsResponse) GetAlerts() []*WeatherAlert {
+func (x *ForecastPeriod) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Alerts
+		return x.Time
 	}
 	return nil
 }
 
-func (x *ListWeatherAlertsResponse) GetLastUpdated() *timestamppb.Timestamp {
+func (x *ForecastPeriod) GetTemperatureCelsius() int32 {
 	if x != nil {
-		return x.LastUpdated
+		return x.TemperatureCelsius
 	}
-	return nil
-}
-
-// Data models
-type WeatherData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LocationId           string          `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	LocationName         string          `protobuf:"bytes,2,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
-	WeatherMain          string          `protobuf:"bytes,3,opt,name=weather_main,json=weatherMain,proto3" json:"weather_main,omitempty"`                                // "Clear", "Rain", "Snow", etc.
-	WeatherDescription   string          `protobuf:"bytes,4,opt,name=weather_description,json=weatherDescription,proto3" json:"weather_description,omitempty"`           // "light rain", "clear sky", etc.
-	WeatherIcon          string          `protobuf:"bytes,5,opt,name=weather_icon,json=weatherIcon,proto3" json:"weather_icon,omitempty"`                                // Icon code for display
-	TemperatureCelsius   int32           `protobuf:"varint,6,opt,name=temperature_celsius,json=temperatureCelsius,proto3" json:"temperature_celsius,omitempty"`          // Temperature in Celsius (rounded)
-	FeelsLikeCelsius     int32           `protobuf:"varint,7,opt,name=feels_like_celsius,json=feelsLikeCelsius,proto3" json:"feels_like_celsius,omitempty"`              // Feels like temperature in Celsius (rounded)
-	HumidityPercent      int32           `protobuf:"varint,8,opt,name=humidity_percent,json=humidityPercent,proto3" json:"humidity_percent,omitempty"`                   // Humidity percentage (0-100)
-	WindSpeedKmh         int32           `protobuf:"varint,9,opt,name=wind_speed_kmh,json=windSpeedKmh,proto3" json:"wind_speed_kmh,omitempty"`                          // Wind speed in km/h (more user-friendly)
-	WindDirectionDegrees int32           `protobuf:"varint,10,opt,name=wind_direction_degrees,json=windDirectionDegrees,proto3" json:"wind_direction_degrees,omitempty"` // Wind direction in degrees (0-360)
-	VisibilityKm         int32           `protobuf:"varint,11,opt,name=visibility_km,json=visibilityKm,proto3" json:"visibility_km,omitempty"`                           // Visibility distance in kilometers
-	Alerts               []*WeatherAlert `protobuf:"bytes,12,rep,name=alerts,proto3" json:"alerts,omitempty"`                                                            // Active weather alerts
+	return 0
 }
 
-func (x *WeatherData) Reset() {
-	*x = WeatherData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ForecastPeriod) GetTemperatureMinCelsius() int32 {
+	if x != nil {
+		return x.TemperatureMinCelsius
 	}
+	return 0
 }
 
-func (x *WeatherData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WeatherData) ProtoMessage() {}
-
-func (x *WeatherData) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ForecastPeriod) GetTemperatureMaxCelsius() int32 {
+	if x != nil {
+		return x.TemperatureMaxCelsius
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WeatherData.ProtoReflect.Descriptor instead.
-func (*WeatherData) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{6}
+func (x *ForecastPeriod) GetPrecipitationProbabilityPercent() int32 {
+	if x != nil {
+		return x.PrecipitationProbabilityPercent
+	}
+	return 0
 }
 
-func (x *WeatherData) GetLocationId() string {
+func (x *ForecastPeriod) GetWindSpeedKmh() int32 {
 	if x != nil {
-		return x.LocationId
+		return x.WindSpeedKmh
 	}
-	return ""
+	return 0
 }
 
-func (x *WeatherData) GetLocationName() string {
+func (x *ForecastPeriod) GetWindDirectionDegrees() int32 {
 	if x != nil {
-		return x.LocationName
+		return x.WindDirectionDegrees
 	}
-	return ""
+	return 0
 }
 
-func (x *WeatherData) GetWeatherMain() string {
+func (x *ForecastPeriod) GetWeatherMain() string {
 	if x != nil {
 		return x.WeatherMain
 	}
 	return ""
 }
 
-func (x *WeatherData) GetWeatherDescription() string {
+func (x *ForecastPeriod) GetWeatherDescription() string {
 	if x != nil {
 		return x.WeatherDescription
 	}
 	return ""
 }
 
-func (x *WeatherData) GetWeatherIcon() string {
+func (x *ForecastPeriod) GetWeatherIcon() string {
 	if x != nil {
 		return x.WeatherIcon
 	}
 	return ""
 }
 
-func (x *WeatherData) GetTemperatureCelsius() int32 {
+func (x *ForecastPeriod) GetSummary() string {
 	if x != nil {
-		return x.TemperatureCelsius
+		return x.Summary
 	}
-	return 0
+	return ""
 }
 
-func (x *WeatherData) GetFeelsLikeCelsius() int32 {
+// AirQuality is the current air-pollution reading at a location. Wildfire
+// smoke shows up primarily as elevated PM2.5.
+type AirQuality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aqi        int32                  `protobuf:"varint,1,opt,name=aqi,proto3" json:"aqi,omitempty"`                                // OpenWeatherMap index: 1 Good, 2 Fair, 3 Moderate, 4 Poor, 5 Very Poor
+	AqiLabel   string                 `protobuf:"bytes,2,opt,name=aqi_label,json=aqiLabel,proto3" json:"aqi_label,omitempty"`       // "Good", "Fair", "Moderate", "Poor", "Very Poor"
+	Pm25       float32                `protobuf:"fixed32,3,opt,name=pm25,proto3" json:"pm25,omitempty"`                             // PM2.5 concentration (μg/m³)
+	Pm10       float32                `protobuf:"fixed32,4,opt,name=pm10,proto3" json:"pm10,omitempty"`                             // PM10 concentration (μg/m³)
+	Ozone      float32                `protobuf:"fixed32,5,opt,name=ozone,proto3" json:"ozone,omitempty"`                           // O3 concentration (μg/m³)
+	MeasuredAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"` // Time of the reading
+}
+
+func (x *AirQuality) Reset() {
+	*x = AirQuality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirQuality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirQuality) ProtoMessage() {}
+
+func (x *AirQuality) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirQuality.ProtoReflect.Descriptor instead.
+func (*AirQuality) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AirQuality) GetAqi() int32 {
 	if x != nil {
-		return x.FeelsLikeCelsius
+		return x.Aqi
 	}
 	return 0
 }
 
-func (x *WeatherData) GetHumidityPercent() int32 {
+func (x *AirQuality) GetAqiLabel() string {
 	if x != nil {
-		return x.HumidityPercent
+		return x.AqiLabel
 	}
-	return 0
+	return ""
 }
 
-func (x *WeatherData) GetWindSpeedKmh() int32 {
+func (x *AirQuality) GetPm25() float32 {
 	if x != nil {
-		return x.WindSpeedKmh
+		return x.Pm25
 	}
 	return 0
 }
 
-func (x *WeatherData) GetWindDirectionDegrees() int32 {
+func (x *AirQuality) GetPm10() float32 {
 	if x != nil {
-		return x.WindDirectionDegrees
+		return x.Pm10
 	}
 	return 0
 }
 
-func (x *WeatherData) GetVisibilityKm() int32 {
+func (x *AirQuality) GetOzone() float32 {
 	if x != nil {
-		return x.VisibilityKm
+		return x.Ozone
 	}
 	return 0
 }
 
-func (x *WeatherData) GetAlerts() []*WeatherAlert {
+func (x *AirQuality) GetMeasuredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Alerts
+		return x.MeasuredAt
 	}
 	return nil
 }
@@ -498,7 +1132,7 @@ type FireWeather struct {
 func (x *FireWeather) Reset() {
 	*x = FireWeather{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[7]
+		mi := &file_weather_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -511,7 +1145,7 @@ func (x *FireWeather) String() string {
 func (*FireWeather) ProtoMessage() {}
 
 func (x *FireWeather) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[7]
+	mi := &file_weather_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +1158,7 @@ func (x *FireWeather) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FireWeather.ProtoReflect.Descriptor instead.
 func (*FireWeather) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{7}
+	return file_weather_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FireWeather) GetState() FireWeatherState {
@@ -591,17 +1225,18 @@ type WeatherAlert struct {
 	Summary  string `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`   // 2-3 sentences, plain text, traveler-focused
 	Details  string `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`  // Full description with minimal markdown formatting
 	// Provenance / NWS fields
-	Source    AlertSource            `protobuf:"varint,11,opt,name=source,proto3,enum=api.v1.AlertSource" json:"source,omitempty"`       // Which upstream feed produced the alert
-	Severity  AlertSeverity          `protobuf:"varint,12,opt,name=severity,proto3,enum=api.v1.AlertSeverity" json:"severity,omitempty"` // Severity (NWS levels mapped onto the shared scale)
-	Zones     []string               `protobuf:"bytes,13,rep,name=zones,proto3" json:"zones,omitempty"`                                  // NWS forecast zones this alert applies to (e.g. "CAZ064")
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`         // When the alert becomes effective
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`               // When the alert expires
+	Source    AlertSource            `protobuf:"varint,11,opt,name=source,proto3,enum=api.v1.AlertSource" json:"source,omitempty"`              // Which upstream feed produced the alert
+	Severity  AlertSeverity          `protobuf:"varint,12,opt,name=severity,proto3,enum=api.v1.AlertSeverity" json:"severity,omitempty"`        // Severity (NWS levels mapped onto the shared scale)
+	Zones     []string               `protobuf:"bytes,13,rep,name=zones,proto3" json:"zones,omitempty"`                                         // NWS forecast zones this alert applies to (e.g. "CAZ064")
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                // When the alert becomes effective
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                      // When the alert expires
+	Category  WeatherAlertCategory   `protobuf:"varint,16,opt,name=category,proto3,enum=api.v1.WeatherAlertCategory" json:"category,omitempty"` // WINTER for snow and ice hazards that bring chain control
 }
 
 func (x *WeatherAlert) Reset() {
 	*x = WeatherAlert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weather_proto_msgTypes[8]
+		mi := &file_weather_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -614,7 +1249,7 @@ func (x *WeatherAlert) String() string {
 func (*WeatherAlert) ProtoMessage() {}
 
 func (x *WeatherAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[8]
+	mi := &file_weather_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +1262,7 @@ func (x *WeatherAlert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherAlert.ProtoReflect.Descriptor instead.
 func (*WeatherAlert) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{8}
+	return file_weather_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *WeatherAlert) GetId() string {
@@ -721,6 +1356,13 @@ func (x *WeatherAlert) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *WeatherAlert) GetCategory() WeatherAlertCategory {
+	if x != nil {
+		return x.Category
+	}
+	return WeatherAlertCategory_WEATHER_ALERT_CATEGORY_UNSPECIFIED
+}
+
 var File_weather_proto protoreflect.FileDescriptor
 
 var file_weather_proto_rawDesc = []byte{
@@ -738,170 +1380,308 @@ var file_weather_proto_rawDesc = []byte{
 	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x77,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x72,
-	0x65, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x22, 0xcb, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x77, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x65, 0x5f,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68,
-	0x65, 0x72, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22,
-	0x88, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41,
-	0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c,
-	0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x97, 0x04, 0x0a, 0x0b, 0x57,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4d,
-	0x61, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f,
-	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x6c,
-	0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-	0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f,
-	0x6b, 0x6d, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x53,
-	0x70, 0x65, 0x65, 0x64, 0x4b, 0x6d, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x69, 0x6e, 0x64, 0x5f,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x77, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x6d, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73,
-	0x4a, 0x04, 0x08, 0x0d, 0x10, 0x0e, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72,
-	0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x34,
-	0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xef, 0x03, 0x0a, 0x0c, 0x57,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08,
-	0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x22, 0x3f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x69, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0xcb,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x0f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x32, 0xf0, 0x02, 0x0a,
-	0x0e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
-	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
-	0x12, 0x82, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x61, 0x74,
-	0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52,
+	0x0b, 0x66, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0xd7, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x69, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33,
+	0x0a, 0x0b, 0x61, 0x69, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x69, 0x72,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xba, 0x06,
+	0x0a, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x66,
+	0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x75, 0x6d,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x5f, 0x6b, 0x6d, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69,
+	0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x6d, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x69,
+	0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x77, 0x69, 0x6e, 0x64,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6b,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x6e, 0x6f,
+	0x77, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x6d, 0x6d, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x6e, 0x6f, 0x77, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x6f,
+	0x75, 0x72, 0x4d, 0x6d, 0x12, 0x27, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x73,
+	0x74, 0x5f, 0x6b, 0x6d, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x47, 0x75, 0x73, 0x74, 0x4b, 0x6d, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x64, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x77, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x73, 0x74, 0x5f, 0x6b, 0x6d, 0x68,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63,
+	0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x4a, 0x04, 0x08, 0x0d, 0x10, 0x0e, 0x52, 0x0c, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x0f, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x22, 0x9a, 0x04, 0x0a, 0x0e,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2f,
+	0x0a, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e,
+	0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x61, 0x78, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12,
+	0x4a, 0x0a, 0x21, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1f, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x77,
+	0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x6d, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x6d,
+	0x68, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x14, 0x77, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x41, 0x69, 0x72,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x71, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x71, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x71, 0x69,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x71,
+	0x69, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6d, 0x32, 0x35, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x70, 0x6d, 0x32, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6d,
+	0x31, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x70, 0x6d, 0x31, 0x30, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xa9, 0x04, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2a, 0x4a, 0x0a, 0x14, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x22, 0x57,
+	0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x32,
+	0x9b, 0x05, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x69, 0x72, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x69, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x69,
+	0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x7b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x69, 0x72, 0x2d, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41,
-	0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x42,
-	0xa9, 0x02, 0x92, 0x41, 0xf8, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x12, 0x43, 0x52, 0x65, 0x61,
-	0x6c, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c,
-	0x65, 0x72, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x62, 0x62,
-	0x65, 0x74, 0x74, 0x73, 0x20, 0x50, 0x61, 0x73, 0x73, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x22, 0x29, 0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x15, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x69, 0x6e,
-	0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x32, 0x03, 0x31, 0x2e, 0x30,
-	0x2a, 0x02, 0x02, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x1b, 0x4d, 0x6f, 0x72, 0x65,
-	0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f,
-	0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
-	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70, 0x75, 0x70,
-	0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x5a, 0x2b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70, 0x75, 0x70, 0x2f,
-	0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x42, 0xa9, 0x02,
+	0x92, 0x41, 0xf8, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x12, 0x43, 0x52, 0x65, 0x61, 0x6c, 0x2d,
+	0x74, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x62, 0x62, 0x65, 0x74,
+	0x74, 0x73, 0x20, 0x50, 0x61, 0x73, 0x73, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x29,
+	0x0a, 0x10, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x15, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x69, 0x6e, 0x66, 0x6f,
+	0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02,
+	0x02, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x1b, 0x4d, 0x6f, 0x72, 0x65, 0x20, 0x61,
+	0x62, 0x6f, 0x75, 0x74, 0x20, 0x45, 0x52, 0x53, 0x4e, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70, 0x75, 0x70, 0x2f, 0x69,
+	0x6e, 0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x5a, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x70, 0x75, 0x70, 0x2f, 0x69, 0x6e,
+	0x66, 0x6f, 0x2e, 0x65, 0x72, 0x73, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -916,50 +1696,72 @@ func file_weather_proto_rawDescGZIP() []byte {
 	return file_weather_proto_rawDescData
 }
 
-var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_weather_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_weather_proto_goTypes = []interface{}{
-	(*ListWeatherRequest)(nil),         // 0: api.v1.ListWeatherRequest
-	(*GetLocationWeatherRequest)(nil),  // 1: api.v1.GetLocationWeatherRequest
-	(*ListWeatherAlertsRequest)(nil),   // 2: api.v1.ListWeatherAlertsRequest
-	(*ListWeatherResponse)(nil),        // 3: api.v1.ListWeatherResponse
-	(*GetLocationWeatherResponse)(nil), // 4: api.v1.GetLocationWeatherResponse
-	(*ListWeatherAlertsResponse)(nil),  // 5: api.v1.ListWeatherAlertsResponse
-	(*WeatherData)(nil),                // 6: api.v1.WeatherData
-	(*FireWeather)(nil),                // 7: api.v1.FireWeather
-	(*WeatherAlert)(nil),               // 8: api.v1.WeatherAlert
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
-	(FireWeatherState)(0),              // 10: api.v1.FireWeatherState
-	(AlertSource)(0),                   // 11: api.v1.AlertSource
-	(AlertSeverity)(0),                 // 12: api.v1.AlertSeverity
+	(WeatherAlertCategory)(0),             // 0: api.v1.WeatherAlertCategory
+	(*ListWeatherRequest)(nil),            // 1: api.v1.ListWeatherRequest
+	(*GetLocationWeatherRequest)(nil),     // 2: api.v1.GetLocationWeatherRequest
+	(*GetLocationForecastRequest)(nil),    // 3: api.v1.GetLocationForecastRequest
+	(*GetLocationAirQualityRequest)(nil),  // 4: api.v1.GetLocationAirQualityRequest
+	(*ListWeatherAlertsRequest)(nil),      // 5: api.v1.ListWeatherAlertsRequest
+	(*ListWeatherResponse)(nil),           // 6: api.v1.ListWeatherResponse
+	(*GetLocationWeatherResponse)(nil),    // 7: api.v1.GetLocationWeatherResponse
+	(*GetLocationForecastResponse)(nil),   // 8: api.v1.GetLocationForecastResponse
+	(*GetLocationAirQualityResponse)(nil), // 9: api.v1.GetLocationAirQualityResponse
+	(*ListWeatherAlertsResponse)(nil),     // 10: api.v1.ListWeatherAlertsResponse
+	(*WeatherData)(nil),                   // 11: api.v1.WeatherData
+	(*WeatherForecast)(nil),               // 12: api.v1.WeatherForecast
+	(*ForecastPeriod)(nil),                // 13: api.v1.ForecastPeriod
+	(*AirQuality)(nil),                    // 14: api.v1.AirQuality
+	(*FireWeather)(nil),                   // 15: api.v1.FireWeather
+	(*WeatherAlert)(nil),                  // 16: api.v1.WeatherAlert
+	(*timestamppb.Timestamp)(nil),         // 17: google.protobuf.Timestamp
+	(FireWeatherState)(0),                 // 18: api.v1.FireWeatherState
+	(AlertSource)(0),                      // 19: api.v1.AlertSource
+	(AlertSeverity)(0),                    // 20: api.v1.AlertSeverity
 }
 var file_weather_proto_depIdxs = []int32{
-	6,  // 0: api.v1.ListWeatherResponse.weather_data:type_name -> api.v1.WeatherData
-	9,  // 1: api.v1.ListWeatherResponse.last_updated:type_name -> google.protobuf.Timestamp
-	7,  // 2: api.v1.ListWeatherResponse.fire_weather:type_name -> api.v1.FireWeather
-	6,  // 3: api.v1.GetLocationWeatherResponse.weather_data:type_name -> api.v1.WeatherData
-	9,  // 4: api.v1.GetLocationWeatherResponse.last_updated:type_name -> google.protobuf.Timestamp
-	7,  // 5: api.v1.GetLocationWeatherResponse.fire_weather:type_name -> api.v1.FireWeather
-	8,  // 6: api.v1.ListWeatherAlertsResponse.alerts:type_name -> api.v1.WeatherAlert
-	9,  // 7: api.v1.ListWeatherAlertsResponse.last_updated:type_name -> google.protobuf.Timestamp
-	8,  // 8: api.v1.WeatherData.alerts:type_name -> api.v1.WeatherAlert
-	10, // 9: api.v1.FireWeather.state:type_name -> api.v1.FireWeatherState
-	9,  // 10: api.v1.FireWeather.effective:type_name -> google.protobuf.Timestamp
-	9,  // 11: api.v1.FireWeather.expires:type_name -> google.protobuf.Timestamp
-	11, // 12: api.v1.WeatherAlert.source:type_name -> api.v1.AlertSource
-	12, // 13: api.v1.WeatherAlert.severity:type_name -> api.v1.AlertSeverity
-	9,  // 14: api.v1.WeatherAlert.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 15: api.v1.WeatherAlert.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 16: api.v1.WeatherService.ListWeather:input_type -> api.v1.ListWeatherRequest
-	1,  // 17: api.v1.WeatherService.GetLocationWeather:input_type -> api.v1.GetLocationWeatherRequest
-	2,  // 18: api.v1.WeatherService.ListWeatherAlerts:input_type -> api.v1.ListWeatherAlertsRequest
-	3,  // 19: api.v1.WeatherService.ListWeather:output_type -> api.v1.ListWeatherResponse
-	4,  // 20: api.v1.WeatherService.GetLocationWeather:output_type -> api.v1.GetLocationWeatherResponse
-	5,  // 21: api.v1.WeatherService.ListWeatherAlerts:output_type -> api.v1.ListWeatherAlertsResponse
-	19, // [19:22] is the sub-list for method output_type
-	16, // [16:19] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	11, // 0: api.v1.ListWeatherResponse.weather_data:type_name -> api.v1.WeatherData
+	17, // 1: api.v1.ListWeatherResponse.last_updated:type_name -> google.protobuf.Timestamp
+	15, // 2: api.v1.ListWeatherResponse.fire_weather:type_name -> api.v1.FireWeather
+	11, // 3: api.v1.GetLocationWeatherResponse.weather_data:type_name -> api.v1.WeatherData
+	17, // 4: api.v1.GetLocationWeatherResponse.last_updated:type_name -> google.protobuf.Timestamp
+	15, // 5: api.v1.GetLocationWeatherResponse.fire_weather:type_name -> api.v1.FireWeather
+	12, // 6: api.v1.GetLocationForecastResponse.forecast:type_name -> api.v1.WeatherForecast
+	17, // 7: api.v1.GetLocationForecastResponse.last_updated:type_name -> google.protobuf.Timestamp
+	14, // 8: api.v1.GetLocationAirQualityResponse.air_quality:type_name -> api.v1.AirQuality
+	17, // 9: api.v1.GetLocationAirQualityResponse.last_updated:type_name -> google.protobuf.Timestamp
+	16, // 10: api.v1.ListWeatherAlertsResponse.alerts:type_name -> api.v1.WeatherAlert
+	17, // 11: api.v1.ListWeatherAlertsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	16, // 12: api.v1.WeatherData.alerts:type_name -> api.v1.WeatherAlert
+	13, // 13: api.v1.WeatherForecast.hourly:type_name -> api.v1.ForecastPeriod
+	13, // 14: api.v1.WeatherForecast.daily:type_name -> api.v1.ForecastPeriod
+	17, // 15: api.v1.ForecastPeriod.time:type_name -> google.protobuf.Timestamp
+	17, // 16: api.v1.AirQuality.measured_at:type_name -> google.protobuf.Timestamp
+	18, // 17: api.v1.FireWeather.state:type_name -> api.v1.FireWeatherState
+	17, // 18: api.v1.FireWeather.effective:type_name -> google.protobuf.Timestamp
+	17, // 19: api.v1.FireWeather.expires:type_name -> google.protobuf.Timestamp
+	19, // 20: api.v1.WeatherAlert.source:type_name -> api.v1.AlertSource
+	20, // 21: api.v1.WeatherAlert.severity:type_name -> api.v1.AlertSeverity
+	17, // 22: api.v1.WeatherAlert.start_time:type_name -> google.protobuf.Timestamp
+	17, // 23: api.v1.WeatherAlert.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 24: api.v1.WeatherAlert.category:type_name -> api.v1.WeatherAlertCategory
+	1,  // 25: api.v1.WeatherService.ListWeather:input_type -> api.v1.ListWeatherRequest
+	2,  // 26: api.v1.WeatherService.GetLocationWeather:input_type -> api.v1.GetLocationWeatherRequest
+	3,  // 27: api.v1.WeatherService.GetLocationForecast:input_type -> api.v1.GetLocationForecastRequest
+	4,  // 28: api.v1.WeatherService.GetLocationAirQuality:input_type -> api.v1.GetLocationAirQualityRequest
+	5,  // 29: api.v1.WeatherService.ListWeatherAlerts:input_type -> api.v1.ListWeatherAlertsRequest
+	6,  // 30: api.v1.WeatherService.ListWeather:output_type -> api.v1.ListWeatherResponse
+	7,  // 31: api.v1.WeatherService.GetLocationWeather:output_type -> api.v1.GetLocationWeatherResponse
+	8,  // 32: api.v1.WeatherService.GetLocationForecast:output_type -> api.v1.GetLocationForecastResponse
+	9,  // 33: api.v1.WeatherService.GetLocationAirQuality:output_type -> api.v1.GetLocationAirQualityResponse
+	10, // 34: api.v1.WeatherService.ListWeatherAlerts:output_type -> api.v1.ListWeatherAlertsResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_weather_proto_init() }
@@ -994,7 +1796,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWeatherAlertsRequest); i {
+			switch v := v.(*GetLocationForecastRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1006,7 +1808,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWeatherResponse); i {
+			switch v := v.(*GetLocationAirQualityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1018,7 +1820,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationWeatherResponse); i {
+			switch v := v.(*ListWeatherAlertsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1030,7 +1832,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWeatherAlertsResponse); i {
+			switch v := v.(*ListWeatherResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1042,7 +1844,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeatherData); i {
+			switch v := v.(*GetLocationWeatherResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1054,7 +1856,7 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FireWeather); i {
+			switch v := v.(*GetLocationForecastResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1066,6 +1868,90 @@ func file_weather_proto_init() {
 			}
 		}
 		file_weather_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLocationAirQualityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWeatherAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForecastPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AirQuality); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FireWeather); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WeatherAlert); i {
 			case 0:
 				return &v.state
@@ -1078,18 +1964,20 @@ func file_weather_proto_init() {
 			}
 		}
 	}
+	file_weather_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_weather_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_weather_proto_goTypes,
 		DependencyIndexes: file_weather_proto_depIdxs,
+		EnumInfos:         file_weather_proto_enumTypes,
 		MessageInfos:      file_weather_proto_msgTypes,
 	}.Build()
 	File_weather_proto = out.File
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	WeatherService_ListWeather_FullMethodName        = "/api.v1.WeatherService/ListWeather"
-	WeatherService_GetLocationWeather_FullMethodName = "/api.v1.WeatherService/GetLocationWeather"
-	WeatherService_ListWeatherAlerts_FullMethodName  = "/api.v1.WeatherService/ListWeatherAlerts"
+	WeatherService_ListWeather_FullMethodName           = "/api.v1.WeatherService/ListWeather"
+	WeatherService_GetLocationWeather_FullMethodName    = "/api.v1.WeatherService/GetLocationWeather"
+	WeatherService_GetLocationForecast_FullMethodName   = "/api.v1.WeatherService/GetLocationForecast"
+	WeatherService_GetLocationAirQuality_FullMethodName = "/api.v1.WeatherService/GetLocationAirQuality"
+	WeatherService_ListWeatherAlerts_FullMethodName     = "/api.v1.WeatherService/ListWeatherAlerts"
 )
 
 // WeatherServiceClient is the client API for WeatherService service.
@@ -32,6 +34,13 @@ type WeatherServiceClient interface {
 	ListWeather(ctx context.Context, in *ListWeatherRequest, opts ...grpc.CallOption) (*ListWeatherResponse, error)
 	// GetLocationWeather returns weather for a specific location
 	GetLocationWeather(ctx context.Context, in *GetLocationWeatherRequest, opts ...grpc.CallOption) (*GetLocationWeatherResponse, error)
+	// GetLocationForecast returns the hourly and/or daily forecast for a
+	// configured location (OpenWeatherMap One Call). By default both are
+	// returned; set include_hourly or include_daily to request just one.
+	GetLocationForecast(ctx context.Context, in *GetLocationForecastRequest, opts ...grpc.CallOption) (*GetLocationForecastResponse, error)
+	// GetLocationAirQuality returns current air quality (AQI, PM2.5, PM10,
+	// ozone) for a configured location from OpenWeatherMap's Air Pollution API.
+	GetLocationAirQuality(ctx context.Context, in *GetLocationAirQualityRequest, opts ...grpc.CallOption) (*GetLocationAirQualityResponse, error)
 	// ListWeatherAlerts returns active weather alerts for all locations
 	ListWeatherAlerts(ctx context.Context, in *ListWeatherAlertsRequest, opts ...grpc.CallOption) (*ListWeatherAlertsResponse, error)
 }
@@ -62,6 +71,24 @@ func (c *weatherServiceClient) GetLocationWeather(ctx context.Context, in *GetLo
 	return out, nil
 }
 
+func (c *weatherServiceClient) GetLocationForecast(ctx context.Context, in *GetLocationForecastRequest, opts ...grpc.CallOption) (*GetLocationForecastResponse, error) {
+	out := new(GetLocationForecastResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetLocationForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetLocationAirQuality(ctx context.Context, in *GetLocationAirQualityRequest, opts ...grpc.CallOption) (*GetLocationAirQualityResponse, error) {
+	out := new(GetLocationAirQualityResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetLocationAirQuality_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weatherServiceClient) ListWeatherAlerts(ctx context.Context, in *ListWeatherAlertsRequest, opts ...grpc.CallOption) (*ListWeatherAlertsResponse, error) {
 	out := new(ListWeatherAlertsResponse)
 	err := c.cc.Invoke(ctx, WeatherService_ListWeatherAlerts_FullMethodName, in, out, opts...)
@@ -79,6 +106,13 @@ type WeatherServiceServer interface {
 	ListWeather(context.Context, *ListWeatherRequest) (*ListWeatherResponse, error)
 	// GetLocationWeather returns weather for a specific location
 	GetLocationWeather(context.Context, *GetLocationWeatherRequest) (*GetLocationWeatherResponse, error)
+	// GetLocationForecast returns the hourly and/or daily forecast for a
+	// configured location (OpenWeatherMap One Call). By default both are
+	// returned; set include_hourly or include_daily to request just one.
+	GetLocationForecast(context.Context, *GetLocationForecastRequest) (*GetLocationForecastResponse, error)
+	// GetLocationAirQuality returns current air quality (AQI, PM2.5, PM10,
+	// ozone) for a configured location from OpenWeatherMap's Air Pollution API.
+	GetLocationAirQuality(context.Context, *GetLocationAirQualityRequest) (*GetLocationAirQualityResponse, error)
 	// ListWeatherAlerts returns active weather alerts for all locations
 	ListWeatherAlerts(context.Context, *ListWeatherAlertsRequest) (*ListWeatherAlertsResponse, error)
 	mustEmbedUnimplementedWeatherServiceServer()
@@ -94,6 +128,12 @@ func (UnimplementedWeatherServiceServer) ListWeather(context.Context, *ListWeath
 func (UnimplementedWeatherServiceServer) GetLocationWeather(context.Context, *GetLocationWeatherRequest) (*GetLocationWeatherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLocationWeather not implemented")
 }
+func (UnimplementedWeatherServiceServer) GetLocationForecast(context.Context, *GetLocationForecastRequest) (*GetLocationForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationForecast not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetLocationAirQuality(context.Context, *GetLocationAirQualityRequest) (*GetLocationAirQualityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationAirQuality not implemented")
+}
 func (UnimplementedWeatherServiceServer) ListWeatherAlerts(context.Context, *ListWeatherAlertsRequest) (*ListWeatherAlertsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWeatherAlerts not implemented")
 }
@@ -146,6 +186,42 @@ func _WeatherService_GetLocationWeather_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WeatherService_GetLocationForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetLocationForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetLocationForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetLocationForecast(ctx, req.(*GetLocationForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetLocationAirQuality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationAirQualityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetLocationAirQuality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetLocationAirQuality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetLocationAirQuality(ctx, req.(*GetLocationAirQualityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WeatherService_ListWeatherAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWeatherAlertsRequest)
 	if err := dec(in); err != nil {
@@ -179,6 +255,14 @@ var WeatherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLocationWeather",
 			Handler:    _WeatherService_GetLocationWeather_Handler,
 		},
+		{
+			MethodName: "GetLocationForecast",
+			Handler:    _WeatherService_GetLocationForecast_Handler,
+		},
+		{
+			MethodName: "GetLocationAirQuality",
+			Handler:    _WeatherService_GetLocationAirQuality_Handler,
+		},
 		{
 			MethodName: "ListWeatherAlerts",
 			Handler:    _WeatherService_ListWeatherAlerts_Handler,
@@ -6,9 +6,13 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/dpup/prefab"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/etag"
 )
 
 // cacheMaxAgeSeconds is how long clients may reuse a response without
@@ -59,10 +63,33 @@ func isCacheableMethod(fullMethod string) bool {
 		return false
 	}
 	switch fullMethod[idx+1:] {
-	case "ListRoads", "GetRoad", "ListIncidents",
-		"ListWeather", "GetLocationWeather", "ListWeatherAlerts":
+	case "ListRoads", "GetRoad", "ListIncidents", "ListAllAlerts",
+		"ListWeather", "GetLocationWeather", "GetLocationForecast", "GetLocationAirQuality",
+		"ListWeatherAlerts":
 		return true
 	default:
 		return false
 	}
 }
+
+// etagGatewayPrefix is where the versioned REST API is served with ETags
+const etagGatewayPrefix = "/api/v1/"
+
+// etagGateway serves the versioned REST API through etag.Middleware, so
+// polling clients revalidating with If-None-Match get a 304 when nothing
+// changed. Prefab mounts its gateway at "/api/" without a middleware hook, so
+// the gateway mux is captured as the server is built and re-mounted behind
+// the middleware at the more specific "/api/v1/". Handlers registered at
+// narrower /api/v1 paths (hazards, calendar, ...) still take precedence.
+func etagGateway() []prefab.ServerOption {
+	var gateway http.Handler
+	return []prefab.ServerOption{
+		prefab.WithGRPCGateway(func(_ context.Context, mux *runtime.ServeMux, _ string, _ []grpc.DialOption) error {
+			gateway = mux
+			return nil
+		}),
+		prefab.WithHTTPHandler(etagGatewayPrefix, etag.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gateway.ServeHTTP(w, r)
+		}))),
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+func TestETagGateway(t *testing.T) {
+	store := cache.NewCache()
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	require.NoError(t, store.Set("roads:all", []*api.Road{{Id: "hwy4-murphys-arnold", Name: "Hwy 4"}}, time.Hour, "roads"))
+	roads := services.NewRoadsService(nil, nil, store, cfg, nil, nil)
+	baseURL := startTestServer(t, func(server *prefab.Server) {
+		api.RegisterRoadsServiceServer(server.ServiceRegistrar(), roads)
+		require.NoError(t, api.RegisterRoadsServiceHandlerFromEndpoint(server.GatewayArgs()))
+	}, append(etagGateway(),
+		prefab.WithIncomingHeaders(requestid.Header),
+		prefab.WithGRPCInterceptor(requestid.UnaryServerInterceptor),
+	)...)
+
+	get := func(path, ifNoneMatch string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, baseURL+path, nil)
+		require.NoError(t, err)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	// The gateway's connection to the gRPC server was first dialed before
+	// the server started listening; wait for it to reconnect
+	require.Eventually(t, func() bool {
+		resp, _ := get("/api/v1/version", "")
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 50*time.Millisecond, "gateway never reached the gRPC server")
+
+	for _, path := range []string{"/api/v1/version", "/api/v1/roads"} {
+		first, body := get(path, "")
+		require.Equal(t, http.StatusOK, first.StatusCode, path)
+		assert.NotEmpty(t, body, path)
+		tag := first.Header.Get("ETag")
+		require.NotEmpty(t, tag, path)
+		assert.Len(t, first.Header.Values(requestid.Header), 1, path)
+
+		// Revalidating unchanged data, e.g. the same cached roads, is a 304
+		second, body := get(path, tag)
+		assert.Equal(t, http.StatusNotModified, second.StatusCode, path)
+		assert.Empty(t, body, path)
+		assert.Equal(t, tag, second.Header.Get("ETag"), path)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab"
+	"github.com/dpup/prefab/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestServer starts a Prefab server with the server.security settings
+// from prefab.yaml and returns its base URL. register, when non-nil, adds
+// services before the server starts.
+func startTestServer(t *testing.T, register func(*prefab.Server), opts ...prefab.ServerOption) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	server := prefab.New(append([]prefab.ServerOption{
+		prefab.WithContext(logging.EnsureLogger(context.Background())),
+		prefab.WithHost("127.0.0.1"),
+		prefab.WithPort(port),
+	}, opts...)...)
+	if register != nil {
+		register(server)
+	}
+	go func() { _ = server.Start() }()
+
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond, "server did not start")
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+	return baseURL
+}
+
+func preflight(t *testing.T, url, origin string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodOptions, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	req.Header.Set("Access-Control-Request-Headers", "content-type")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	return resp
+}
+
+func TestGatewayCORSPreflight(t *testing.T) {
+	// Preflights are answered by Prefab's security middleware before reaching
+	// the gateway, so no services need registering
+	baseURL := startTestServer(t, nil)
+
+	resp := preflight(t, baseURL+"/api/v1/roads", "https://ersn.net")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "https://ersn.net", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET", resp.Header.Get("Access-Control-Allow-Methods"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Headers"), "Content-Type")
+	assert.Equal(t, "259200", resp.Header.Get("Access-Control-Max-Age"))
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Credentials"))
+
+	// Origins off the allow-list get no CORS grant
+	resp = preflight(t, baseURL+"/api/v1/roads", "https://evil.example")
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Methods"))
+}
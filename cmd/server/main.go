@@ -4,89 +4,190 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"log/slog"
 	"net/http"
 	_ "time/tzdata" // Embed the IANA tz database so America/Los_Angeles resolves in minimal containers
 
 	"github.com/dpup/prefab"
 	"github.com/dpup/prefab/logging"
+	"github.com/dpup/prefab/plugins/auth"
+	"github.com/dpup/prefab/plugins/auth/apikey"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/buildinfo"
 	"github.com/dpup/info.ersn.net/server/internal/cache"
-	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
-	"github.com/dpup/info.ersn.net/server/internal/clients/google"
-	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
-	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/calendar"
 	"github.com/dpup/info.ersn.net/server/internal/config"
 	"github.com/dpup/info.ersn.net/server/internal/hazards"
-	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/kmlexport"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
+	"github.com/dpup/info.ersn.net/server/internal/rss"
 	"github.com/dpup/info.ersn.net/server/internal/services"
 )
 
 func main() {
-	// Initialize structured logging
-	logger := logging.NewProdLogger()
+	// Load configuration using Prefab's config system
+	appConfig := config.LoadConfig()
+
+	// Initialize structured logging: JSON lines by default, readable console
+	// output for local development
+	var logger logging.Logger
+	switch appConfig.Logging.Format {
+	case "", config.LogFormatJSON:
+		logger = logging.NewProdLogger()
+	case config.LogFormatConsole:
+		logger = logging.NewDevLogger()
+	default:
+		log.Fatalf("Unknown logging.format %q (want %q or %q)", appConfig.Logging.Format, config.LogFormatJSON, config.LogFormatConsole)
+	}
 	ctx := logging.With(context.Background(), logger)
 
-	logging.Info(ctx, "Starting ERSN Info Server")
+	build := buildinfo.Get()
+	logging.Infow(ctx, "Starting ERSN Info Server", "version", build.Version, "commit", build.Commit)
 
-	// Load configuration using Prefab's config system
-	appConfig := config.LoadConfig()
+	// Fail fast on a typo'd road rather than misclassifying its alerts later
+	if err := appConfig.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
 
-	// Initialize cache
-	cacheInstance := cache.NewCache()
+	// Initialize cache: in-memory by default, or Redis so replicas share
+	// enhanced alerts instead of each paying for them
+	var cacheInstance cache.Store
+	var memoryCache *cache.Cache // Only set for the in-memory backend
+	switch appConfig.Cache.Backend {
+	case "", config.CacheBackendMemory:
+		// Bounded so unique enhanced-alert hashes can't grow it forever
+		memoryCache = cache.NewCacheWithLimits(cache.Limits{
+			MaxEntries: appConfig.Cache.MaxEntries,
+			MaxBytes:   appConfig.Cache.MaxBytes,
+		})
+		cacheInstance = memoryCache
+	case config.CacheBackendRedis:
+		redisStore, err := cache.NewRedisStore(appConfig.Cache.RedisURL, appConfig.Cache.RedisKeyPrefix)
+		if err != nil {
+			logging.Errorw(ctx, "Failed to initialize Redis cache", "error", err)
+			log.Fatalf("Failed to initialize Redis cache: %v", err)
+		}
+		defer func() { _ = redisStore.Close() }()
+		// Rescan for the cache metrics on a timer, not on every scrape
+		redisStore.StartStatsRefresh(ctx, cache.DefaultRedisStatsInterval)
+		cacheInstance = redisStore
+	default:
+		log.Fatalf("Unknown cache backend %q (want %q or %q)", appConfig.Cache.Backend, config.CacheBackendMemory, config.CacheBackendRedis)
+	}
 
-	// Initialize external API clients using top-level client configurations
-	googleClient := google.NewClient(appConfig.GoogleRoutes.APIKey)
-	caltransClient := caltrans.NewFeedParser()
-	weatherClient := weather.NewClient(appConfig.OpenWeather.APIKey)
-	nwsClient := nws.NewClient(appConfig.Weather.NWS.UserAgent)
+	// Optionally restore the in-memory cache from disk so a restart doesn't
+	// re-pay for every current incident's enhancement
+	persistPath := appConfig.Cache.PersistPath
+	if memoryCache == nil {
+		persistPath = "" // Redis already outlives restarts
+	}
+	if persistPath != "" {
+		if loaded, err := memoryCache.LoadFromFile(persistPath); err != nil {
+			logging.Errorw(ctx, "Failed to load cache snapshot", "path", persistPath, "error", err)
+		} else {
+			logging.Infow(ctx, "Loaded cache snapshot", "path", persistPath, "entries", loaded)
+		}
 
-	// Initialize OpenAI enhancer with caching (required for service)
-	if appConfig.OpenAI.APIKey == "" {
-		logging.Error(ctx, "OpenAI API key is required in configuration for incident enhancement")
-		log.Fatal("OpenAI API key is required in configuration for incident enhancement")
+		persistInterval := appConfig.Cache.PersistInterval
+		if persistInterval <= 0 {
+			persistInterval = config.DefaultCachePersistInterval
+		}
+		memoryCache.StartPeriodicPersist(ctx, persistPath, persistInterval)
 	}
 
-	model := appConfig.OpenAI.Model
+	// Reap very stale entries between accesses (Redis expires its own)
+	if memoryCache != nil {
+		janitorInterval := appConfig.Cache.JanitorInterval
+		if janitorInterval <= 0 {
+			janitorInterval = config.DefaultCacheJanitorInterval
+		}
+		janitor := cache.NewJanitor(memoryCache, janitorInterval)
+		if err := janitor.Start(ctx); err != nil {
+			logging.Errorw(ctx, "Failed to start cache janitor", "error", err)
+		}
+		defer janitor.Stop()
+	}
 
-	// Create OpenAI enhancers (caching is integrated directly in services)
-	alertEnhancer := alerts.NewAlertEnhancer(appConfig.OpenAI.APIKey, model)
-	weatherAlertEnhancer := alerts.NewWeatherAlertEnhancer(appConfig.OpenAI.APIKey, model)
+	// Prometheus metrics, scraped from GET /metrics
+	appMetrics := metrics.New(cacheInstance)
 
-	logging.Infow(ctx, "OpenAI enhancement enabled", "model", model, "caching", "content-based")
+	// External API clients and AI enhancers (caching is integrated directly
+	// in services), or local fixtures in offline mode
+	up, err := newUpstreams(ctx, appConfig, appMetrics)
+	if err != nil {
+		logging.Errorw(ctx, "Failed to initialize upstream clients", "error", err)
+		log.Fatalf("Failed to initialize upstream clients: %v", err)
+	}
 
-	// Initialize gRPC services
-	roadsService := services.NewRoadsService(googleClient, caltransClient, cacheInstance, appConfig, alertEnhancer)
-	weatherService := services.NewWeatherService(weatherClient, nwsClient, cacheInstance, appConfig, weatherAlertEnhancer)
+	// Initialize gRPC services. Both record upstream fetch outcomes into a shared
+	// tracker that backs GET /api/v1/health and counts fetches for /metrics.
+	sourceHealth := services.NewSourceHealth(appMetrics)
+	roadsService := services.NewRoadsService(up.google, up.caltrans, cacheInstance, appConfig, up.alertEnhancer, sourceHealth)
+	roadsService.ReportMetrics(appMetrics)
+	weatherService := services.NewWeatherService(up.weather, up.nws, cacheInstance, appConfig, up.weatherAlertEnhancer, sourceHealth)
+	weatherService.ReportMetrics(appMetrics)
+	roadsService.CorrelateWeatherAlerts(weatherService)
+	roadsService.IncludeCorridorWeather(weatherService)
 
 	// Unified hazard/situation GeoJSON feed (re-projects the feeds above).
-	hazardsService := hazards.NewService(appConfig, roadsService, weatherService, caltransClient, cacheInstance)
+	hazardsService := hazards.NewService(appConfig, roadsService, weatherService, up.caltrans, cacheInstance)
+
+	// Dated closures as an iCalendar feed for calendar subscriptions
+	closuresCalendar, calErr := calendar.NewHandler(appConfig, roadsService)
+	if calErr != nil {
+		log.Fatalf("Failed to create closures calendar: %v", calErr)
+	}
 
 	logging.Infow(ctx, "Live Data API Server starting",
 		"roads_monitored", len(appConfig.Roads.MonitoredRoads),
 		"weather_locations", len(appConfig.Weather.Locations))
 
+	// Enhance alerts off the refresh path so OpenAI latency doesn't stall it
+	roadsService.StartBackgroundEnhancement(ctx, appConfig.Roads.EnhancementWorkers)
+
 	// Start periodic refresh to maintain cache warmth (replaces complex cache warmer)
-	periodicRefresh := services.NewPeriodicRefreshService(roadsService, appConfig)
+	periodicRefresh := services.NewPeriodicRefreshService(roadsService)
 	if err := periodicRefresh.StartPeriodicRefresh(ctx); err != nil {
 		logging.Errorw(ctx, "Failed to start periodic refresh", "error", err)
 	}
 
+	// Keep weather warm too, so the first weather request doesn't wait on upstream APIs
+	weatherRefresh := services.NewWeatherRefreshService(weatherService)
+	if err := weatherRefresh.StartPeriodicRefresh(ctx); err != nil {
+		logging.Errorw(ctx, "Failed to start weather refresh", "error", err)
+	}
+
+	// Monitored roads and weather locations can be changed without a restart:
+	// edit prefab.yaml and send SIGHUP
+	reloadOnSIGHUP(ctx, services.NewConfigReloader(roadsService, weatherService, periodicRefresh, weatherRefresh))
+
 	// Create Prefab server with GRPC reflection enabled
 	// Server configuration (port, etc.) will be loaded from prefab.yaml/env vars
-	server := prefab.New(
+	serverOptions := []prefab.ServerOption{
 		prefab.WithContext(ctx),
 		prefab.WithGRPCReflection(),
+		prefab.WithIncomingHeaders(requestid.Header),
+		prefab.WithGRPCInterceptor(requestid.UnaryServerInterceptor),
 		prefab.WithGRPCInterceptor(cacheHeadersInterceptor),
 		prefab.WithHTTPHandler(hazards.HandlerPrefix, hazardsService),
 		prefab.WithHTTPHandlerFunc(hazards.ScannersPrefix, hazardsService.ServeScanners),
 		prefab.WithHTTPHandlerFunc(hazards.SituationPrefix, hazardsService.ServeSituation),
+		prefab.WithHTTPHandlerFunc(hazards.RoadGeoJSONPattern, hazardsService.ServeRoadGeoJSON),
+		prefab.WithHTTPHandler(calendar.AllRoadsPattern, closuresCalendar),
+		prefab.WithHTTPHandler(calendar.RoadPattern, closuresCalendar),
+		prefab.WithHTTPHandler(kmlexport.Pattern, kmlexport.NewHandler(roadsService)),
+		prefab.WithHTTPHandler(rss.Pattern, rss.NewHandler(roadsService)),
+		prefab.WithHTTPHandler(metrics.Pattern, appMetrics.Handler()),
+		prefab.WithHTTPHandlerFunc("/version", versionHandler(roadsService)),
 		prefab.WithHTTPHandlerFunc("/", homepageHandler),
 		prefab.WithHTTPHandlerFunc("/api/docs/roads.swagger.json", openAPIHandler("api/v1/roads.swagger.json")),
 		prefab.WithHTTPHandlerFunc("/api/docs/weather.swagger.json", openAPIHandler("api/v1/weather.swagger.json")),
 		prefab.WithHTTPHandlerFunc("/api/docs/common.swagger.json", openAPIHandler("api/v1/common.swagger.json")),
-	)
+	}
+	serverOptions = append(serverOptions, etagGateway()...)
+	serverOptions = append(serverOptions, adminAuth(appConfig.Admin)...)
+	server := prefab.New(serverOptions...)
 
 	// Register gRPC services using Prefab's service registrar
 	api.RegisterRoadsServiceServer(server.ServiceRegistrar(), roadsService)
@@ -105,8 +206,23 @@ func main() {
 
 	logging.Info(ctx, "Server initialization complete, starting HTTP and gRPC services")
 
-	// Start the server (blocks until shutdown)
-	if err := server.Start(); err != nil {
+	// Start the server (blocks until SIGTERM or SIGINT shuts it down)
+	err = server.Start()
+
+	// Save the cache on shutdown so the next start picks up where this one left off
+	var saveCache func()
+	if persistPath != "" {
+		saveCache = func() {
+			if saved, saveErr := memoryCache.SaveToFile(persistPath); saveErr != nil {
+				logging.Errorw(ctx, "Failed to save cache snapshot", "path", persistPath, "error", saveErr)
+			} else {
+				logging.Infow(ctx, "Saved cache snapshot", "path", persistPath, "entries", saved)
+			}
+		}
+	}
+	drainBackgroundWork(ctx, shutdownTimeout, []stopper{periodicRefresh, weatherRefresh}, roadsService, saveCache)
+
+	if err != nil {
 		logging.Errorw(ctx, "Server failed", "error", err)
 		log.Fatalf("Server failed: %v", err)
 	}
@@ -166,9 +282,16 @@ for the Ebbett's Pass / Highway 4 corridor.
     <a href="/api/v1/roads">GET /api/v1/roads</a>               - List all monitored roads
     <a href="/api/v1/roads/hwy4-angels-murphys">GET /api/v1/roads/{road_id}</a>     - Get specific road details
     <a href="/api/v1/incidents/mother-lode">GET /api/v1/incidents/{area}</a>    - Region-wide CHP/Caltrans incidents
+    <a href="/api/v1/alerts">GET /api/v1/alerts</a>              - All road alerts, one entry per alert
+    <a href="/api/v1/roads/closures.ics">GET /api/v1/roads/closures.ics</a>  - Dated closures as an iCalendar feed
+    <a href="/api/v1/roads.kml">GET /api/v1/roads.kml</a>           - Monitored corridors and alerts as KML
+    <a href="/api/v1/roads/hwy4-angels-murphys/feed.xml">GET /api/v1/roads/{road_id}/feed.xml</a> - RSS feed of a road's alerts
+    <a href="/api/v1/health">GET /api/v1/health</a>              - Upstream data freshness and readiness
 
   Weather API:
     <a href="/api/v1/weather">GET /api/v1/weather</a>             - Current weather + fire-weather state
+    <a href="/api/v1/weather/murphys/forecast">GET /api/v1/weather/{location_id}/forecast</a> - Hourly + daily forecast
+    <a href="/api/v1/weather/murphys/air-quality">GET /api/v1/weather/{location_id}/air-quality</a> - AQI, PM2.5, PM10, ozone (smoke)
     <a href="/api/v1/weather/alerts">GET /api/v1/weather/alerts</a>      - NWS zone alerts + OpenWeatherMap alerts
     <a href="/api/v1/weather/alerts?zones=CAZ064,CAZ065,CAZ258,CAZ259">GET /api/v1/weather/alerts?zones=...</a> - Filter to NWS forecast zones
 
@@ -185,7 +308,7 @@ for the Ebbett's Pass / Highway 4 corridor.
 <span class="header">Data Sources:</span>
   • Google Routes API               - Traffic conditions and travel times
   • Caltrans KML Feeds              - Lane closures, CHP incidents, chain control
-  • OpenWeatherMap API              - Weather conditions and alerts
+  • OpenWeatherMap API              - Weather conditions, forecasts, alerts, air quality
   • National Weather Service        - Zone alerts and fire-weather products
   • USGS (FDSN)                     - Earthquakes
   • CAL FIRE + NIFC WFIGS           - Active wildfires and perimeters
@@ -204,7 +327,7 @@ for the Ebbett's Pass / Highway 4 corridor.
 </html>`
 
 	if _, err := fmt.Fprint(w, html); err != nil {
-		slog.Error("Failed to write homepage HTML", "error", err)
+		logging.Errorw(r.Context(), "Failed to write homepage HTML", "error", err)
 	}
 }
 
@@ -224,3 +347,19 @@ func openAPIHandler(filename string) http.HandlerFunc {
 		http.ServeFile(w, r, filename)
 	}
 }
+
+// adminAuth authenticates the admin RPCs (ForceRefresh) with the configured
+// admin API key through Prefab's auth plugins. Without a key the plugins
+// aren't installed and the admin RPCs answer Unimplemented.
+func adminAuth(cfg config.AdminConfig) []prefab.ServerOption {
+	if cfg.APIKey == "" {
+		return nil
+	}
+	return []prefab.ServerOption{
+		prefab.WithPlugin(auth.Plugin()),
+		prefab.WithPlugin(apikey.Plugin(
+			apikey.WithKeyPrefix(services.AdminKeyPrefix),
+			apikey.WithKeyFunc(services.AdminKeyOwner(cfg.APIKey)),
+		)),
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab"
+	"github.com/dpup/prefab/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+// loopbackOnly fails the test on any request that leaves the machine, so a
+// client that slipped past offline mode shows up
+type loopbackOnly struct {
+	t    *testing.T
+	next http.RoundTripper
+}
+
+func (l loopbackOnly) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Hostname() != "127.0.0.1" {
+		l.t.Errorf("offline mode made a network request: %s %s", req.Method, req.URL.Redacted())
+		return nil, http.ErrHandlerTimeout
+	}
+	return l.next.RoundTrip(req)
+}
+
+func TestOfflineModeListRoads(t *testing.T) {
+	transport := http.DefaultTransport
+	http.DefaultTransport = loopbackOnly{t: t, next: transport}
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	// The repository's prefab.yaml in offline mode, without any API keys
+	for _, key := range []string{"PF__GOOGLE_ROUTES__API_KEY", "PF__OPENAI__API_KEY", "PF__OPENWEATHER__API_KEY"} {
+		t.Setenv(key, "")
+	}
+	t.Setenv("PF__OFFLINE__ENABLED", "true")
+	t.Setenv("PF__OFFLINE__TEST_DATA_DIR", "../../tests/testdata")
+	cfg, err := config.Reload()
+	require.NoError(t, err)
+	require.True(t, cfg.Offline.Enabled)
+
+	up, err := newUpstreams(logging.EnsureLogger(context.Background()), cfg, nil)
+	require.NoError(t, err)
+	c := cache.NewCache()
+	roads := services.NewRoadsService(up.google, up.caltrans, c, cfg, up.alertEnhancer, services.NewSourceHealth(nil))
+	roads.CorrelateWeatherAlerts(services.NewWeatherService(up.weather, up.nws, c, cfg, nil, nil))
+
+	// Refresh before serving: the first ListRoads refreshes synchronously and
+	// caches the roads, which can outlast the wait for the gateway below
+	// (e.g. under -race)
+	_, err = roads.ListRoads(logging.EnsureLogger(context.Background()), &api.ListRoadsRequest{})
+	require.NoError(t, err)
+
+	baseURL := startTestServer(t, func(server *prefab.Server) {
+		api.RegisterRoadsServiceServer(server.ServiceRegistrar(), roads)
+		require.NoError(t, api.RegisterRoadsServiceHandlerFromEndpoint(server.GatewayArgs()))
+	})
+
+	var body []byte
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(baseURL + "/api/v1/roads")
+		if err != nil {
+			return false
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, err = io.ReadAll(resp.Body)
+		return err == nil && resp.StatusCode == http.StatusOK
+	}, 10*time.Second, 50*time.Millisecond, "gateway never reached the gRPC server")
+
+	var list api.ListRoadsResponse
+	require.NoError(t, protojson.Unmarshal(body, &list))
+	require.Len(t, list.Roads, len(cfg.Roads.MonitoredRoads))
+
+	for i, road := range list.Roads {
+		monitored := cfg.Roads.MonitoredRoads[i]
+		assert.Equal(t, monitored.ID, road.Id)
+		assert.Equal(t, monitored.Name, road.Name)
+
+		// Timed from the synthetic straight-line route
+		assert.Positive(t, road.DistanceKm, road.Id)
+		assert.Positive(t, road.DurationMinutes, road.Id)
+		assert.Equal(t, api.CongestionLevel_CLEAR, road.CongestionLevel, road.Id)
+
+		// The chain control fixture has R2 in effect at Bear Valley
+		if road.Id == "hwy4-arnold-bearvalley" {
+			require.NotNil(t, road.ChainControlInfo)
+			assert.Equal(t, api.ChainControlLevel_CHAIN_CONTROL_LEVEL_R2, road.ChainControlInfo.Level)
+		}
+	}
+}
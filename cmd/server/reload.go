@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+// reloadOnSIGHUP re-reads the configuration each time the process receives
+// SIGHUP and applies it to the running services. A config that fails to load
+// or validate is logged and ignored, so a bad edit can't take the server down.
+func reloadOnSIGHUP(ctx context.Context, reloader *services.ConfigReloader) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(hangups)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hangups:
+				ctx := requestid.With(ctx, requestid.New())
+				logging.Info(ctx, "Received SIGHUP, reloading configuration")
+
+				newConfig, err := config.Reload()
+				if err == nil {
+					err = reloader.Reload(ctx, newConfig)
+				}
+				if err != nil {
+					logging.Errorw(ctx, "Configuration reload failed, keeping the running configuration", "error", err)
+				}
+			}
+		}
+	}()
+}
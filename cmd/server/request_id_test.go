@@ -0,0 +1,53 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+func TestGatewayRequestID(t *testing.T) {
+	roads := services.NewRoadsService(nil, nil, cache.NewCache(), &config.Config{}, nil, nil)
+	baseURL := startTestServer(t, func(server *prefab.Server) {
+		api.RegisterRoadsServiceServer(server.ServiceRegistrar(), roads)
+		require.NoError(t, api.RegisterRoadsServiceHandlerFromEndpoint(server.GatewayArgs()))
+	},
+		prefab.WithIncomingHeaders(requestid.Header),
+		prefab.WithGRPCInterceptor(requestid.UnaryServerInterceptor),
+	)
+
+	get := func(id string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/api/v1/health", nil)
+		require.NoError(t, err)
+		if id != "" {
+			req.Header.Set(requestid.Header, id)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp
+	}
+
+	// The gateway's connection to the gRPC server was first dialed before
+	// the server started listening; wait for it to reconnect
+	require.Eventually(t, func() bool { return get("").StatusCode == http.StatusOK },
+		5*time.Second, 50*time.Millisecond, "gateway never reached the gRPC server")
+
+	// A caller's ID is echoed back
+	assert.Equal(t, "edge-7f3a", get("edge-7f3a").Header.Get(requestid.Header))
+
+	// Otherwise one is generated per request
+	first, second := get("").Header.Get(requestid.Header), get("").Header.Get(requestid.Header)
+	assert.Len(t, first, 16)
+	assert.NotEqual(t, first, second)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+)
+
+// shutdownTimeout bounds how long shutdown waits for queued alert
+// enhancements. ECS kills a task 30s after SIGTERM by default, and Prefab has
+// already spent up to 2s draining HTTP connections.
+const shutdownTimeout = 20 * time.Second
+
+// stopper is a background loop stopped on shutdown, e.g. a periodic refresh
+type stopper interface {
+	Stop()
+}
+
+// enhancementDrainer finishes or abandons queued alert enhancements
+type enhancementDrainer interface {
+	StopBackgroundEnhancement(ctx context.Context) error
+}
+
+// drainBackgroundWork winds down background work once Prefab has stopped
+// serving on SIGTERM or SIGINT, so no request can queue more: the periodic
+// refreshes stop, cancelling any refresh in progress, queued alert
+// enhancements get until timeout to finish and be cached, and then saveCache
+// (when non-nil) flushes the cache to disk.
+func drainBackgroundWork(ctx context.Context, timeout time.Duration, refreshes []stopper, enhancer enhancementDrainer, saveCache func()) {
+	logging.Info(ctx, "Stopping background work")
+	for _, refresh := range refreshes {
+		refresh.Stop()
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := enhancer.StopBackgroundEnhancement(drainCtx); err != nil {
+		logging.Warnw(ctx, "Shutdown cut alert enhancement short", "error", err)
+	} else {
+		logging.Info(ctx, "Alert enhancement queue drained")
+	}
+
+	if saveCache != nil {
+		saveCache()
+	}
+}
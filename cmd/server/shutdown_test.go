@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shutdownRecorder records the order background work is wound down in
+type shutdownRecorder struct {
+	steps []string
+	block bool // StopBackgroundEnhancement waits for its context
+}
+
+type recordedStopper struct {
+	name string
+	r    *shutdownRecorder
+}
+
+func (s recordedStopper) Stop() { s.r.steps = append(s.r.steps, "stop "+s.name) }
+
+func (r *shutdownRecorder) StopBackgroundEnhancement(ctx context.Context) error {
+	r.steps = append(r.steps, "drain")
+	if r.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func TestDrainBackgroundWork(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	r := &shutdownRecorder{}
+
+	drainBackgroundWork(ctx, time.Second,
+		[]stopper{recordedStopper{"roads", r}, recordedStopper{"weather", r}}, r,
+		func() { r.steps = append(r.steps, "save cache") })
+
+	assert.Equal(t, []string{"stop roads", "stop weather", "drain", "save cache"}, r.steps)
+}
+
+func TestDrainBackgroundWork_GivesUpAfterTimeout(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	r := &shutdownRecorder{block: true}
+
+	start := time.Now()
+	drainBackgroundWork(ctx, 50*time.Millisecond, nil, r,
+		func() { r.steps = append(r.steps, "save cache") })
+
+	assert.Less(t, time.Since(start), time.Second, "shutdown should not wait past its timeout")
+	require.Equal(t, []string{"drain", "save cache"}, r.steps, "the cache is saved even when enhancement is cut short")
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
+	"github.com/dpup/info.ersn.net/server/internal/offline"
+)
+
+// upstreams are the API clients and alert enhancers the services call out
+// through
+type upstreams struct {
+	google               *google.Client
+	caltrans             *caltrans.FeedParser
+	weather              *weather.Client
+	nws                  *nws.Client
+	alertEnhancer        alerts.AlertEnhancer
+	weatherAlertEnhancer alerts.WeatherAlertEnhancer
+}
+
+// newUpstreams creates the upstreams cfg selects: the live APIs, or local
+// fixtures when offline mode is enabled
+func newUpstreams(ctx context.Context, cfg *config.Config, appMetrics *metrics.Metrics) (*upstreams, error) {
+	if cfg.Offline.Enabled {
+		return offlineUpstreams(ctx, cfg), nil
+	}
+
+	googleClient := google.NewClient(cfg.GoogleRoutes.APIKey)
+	googleClient.SpeedReadings = cfg.GoogleRoutes.SpeedReadings
+	googleClient.UserAgent = cfg.Upstream.UserAgent
+	weatherClient := weather.NewClient(cfg.OpenWeather.APIKey)
+	weatherClient.UserAgent = cfg.Upstream.UserAgent
+
+	// Initialize AI enhancer with caching (required for service). A local
+	// OpenAI-compatible server doesn't need a key.
+	if cfg.OpenAI.APIKey == "" && cfg.OpenAI.Provider != alerts.ProviderOpenAICompatible {
+		return nil, errors.New("OpenAI API key is required in configuration for incident enhancement")
+	}
+
+	model := cfg.OpenAI.Model
+
+	// Create enhancers (caching is integrated directly in services) on the
+	// configured LLM backend
+	var provider alerts.EnhancerProvider
+	switch cfg.OpenAI.Provider {
+	case "", alerts.ProviderOpenAI:
+		provider = alerts.NewOpenAIProvider(cfg.OpenAI.APIKey, model)
+	case alerts.ProviderOpenAICompatible:
+		if cfg.OpenAI.BaseURL == "" {
+			return nil, errors.New("openai.baseURL is required for the openai-compatible provider")
+		}
+		provider = alerts.NewCompatibleProvider(cfg.OpenAI.BaseURL, cfg.OpenAI.APIKey, model, nil)
+	default:
+		return nil, fmt.Errorf("unknown openai.provider %q (want %q or %q)",
+			cfg.OpenAI.Provider, alerts.ProviderOpenAI, alerts.ProviderOpenAICompatible)
+	}
+
+	enhancerOpts := []alerts.EnhancerOption{
+		alerts.WithRetry(cfg.OpenAI.MaxRetries, time.Second),
+		alerts.WithFallbackModel(cfg.OpenAI.FallbackModel),
+		alerts.WithPricing(cfg.OpenAI.PromptPricePer1K, cfg.OpenAI.CompletionPricePer1K),
+		alerts.WithCallObserver(appMetrics.ObserveLLMCall),
+	}
+
+	logging.Infow(ctx, "AI enhancement enabled", "provider", cfg.OpenAI.Provider, "model", model, "caching", "content-based")
+
+	return &upstreams{
+		google:               googleClient,
+		caltrans:             newCaltransClient(cfg),
+		weather:              weatherClient,
+		nws:                  nws.NewClient(nwsUserAgent(cfg)),
+		alertEnhancer:        alerts.NewAlertEnhancerWithProvider(provider, enhancerOpts...),
+		weatherAlertEnhancer: alerts.NewWeatherAlertEnhancerWithProvider(provider, enhancerOpts...),
+	}, nil
+}
+
+// offlineUpstreams answers every client from local fixtures and enhances
+// alerts without an LLM, so no API keys are needed. Empty keys were filled
+// with config.OfflineAPIKey when the config was loaded.
+func offlineUpstreams(ctx context.Context, cfg *config.Config) *upstreams {
+	testDataDir := cfg.Offline.TestDataDir
+	if testDataDir == "" {
+		testDataDir = config.DefaultOfflineTestDataDir
+	}
+	doer := offline.NewHTTPDoer(testDataDir)
+
+	caltransClient := newCaltransClient(cfg)
+	caltransClient.HTTPClient = doer
+
+	logging.Infow(ctx, "Offline mode: serving upstream data from local fixtures", "test_data_dir", testDataDir)
+
+	return &upstreams{
+		google:               google.NewClientWithHTTPDoer(cfg.GoogleRoutes.APIKey, offline.GoogleRoutesURL, doer),
+		caltrans:             caltransClient,
+		weather:              weather.NewClientWithHTTPDoer(cfg.OpenWeather.APIKey, offline.OpenWeatherURL, doer),
+		nws:                  nws.NewClientWithHTTPDoer(nwsUserAgent(cfg), offline.NWSURL, doer),
+		alertEnhancer:        offline.AlertEnhancer{},
+		weatherAlertEnhancer: offline.WeatherAlertEnhancer{},
+	}
+}
+
+// newCaltransClient creates a feed parser with the configured feed URLs,
+// timeouts and limits
+func newCaltransClient(cfg *config.Config) *caltrans.FeedParser {
+	caltransClient := caltrans.NewFeedParser()
+	caltransClient.URLs = caltrans.FeedURLs{
+		ChainControls:  cfg.Roads.CaltransFeeds.ChainControls.URL,
+		LaneClosures:   cfg.Roads.CaltransFeeds.LaneClosures.URL,
+		CHPIncidents:   cfg.Roads.CaltransFeeds.CHPIncidents.URL,
+		RoadConditions: cfg.Roads.CaltransFeeds.RoadConditions.URL,
+	}
+	caltransClient.Timeouts = caltrans.FeedTimeouts{
+		ChainControls:  cfg.Roads.CaltransFeeds.ChainControls.Timeout,
+		LaneClosures:   cfg.Roads.CaltransFeeds.LaneClosures.Timeout,
+		CHPIncidents:   cfg.Roads.CaltransFeeds.CHPIncidents.Timeout,
+		RoadConditions: cfg.Roads.CaltransFeeds.RoadConditions.Timeout,
+	}
+	caltransClient.UserAgent = cfg.Upstream.UserAgent
+	caltransClient.MaxBodyBytes = cfg.Roads.CaltransFeeds.MaxBodyBytes
+	return caltransClient
+}
+
+// nwsUserAgent is the NWS-specific User-Agent, or the shared one when none
+// is configured
+func nwsUserAgent(cfg *config.Config) string {
+	if cfg.Weather.NWS.UserAgent != "" {
+		return cfg.Weather.NWS.UserAgent
+	}
+	return cfg.Upstream.UserAgent
+}
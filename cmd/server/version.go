@@ -0,0 +1,30 @@
+package main
+
+import (
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+// versionHandler serves GET /version: the build details GET /api/v1/version
+// returns, at a path deploy tooling can probe without knowing the API layout
+func versionHandler(roads *services.RoadsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := roads.GetVersion(r.Context(), &api.GetVersionRequest{})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dpup/info.ersn.net/server/internal/buildinfo"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/services"
+)
+
+func TestVersionHandler(t *testing.T) {
+	version, commit := buildinfo.Version, buildinfo.Commit
+	buildinfo.Version, buildinfo.Commit = "v1.4.0-test", "0123abcd"
+	defer func() { buildinfo.Version, buildinfo.Commit = version, commit }()
+
+	cfg := &config.Config{}
+	cfg.OpenAI.Model = "gpt-4o-mini"
+	cfg.Weather.FallbackProvider = config.WeatherProviderNWS
+	parser := caltrans.NewFeedParser()
+	parser.URLs.ChainControls = "https://mirror.example.com/cc.kml"
+	roads := services.NewRoadsService(nil, parser, cache.NewCache(), cfg, nil, nil)
+
+	rec := httptest.NewRecorder()
+	versionHandler(roads)(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var body struct {
+		Version     string `json:"version"`
+		Commit      string `json:"commit"`
+		GoVersion   string `json:"goVersion"`
+		DataSources struct {
+			ChainControlsURL string   `json:"chainControlsUrl"`
+			LaneClosuresURL  string   `json:"laneClosuresUrl"`
+			LLMProvider      string   `json:"llmProvider"`
+			LLMModel         string   `json:"llmModel"`
+			WeatherProviders []string `json:"weatherProviders"`
+		} `json:"dataSources"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "v1.4.0-test", body.Version)
+	assert.Equal(t, "0123abcd", body.Commit)
+	assert.NotEmpty(t, body.GoVersion)
+	assert.Equal(t, "https://mirror.example.com/cc.kml", body.DataSources.ChainControlsURL)
+	assert.Equal(t, caltrans.DefaultLaneClosuresURL, body.DataSources.LaneClosuresURL)
+	assert.Equal(t, "openai", body.DataSources.LLMProvider)
+	assert.Equal(t, "gpt-4o-mini", body.DataSources.LLMModel)
+	assert.Equal(t, []string{"openweather", "nws"}, body.DataSources.WeatherProviders)
+}
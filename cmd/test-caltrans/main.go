@@ -4,14 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 
+	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/offline"
 )
 
 func main() {
@@ -79,42 +78,6 @@ func main() {
 	fmt.Printf("\n🎉 All Caltrans KML parser tests completed!\n")
 }
 
-// mockHTTPClient provides local KML file responses for testing
-type mockHTTPClient struct {
-	testDataDir string
-}
-
-func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
-	var filename string
-	switch req.URL.String() {
-	case "https://quickmap.dot.ca.gov/data/lcs2way.kml":
-		filename = "lane_closures.kml"
-	case "https://quickmap.dot.ca.gov/data/chp-only.kml":
-		filename = "chp_incidents.kml"
-	case "https://quickmap.dot.ca.gov/data/cc.kml":
-		filename = "chain_controls.kml"
-	default:
-		return &http.Response{
-			StatusCode: 404,
-			Body:       io.NopCloser(strings.NewReader("Not found")),
-		}, nil
-	}
-
-	filePath := filepath.Join(m.testDataDir, filename)
-	file, err := os.Open(filePath)
-	if err != nil {
-		return &http.Response{
-			StatusCode: 500,
-			Body:       io.NopCloser(strings.NewReader("Internal server error")),
-		}, err
-	}
-
-	return &http.Response{
-		StatusCode: 200,
-		Body:       file,
-	}, nil
-}
-
 func createOfflineParser() *caltrans.FeedParser {
 	// Get the test data directory relative to the executable
 	execDir, err := os.Executable()
@@ -124,18 +87,18 @@ func createOfflineParser() *caltrans.FeedParser {
 	}
 	
 	// Look for test data relative to project root
-	testDataDir := filepath.Join(filepath.Dir(execDir), "..", "tests", "testdata", "caltrans")
+	testDataDir := filepath.Join(filepath.Dir(execDir), "..", "tests", "testdata")
 	
 	// Also try relative to current working directory
-	if _, err := os.Stat(testDataDir); err != nil {
-		testDataDir = filepath.Join("tests", "testdata", "caltrans")
-		if _, err := os.Stat(testDataDir); err != nil {
+	if _, err := os.Stat(filepath.Join(testDataDir, "caltrans")); err != nil {
+		testDataDir = filepath.Join("tests", "testdata")
+		if _, err := os.Stat(filepath.Join(testDataDir, "caltrans")); err != nil {
 			log.Fatalf("Test data not found. Run from project root or ensure tests/testdata/caltrans/ exists")
 		}
 	}
 
 	return &caltrans.FeedParser{
-		HTTPClient: &mockHTTPClient{testDataDir: testDataDir},
+		HTTPClient: offline.NewHTTPDoer(testDataDir),
 	}
 }
 
@@ -201,6 +164,12 @@ func printSampleIncident(label string, incident caltrans.CaltransIncident) {
 	if len(incident.ParsedDates) > 0 {
 		fmt.Printf("  Dates: %v\n", incident.ParsedDates)
 	}
+	if incident.Lanes.Known() {
+		fmt.Printf("  Lanes closed: %s\n", incident.Lanes)
+	}
+	if incident.Severity != api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED {
+		fmt.Printf("  Severity: %s\n", incident.Severity)
+	}
 }
 
 func truncateString(s string, maxLen int) string {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 	"github.com/dpup/info.ersn.net/server/internal/config"
 )
 
@@ -98,7 +100,7 @@ func main() {
 	fmt.Printf("Testing ComputeRoutes...\n")
 	route, err := client.ComputeRoutes(context.Background(), origin, destination)
 	if err != nil {
-		log.Fatalf("ComputeRoutes failed: %v", err)
+		log.Fatalf("ComputeRoutes failed: %v%s", err, upstreamHint(err))
 	}
 
 	fmt.Printf("✅ ComputeRoutes successful!\n")
@@ -109,6 +111,20 @@ func main() {
 	fmt.Printf("\n🎉 All Google Routes API tests passed!\n")
 }
 
+// upstreamHint suggests a fix for common API failures
+func upstreamHint(err error) string {
+	switch {
+	case errors.Is(err, upstream.ErrUnauthorized):
+		return " (check the API key and that the Routes API is enabled)"
+	case errors.Is(err, upstream.ErrRateLimited):
+		return " (rate limited; wait a minute and retry)"
+	case errors.Is(err, upstream.ErrUpstreamUnavailable):
+		return " (API unreachable or down; check connectivity and retry)"
+	default:
+		return ""
+	}
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-help" || os.Args[1] == "--help" {
+		usage()
+		return
+	}
+
+	switch os.Args[1] {
+	case "classify-batch":
+		classifyBatch(os.Args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Printf("Route Matcher Test Tool\n\n")
+	fmt.Printf("Classifies alerts against route geometry offline, as the server does.\n\n")
+	fmt.Printf("Usage: %s <command> [options]\n\n", os.Args[0])
+	fmt.Printf("Commands:\n")
+	fmt.Printf("  classify-batch   Classify an array of alerts against an array of routes\n")
+	fmt.Printf("\nExamples:\n")
+	fmt.Printf("  %s classify-batch -alerts=alerts.json -routes=routes.json\n", os.Args[0])
+	fmt.Printf("  %s classify-batch -alerts=alerts.json -routes=routes.json -on-route-threshold=150\n", os.Args[0])
+}
+
+// classifyBatch reads a JSON array of routing.UnclassifiedAlert and a JSON
+// array of routing.Route, and prints a JSON array of routing.ClassifiedAlert
+// in input order. Routes may give their geometry as polyline.points or as a
+// Google polyline.encoded_polyline.
+func classifyBatch(args []string) {
+	flags := flag.NewFlagSet("classify-batch", flag.ExitOnError)
+	var (
+		alertsFile  = flags.String("alerts", "", "Path to JSON array of alerts (required)")
+		routesFile  = flags.String("routes", "", "Path to JSON array of routes (required)")
+		threshold   = flags.Float64("on-route-threshold", routing.DefaultOnRouteThresholdMeters, "Distance in meters within which an alert is ON_ROUTE")
+		overlap     = flags.Float64("closure-overlap-percent", routing.DefaultOnRouteOverlapPercent, "Percent of a closure running along a route that makes it ON_ROUTE (100 disables)")
+		overlapDist = flags.Float64("closure-overlap-meters", 0, "Distance in meters within which a closure runs along a route (0 = twice -on-route-threshold)")
+	)
+	_ = flags.Parse(args)
+
+	if *alertsFile == "" || *routesFile == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var alerts []routing.UnclassifiedAlert
+	if err := readJSON(*alertsFile, &alerts); err != nil {
+		log.Fatalf("Failed to read alerts: %v", err)
+	}
+	var routes []routing.Route
+	if err := readJSON(*routesFile, &routes); err != nil {
+		log.Fatalf("Failed to read routes: %v", err)
+	}
+
+	geoUtils := geo.NewGeoUtils()
+	for i, route := range routes {
+		if len(route.Polyline.Points) == 0 && route.Polyline.EncodedPolyline != "" {
+			points, err := geoUtils.DecodePolyline(route.Polyline.EncodedPolyline)
+			if err != nil {
+				log.Fatalf("Failed to decode polyline for route %s: %v", route.ID, err)
+			}
+			routes[i].Polyline.Points = points
+		}
+	}
+
+	matcher := routing.NewRouteMatcherWithThreshold(*threshold,
+		routing.WithOnRouteOverlapPercent(*overlap), routing.WithOverlapThresholdMeters(*overlapDist))
+	classified, err := matcher.ClassifyAlerts(context.Background(), alerts, routes)
+	if err != nil {
+		log.Fatalf("Failed to classify alerts: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(classified); err != nil {
+		log.Fatalf("Failed to write classifications: %v", err)
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
 	"github.com/dpup/info.ersn.net/server/internal/config"
 )
@@ -19,6 +21,7 @@ func main() {
 		lat        = flag.Float64("lat", 38.139117, "Latitude for weather lookup")
 		lon        = flag.Float64("lon", -120.456111, "Longitude for weather lookup")
 		name       = flag.String("name", "Murphys, CA", "Location name for display")
+		units      = flag.String("units", "metric", "Units for current weather: metric, imperial or standard")
 		help       = flag.Bool("help", false, "Show help")
 	)
 	flag.Parse()
@@ -32,6 +35,7 @@ func main() {
 		fmt.Printf("\nExamples:\n")
 		fmt.Printf("  %s -api-key=YOUR_KEY\n", os.Args[0])
 		fmt.Printf("  %s -lat=37.7749 -lon=-122.4194 -name=\"San Francisco, CA\"\n", os.Args[0])
+		fmt.Printf("  %s -units=imperial\n", os.Args[0])
 		fmt.Printf("  %s --config=prefab.yaml\n", os.Args[0])
 		fmt.Printf("  PF__OPENWEATHER__API_KEY=your_key %s\n", os.Args[0])
 		return
@@ -81,14 +85,16 @@ func main() {
 
 	// Test current weather
 	fmt.Printf("Testing GetCurrentWeather...\n")
-	current, err := client.GetCurrentWeather(ctx, coords)
+	current, err := client.GetCurrentWeatherInUnits(ctx, coords, weather.Units(*units))
 	if err != nil {
-		log.Fatalf("GetCurrentWeather failed: %v", err)
+		log.Fatalf("GetCurrentWeather failed: %v%s", err, upstreamHint(err))
 	}
 
 	fmt.Printf("✅ GetCurrentWeather successful!\n")
 	fmt.Printf("Temperature: %d°C (feels like %d°C)\n",
 		current.TemperatureCelsius, current.FeelsLikeCelsius)
+	fmt.Printf("In %s units: %.1f (feels like %.1f), wind %.1f\n",
+		current.Units, current.Temperature, current.FeelsLike, current.WindSpeed)
 	fmt.Printf("Condition: %s\n", current.WeatherMain)
 	fmt.Printf("Description: %s\n", current.WeatherDescription)
 	fmt.Printf("Humidity: %.0f%%\n", float64(current.HumidityPercent))
@@ -102,7 +108,7 @@ func main() {
 	fmt.Printf("Testing GetWeatherAlerts...\n")
 	alerts, err := client.GetWeatherAlerts(ctx, coords)
 	if err != nil {
-		log.Fatalf("GetWeatherAlerts failed: %v", err)
+		log.Fatalf("GetWeatherAlerts failed: %v%s", err, upstreamHint(err))
 	}
 
 	fmt.Printf("✅ GetWeatherAlerts successful!\n")
@@ -158,6 +164,20 @@ func main() {
 	fmt.Printf("\n🎉 All OpenWeatherMap API tests completed!\n")
 }
 
+// upstreamHint suggests a fix for common API failures
+func upstreamHint(err error) string {
+	switch {
+	case errors.Is(err, upstream.ErrUnauthorized):
+		return " (check the API key; One Call 3.0 needs its own subscription)"
+	case errors.Is(err, upstream.ErrRateLimited):
+		return " (rate limited; wait a minute and retry)"
+	case errors.Is(err, upstream.ErrUpstreamUnavailable):
+		return " (API unreachable or down; check connectivity and retry)"
+	default:
+		return ""
+	}
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
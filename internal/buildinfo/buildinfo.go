@@ -0,0 +1,57 @@
+// Package buildinfo identifies the running build. Release builds set the
+// variables with -ldflags, e.g.
+//
+//	go build -ldflags "-X github.com/dpup/info.ersn.net/server/internal/buildinfo.Version=v1.4.0 \
+//	  -X github.com/dpup/info.ersn.net/server/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/dpup/info.ersn.net/server/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/server
+//
+// Builds without them fall back to the VCS details the Go toolchain stamps.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set with -ldflags -X at build time
+var (
+	Version   = ""
+	Commit    = ""
+	BuildTime = "" // RFC 3339
+)
+
+// DevVersion is reported when no version was set at build time
+const DevVersion = "dev"
+
+// Info describes the running build
+type Info struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	GoVersion string
+}
+
+// Get returns the running build's details
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+	if info.Version == "" {
+		info.Version = DevVersion
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range bi.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = setting.Value
+			}
+		}
+	}
+	return info
+}
@@ -1,10 +1,13 @@
 package cache
 
 import (
+	"container/heap"
+	"container/list"
 	"context"
 	"encoding/json"
 	"fmt"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +20,21 @@ import (
 type Cache struct {
 	entries map[string]*CacheEntry
 	mutex   sync.RWMutex
+
+	limits    Limits
+	sizeBytes int64      // Sum of entrySize for all entries
+	evictions int64      // Entries removed to stay within limits
+	reaped    int64      // Very stale entries removed by ReapVeryStale
+	recency   *list.List // Entries, most recently used first
+	expiries  expiryHeap // Entries, soonest to expire first
+}
+
+// Limits bounds the cache size. Zero values mean unbounded. When a Set would
+// exceed a limit, stale entries are evicted before fresh ones, the longest
+// expired first, and fresh entries least recently used first.
+type Limits struct {
+	MaxEntries int
+	MaxBytes   int64 // Approximate: serialized data plus key length
 }
 
 // CacheEntry represents a cached item with metadata
@@ -28,15 +46,65 @@ type CacheEntry struct {
 	ExpiresAt       time.Time `json:"expires_at"`
 	RefreshInterval time.Duration `json:"refresh_interval"`
 	Source          string    `json:"source"`
+
+	element     *list.Element // Position in Cache.recency
+	expiryIndex int           // Position in Cache.expiries
 }
 
 // NewCache creates a new in-memory cache
 func NewCache() *Cache {
+	return NewCacheWithLimits(Limits{})
+}
+
+// NewCacheWithLimits creates a new in-memory cache that evicts entries to
+// stay within limits
+func NewCacheWithLimits(limits Limits) *Cache {
 	return &Cache{
 		entries: make(map[string]*CacheEntry),
+		limits:  limits,
+		recency: list.New(),
 	}
 }
 
+// touch marks entry as just used. Entries removed since they were read are
+// left alone.
+func (c *Cache) touch(entry *CacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.recency.MoveToFront(entry.element)
+}
+
+// expiryHeap orders entries by ExpiresAt, soonest first (see container/heap)
+type expiryHeap []*CacheEntry
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].ExpiresAt.Before(h[j].ExpiresAt) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].expiryIndex = i
+	h[j].expiryIndex = j
+}
+
+func (h *expiryHeap) Push(x any) {
+	entry := x.(*CacheEntry)
+	entry.expiryIndex = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// entrySize approximates the memory held by entry
+func entrySize(entry *CacheEntry) int64 {
+	return int64(len(entry.Key) + len(entry.Data))
+}
+
 // Set stores data in cache with TTL based on refresh interval
 func (c *Cache) Set(key string, data interface{}, refreshInterval time.Duration, source string) error {
 	// Serialize data to JSON
@@ -58,10 +126,82 @@ func (c *Cache) Set(key string, data interface{}, refreshInterval time.Duration,
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	
-	c.entries[key] = entry
+	c.removeLocked(key)
+	c.addLocked(entry)
+	c.evictLocked(key, now)
 	return nil
 }
 
+// addLocked stores entry as the most recently used. Caller holds the write
+// lock and has removed any entry with the same key.
+func (c *Cache) addLocked(entry *CacheEntry) {
+	c.entries[entry.Key] = entry
+	c.sizeBytes += entrySize(entry)
+	entry.element = c.recency.PushFront(entry)
+	heap.Push(&c.expiries, entry)
+}
+
+// removeLocked deletes key, keeping sizeBytes and the eviction order in step.
+// Caller holds the write lock.
+func (c *Cache) removeLocked(key string) {
+	if entry, exists := c.entries[key]; exists {
+		c.sizeBytes -= entrySize(entry)
+		delete(c.entries, key)
+		c.recency.Remove(entry.element)
+		heap.Remove(&c.expiries, entry.expiryIndex)
+	}
+}
+
+// overLimitLocked reports whether the cache exceeds its limits
+func (c *Cache) overLimitLocked() bool {
+	return (c.limits.MaxEntries > 0 && len(c.entries) > c.limits.MaxEntries) ||
+		(c.limits.MaxBytes > 0 && c.sizeBytes > c.limits.MaxBytes)
+}
+
+// evictLocked removes entries until the cache is within its limits, taking
+// the longest expired stale entry first and otherwise the least recently used.
+// keep (the entry just written) is never evicted. Caller holds the write lock.
+func (c *Cache) evictLocked(keep string, now time.Time) {
+	for c.overLimitLocked() {
+		victim := c.staleVictimLocked(keep, now)
+		if victim == nil {
+			for e := c.recency.Back(); e != nil; e = e.Prev() {
+				if entry := e.Value.(*CacheEntry); entry.Key != keep {
+					victim = entry
+					break
+				}
+			}
+		}
+		if victim == nil {
+			return // Only the new entry is left; keep it even if oversized
+		}
+		c.removeLocked(victim.Key)
+		c.evictions++
+	}
+}
+
+// staleVictimLocked returns the entry other than keep that expired first, if
+// it is stale. keep can only displace the root, so the next soonest is one of
+// its children. Caller holds the write lock.
+func (c *Cache) staleVictimLocked(keep string, now time.Time) *CacheEntry {
+	candidates := c.expiries
+	if len(candidates) > 0 && candidates[0].Key == keep {
+		candidates = candidates[1:min(3, len(candidates))]
+	} else if len(candidates) > 0 {
+		candidates = candidates[:1]
+	}
+	var victim *CacheEntry
+	for _, entry := range candidates {
+		if victim == nil || entry.ExpiresAt.Before(victim.ExpiresAt) {
+			victim = entry
+		}
+	}
+	if victim == nil || !now.After(victim.ExpiresAt) {
+		return nil
+	}
+	return victim
+}
+
 // Get retrieves data from cache if not stale
 func (c *Cache) Get(key string, result interface{}) (bool, error) {
 	c.mutex.RLock()
@@ -81,6 +221,7 @@ func (c *Cache) Get(key string, result interface{}) (bool, error) {
 	if err := json.Unmarshal(entry.Data, result); err != nil {
 		return false, fmt.Errorf("failed to unmarshal cached data: %w", err)
 	}
+	c.touch(entry)
 
 	return true, nil
 }
@@ -129,6 +270,7 @@ func (c *Cache) GetWithMetadata(key string, result interface{}) (*CacheEntry, bo
 			return entry, exists, fmt.Errorf("failed to unmarshal cached data: %w", err)
 		}
 	}
+	c.touch(entry)
 
 	return entry, exists, nil
 }
@@ -138,7 +280,7 @@ func (c *Cache) Delete(key string) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	
-	delete(c.entries, key)
+	c.removeLocked(key)
 }
 
 // Clear removes all entries from cache
@@ -147,6 +289,9 @@ func (c *Cache) Clear() {
 	defer c.mutex.Unlock()
 	
 	c.entries = make(map[string]*CacheEntry)
+	c.sizeBytes = 0
+	c.recency = list.New() // A fresh list, so touching a cleared entry is a no-op
+	c.expiries = nil
 }
 
 // Keys returns all cache keys
@@ -169,13 +314,19 @@ func (c *Cache) Stats() CacheStats {
 	now := time.Now()
 	stats := CacheStats{
 		TotalEntries: len(c.entries),
+		SizeBytes:    c.sizeBytes,
+		Evictions:    c.evictions,
+		Reaped:       c.reaped,
 	}
 
-	for _, entry := range c.entries {
+	for key, entry := range c.entries {
 		if now.After(entry.ExpiresAt) {
 			stats.StaleEntries++
 		} else {
 			stats.FreshEntries++
+			if strings.HasPrefix(key, enhancedAlertKeyPrefix) {
+				stats.EnhancedAlerts++
+			}
 		}
 		
 		// Update oldest/newest
@@ -200,7 +351,7 @@ func (c *Cache) CleanupStale() int {
 
 	for key, entry := range c.entries {
 		if now.After(entry.ExpiresAt) {
-			delete(c.entries, key)
+			c.removeLocked(key)
 			removed++
 		}
 	}
@@ -208,6 +359,25 @@ func (c *Cache) CleanupStale() int {
 	return removed
 }
 
+// ReapVeryStale removes entries that were very stale (older than 2x their
+// refresh interval) as of now. Stale-but-recent entries are kept for
+// stale-data fallbacks.
+func (c *Cache) ReapVeryStale(now time.Time) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var removed int
+	for key, entry := range c.entries {
+		if now.After(entry.CreatedAt.Add(entry.RefreshInterval * 2)) {
+			c.removeLocked(key)
+			removed++
+		}
+	}
+	c.reaped += int64(removed)
+
+	return removed
+}
+
 // StartPeriodicCleanup starts a goroutine that periodically cleans up stale entries
 func (c *Cache) StartPeriodicCleanup(ctx context.Context, interval time.Duration) {
 	go func() {
@@ -237,26 +407,33 @@ func (c *Cache) StartPeriodicCleanup(ctx context.Context, interval time.Duration
 
 // CacheStats provides cache usage statistics
 type CacheStats struct {
-	TotalEntries  int
-	FreshEntries  int
-	StaleEntries  int
-	OldestEntry   time.Time
-	NewestEntry   time.Time
+	TotalEntries   int
+	FreshEntries   int
+	StaleEntries   int
+	OldestEntry    time.Time
+	NewestEntry    time.Time
+	SizeBytes      int64 // Approximate size of all entries
+	Evictions      int64 // Entries evicted to stay within Limits
+	Reaped         int64 // Very stale entries removed by the janitor
+	EnhancedAlerts int   // Fresh entries stored by SetEnhancedAlert
 }
 
 
 // Simplified Content-Based Caching Methods
 // These replace the complex incident processing infrastructure
 
+// enhancedAlertKeyPrefix prefixes content hashes in enhanced-alert keys
+const enhancedAlertKeyPrefix = "enhanced_alert:"
+
 // SetEnhancedAlert caches an OpenAI-enhanced alert with content-based key
 func (c *Cache) SetEnhancedAlert(contentHash string, enhanced interface{}, ttl time.Duration) error {
-	key := fmt.Sprintf("enhanced_alert:%s", contentHash)
+	key := enhancedAlertKeyPrefix + contentHash
 	return c.Set(key, enhanced, ttl, "enhanced_alert")
 }
 
 // GetEnhancedAlert retrieves a cached enhanced alert by content hash
 func (c *Cache) GetEnhancedAlert(contentHash string) (interface{}, bool, error) {
-	key := fmt.Sprintf("enhanced_alert:%s", contentHash)
+	key := enhancedAlertKeyPrefix + contentHash
 	
 	var enhanced interface{}
 	found, err := c.Get(key, &enhanced)
@@ -269,6 +446,6 @@ func (c *Cache) GetEnhancedAlert(contentHash string) (interface{}, bool, error)
 
 // IsEnhancedAlertCached checks if an enhanced alert exists without retrieving it
 func (c *Cache) IsEnhancedAlertCached(contentHash string) bool {
-	key := fmt.Sprintf("enhanced_alert:%s", contentHash)
+	key := enhancedAlertKeyPrefix + contentHash
 	return !c.IsStale(key)
 }
\ No newline at end of file
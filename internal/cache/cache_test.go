@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCacheWithLimits(Limits{MaxEntries: 3})
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := c.Set(key, key, time.Hour, "test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Reading "a" makes "b" the least recently used
+	var v string
+	if found, _ := c.Get("a", &v); !found {
+		t.Fatal("a should be cached")
+	}
+
+	if err := c.Set("d", "d", time.Hour, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, exists, _ := c.GetWithMetadata("b", nil); exists {
+		t.Error("b should have been evicted")
+	}
+	for _, key := range []string{"a", "c", "d"} {
+		if _, exists, _ := c.GetWithMetadata(key, nil); !exists {
+			t.Errorf("%s should still be cached", key)
+		}
+	}
+
+	stats := c.Stats()
+	if stats.TotalEntries != 3 || stats.Evictions != 1 {
+		t.Errorf("entries=%d evictions=%d, want 3 and 1", stats.TotalEntries, stats.Evictions)
+	}
+}
+
+func TestCache_EvictsStaleBeforeFresh(t *testing.T) {
+	c := NewCacheWithLimits(Limits{MaxEntries: 2})
+
+	_ = c.Set("stale", "x", time.Nanosecond, "test")
+	time.Sleep(time.Millisecond)
+	_ = c.Set("fresh", "x", time.Hour, "test")
+
+	// "fresh" is older than the touch below, but stale entries go first
+	var v string
+	_, _, _ = c.GetWithMetadata("stale", &v)
+	_ = c.Set("new", "x", time.Hour, "test")
+
+	if _, exists, _ := c.GetWithMetadata("stale", nil); exists {
+		t.Error("stale entry should have been evicted first")
+	}
+	if _, exists, _ := c.GetWithMetadata("fresh", nil); !exists {
+		t.Error("fresh entry should be kept")
+	}
+}
+
+func TestCache_EvictsLongestExpiredFirst(t *testing.T) {
+	c := NewCacheWithLimits(Limits{MaxEntries: 3})
+
+	_ = c.Set("expired-first", "x", time.Nanosecond, "test")
+	time.Sleep(time.Millisecond)
+	_ = c.Set("expired-later", "x", 2*time.Millisecond, "test")
+	_ = c.Set("fresh", "x", time.Hour, "test")
+	time.Sleep(5 * time.Millisecond)
+
+	// Overwriting and reading doesn't change which stale entry goes first
+	_ = c.Set("fresh", "y", time.Hour, "test")
+	_, _, _ = c.GetWithMetadata("expired-first", nil)
+
+	_ = c.Set("new", "x", time.Hour, "test")
+	for key, want := range map[string]bool{"expired-first": false, "expired-later": true, "fresh": true, "new": true} {
+		if _, exists, _ := c.GetWithMetadata(key, nil); exists != want {
+			t.Errorf("%s cached = %v, want %v", key, exists, want)
+		}
+	}
+
+	// Entries read after they were cleared don't come back into the order
+	entry, _, _ := c.GetWithMetadata("fresh", nil)
+	c.Clear()
+	c.touch(entry)
+	_ = c.Set("a", "x", time.Hour, "test")
+	if stats := c.Stats(); stats.TotalEntries != 1 || c.recency.Len() != 1 {
+		t.Errorf("entries=%d recency=%d, want 1 and 1", stats.TotalEntries, c.recency.Len())
+	}
+}
+
+func TestCache_MaxBytes(t *testing.T) {
+	// Each entry is a 1-byte key plus 12 bytes of JSON ("0123456789" quoted)
+	c := NewCacheWithLimits(Limits{MaxBytes: 30})
+
+	for _, key := range []string{"a", "b", "c"} {
+		_ = c.Set(key, "0123456789", time.Hour, "test")
+	}
+
+	stats := c.Stats()
+	if stats.TotalEntries != 2 || stats.Evictions != 1 || stats.SizeBytes != 26 {
+		t.Errorf("entries=%d evictions=%d size=%d, want 2, 1, 26", stats.TotalEntries, stats.Evictions, stats.SizeBytes)
+	}
+	if _, exists, _ := c.GetWithMetadata("a", nil); exists {
+		t.Error("oldest entry should have been evicted")
+	}
+
+	c.Delete("b")
+	if got := c.Stats().SizeBytes; got != 13 {
+		t.Errorf("size after delete = %d, want 13", got)
+	}
+}
+
+func TestCache_UnboundedByDefault(t *testing.T) {
+	c := NewCache()
+	for i := 0; i < 100; i++ {
+		_ = c.Set(string(rune('a'+i%26))+string(rune('0'+i/26)), i, time.Hour, "test")
+	}
+	if stats := c.Stats(); stats.TotalEntries != 100 || stats.Evictions != 0 {
+		t.Errorf("entries=%d evictions=%d, want 100 and 0", stats.TotalEntries, stats.Evictions)
+	}
+}
+
+func TestCache_StatsCountsEnhancedAlerts(t *testing.T) {
+	c := NewCache()
+
+	_ = c.SetEnhancedAlert("fresh", "x", time.Hour)
+	_ = c.SetEnhancedAlert("stale", "x", time.Nanosecond)
+	_ = c.Set("road:1", "x", time.Hour, "test")
+	time.Sleep(time.Millisecond)
+
+	if got := c.Stats().EnhancedAlerts; got != 1 {
+		t.Errorf("enhanced alerts=%d, want 1 (stale and other entries don't count)", got)
+	}
+}
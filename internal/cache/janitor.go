@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+)
+
+// Janitor periodically reaps very stale entries so memory is bounded between
+// accesses, not only when a key is read or the cache is full
+type Janitor struct {
+	cache    *Cache
+	interval time.Duration
+
+	// Background control
+	mutex    sync.Mutex
+	stopChan chan struct{}
+	running  bool
+}
+
+// NewJanitor creates a janitor that sweeps c every interval
+func NewJanitor(c *Cache, interval time.Duration) *Janitor {
+	return &Janitor{
+		cache:    c,
+		interval: interval,
+	}
+}
+
+// Start begins sweeping in the background until Stop is called or ctx is done
+func (j *Janitor) Start(ctx context.Context) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.running {
+		return nil // Already running
+	}
+	j.running = true
+	j.stopChan = make(chan struct{})
+	stopChan := j.stopChan
+
+	logging.Infow(ctx, "Starting cache janitor", "interval", j.interval)
+
+	go func() {
+		defer func() {
+			// Recover from any panics in the janitor goroutine
+			if r := recover(); r != nil {
+				err, _ := errors.ParseStack(debug.Stack())
+				skipFrames := 3
+				numFrames := 5
+				logging.Errorw(ctx, "Cache janitor: recovered from panic",
+					"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+			}
+			j.mutex.Lock()
+			if j.stopChan == stopChan {
+				j.running = false
+			}
+			j.mutex.Unlock()
+		}()
+
+		j.sweepLoop(ctx, stopChan)
+	}()
+
+	return nil
+}
+
+// Stop ends background sweeping
+func (j *Janitor) Stop() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if !j.running {
+		return
+	}
+	j.running = false
+	close(j.stopChan)
+}
+
+// IsRunning reports whether the janitor is sweeping
+func (j *Janitor) IsRunning() bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.running
+}
+
+// sweepLoop reaps very stale entries every interval
+func (j *Janitor) sweepLoop(ctx context.Context, stopChan chan struct{}) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopChan:
+			return
+		case now := <-ticker.C:
+			if reaped := j.cache.ReapVeryStale(now); reaped > 0 {
+				logging.Infow(ctx, "Cache janitor reaped very stale entries", "reaped", reaped)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+)
+
+func TestReapVeryStale(t *testing.T) {
+	c := NewCache()
+	_ = c.Set("short-1", 1, time.Minute, "test")
+	_ = c.Set("short-2", 2, time.Minute, "test")
+	_ = c.Set("long", 3, time.Hour, "test")
+
+	// One minute on, the short entries are stale but still usable as fallbacks
+	if reaped := c.ReapVeryStale(time.Now().Add(90 * time.Second)); reaped != 0 {
+		t.Errorf("reaped %d merely stale entries, want 0", reaped)
+	}
+
+	// Past 2x their interval they're very stale and go
+	if reaped := c.ReapVeryStale(time.Now().Add(3 * time.Minute)); reaped != 2 {
+		t.Errorf("reaped = %d, want 2", reaped)
+	}
+	if _, exists, _ := c.GetWithMetadata("long", nil); !exists {
+		t.Error("long-lived entry should survive")
+	}
+
+	stats := c.Stats()
+	if stats.TotalEntries != 1 || stats.Reaped != 2 {
+		t.Errorf("entries=%d reaped=%d, want 1 and 2", stats.TotalEntries, stats.Reaped)
+	}
+}
+
+func TestJanitor_StartStop(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	c := NewCache()
+	_ = c.Set("short", 1, time.Millisecond, "test")
+
+	j := NewJanitor(c, 5*time.Millisecond)
+	if err := j.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !j.IsRunning() {
+		t.Fatal("janitor should be running")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for c.Stats().Reaped == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if _, exists, _ := c.GetWithMetadata("short", nil); exists {
+		t.Error("janitor should have reaped the very stale entry")
+	}
+
+	j.Stop()
+	if j.IsRunning() {
+		t.Error("janitor should be stopped")
+	}
+	j.Stop() // Safe to call twice
+}
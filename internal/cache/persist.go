@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+)
+
+// persistedCache is the on-disk snapshot format
+type persistedCache struct {
+	SavedAt time.Time     `json:"saved_at"`
+	Entries []*CacheEntry `json:"entries"`
+}
+
+// SaveToFile writes all unexpired entries to path as JSON. The file is
+// written to a temp file and renamed so a crash never leaves a partial snapshot.
+func (c *Cache) SaveToFile(path string) (int, error) {
+	now := time.Now()
+
+	c.mutex.RLock()
+	snapshot := persistedCache{SavedAt: now, Entries: make([]*CacheEntry, 0, len(c.entries))}
+	for _, entry := range c.entries {
+		if now.Before(entry.ExpiresAt) {
+			snapshot.Entries = append(snapshot.Entries, entry)
+		}
+	}
+	data, err := json.Marshal(snapshot)
+	c.mutex.RUnlock()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal cache snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create cache snapshot: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }() // No-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("failed to write cache snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write cache snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("failed to replace cache snapshot: %w", err)
+	}
+
+	return len(snapshot.Entries), nil
+}
+
+// LoadFromFile restores entries saved by SaveToFile, keeping their original
+// expiry and skipping any that have since expired. A missing file is not an
+// error (first start). Loaded entries are subject to the cache's limits.
+func (c *Cache) LoadFromFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read cache snapshot: %w", err)
+	}
+
+	var snapshot persistedCache
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return 0, fmt.Errorf("failed to parse cache snapshot: %w", err)
+	}
+
+	// Oldest first so the most recently created entries survive eviction
+	sort.Slice(snapshot.Entries, func(i, j int) bool {
+		return snapshot.Entries[i].CreatedAt.Before(snapshot.Entries[j].CreatedAt)
+	})
+
+	now := time.Now()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var loaded int
+	for _, entry := range snapshot.Entries {
+		if entry == nil || entry.Key == "" || !now.Before(entry.ExpiresAt) {
+			continue
+		}
+		if _, exists := c.entries[entry.Key]; exists {
+			continue // Data written since startup is newer than the snapshot
+		}
+		c.addLocked(entry)
+		c.evictLocked(entry.Key, now)
+		loaded++
+	}
+
+	return loaded, nil
+}
+
+// StartPeriodicPersist starts a goroutine that saves the cache to path every
+// interval until ctx is done
+func (c *Cache) StartPeriodicPersist(ctx context.Context, path string, interval time.Duration) {
+	go func() {
+		defer func() {
+			// Recover from any panics in the cache persist goroutine
+			if r := recover(); r != nil {
+				err, _ := errors.ParseStack(debug.Stack())
+				skipFrames := 3
+				numFrames := 5
+				logging.Errorw(ctx, "Cache persist: recovered from panic",
+					"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := c.SaveToFile(path); err != nil {
+					logging.Errorw(ctx, "Cache persist failed", "path", path, "error", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache_PersistRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "snapshot.json")
+
+	c := NewCache()
+	_ = c.Set("enhanced_alert:abc", map[string]string{"summary": "Tree down"}, time.Hour, "enhanced_alert")
+	_ = c.Set("weather:all", []int{1, 2, 3}, 10*time.Minute, "openweather")
+	_ = c.Set("expired", "gone", time.Nanosecond, "test")
+	time.Sleep(time.Millisecond)
+
+	saved, err := c.SaveToFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved != 2 {
+		t.Errorf("saved = %d, want 2 (expired entry skipped)", saved)
+	}
+
+	restored := NewCache()
+	loaded, err := restored.LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded != 2 {
+		t.Errorf("loaded = %d, want 2", loaded)
+	}
+
+	var alert map[string]string
+	if found, err := restored.Get("enhanced_alert:abc", &alert); err != nil || !found || alert["summary"] != "Tree down" {
+		t.Errorf("enhanced alert = %v (found=%v, err=%v)", alert, found, err)
+	}
+
+	for _, key := range []string{"enhanced_alert:abc", "weather:all"} {
+		original, _, _ := c.GetWithMetadata(key, nil)
+		got, exists, _ := restored.GetWithMetadata(key, nil)
+		if !exists {
+			t.Fatalf("%s not restored", key)
+		}
+		if !got.ExpiresAt.Equal(original.ExpiresAt) || got.RefreshInterval != original.RefreshInterval || got.Source != original.Source {
+			t.Errorf("%s metadata = %+v, want %+v", key, got, original)
+		}
+	}
+	if _, exists, _ := restored.GetWithMetadata("expired", nil); exists {
+		t.Error("expired entry should not be restored")
+	}
+	if got, want := restored.Stats().SizeBytes, c.Stats().SizeBytes-entrySize(&CacheEntry{Key: "expired", Data: []byte(`"gone"`)}); got != want {
+		t.Errorf("size = %d, want %d", got, want)
+	}
+}
+
+func TestCache_LoadMissingFile(t *testing.T) {
+	c := NewCache()
+	loaded, err := c.LoadFromFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil || loaded != 0 {
+		t.Errorf("loaded=%d err=%v, want 0 and nil", loaded, err)
+	}
+}
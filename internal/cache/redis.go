@@ -0,0 +1,307 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisOpTimeout bounds each Redis call, since Store methods carry no context
+const redisOpTimeout = 2 * time.Second
+
+// DefaultRedisKeyPrefix namespaces keys when none is configured, so the cache
+// can share a Redis database with other applications
+const DefaultRedisKeyPrefix = "ersn:"
+
+// DefaultRedisStatsInterval is how often StartStatsRefresh rescans the keys
+// Stats reports on
+const DefaultRedisStatsInterval = time.Minute
+
+// RedisStore is a Store backed by Redis, letting replicas share cached data
+// (most importantly AI-enhanced alerts). Entries are stored as JSON
+// CacheEntry values. Redis expires them at 2x their refresh interval, so
+// stale data stays available for fallbacks until it would be very stale.
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+
+	// stats is the last scan's summary, nil until the first scan. A scan
+	// reads every key, too much to do on each metrics scrape.
+	statsMutex sync.Mutex
+	stats      *CacheStats
+}
+
+// NewRedisStore connects to the Redis server at url (e.g.
+// "redis://localhost:6379/0") and verifies the connection
+func NewRedisStore(url, keyPrefix string) (*RedisStore, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	if keyPrefix == "" {
+		keyPrefix = DefaultRedisKeyPrefix
+	}
+
+	store := &RedisStore{client: redis.NewClient(opts), prefix: keyPrefix}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+	if err := store.client.Ping(ctx).Err(); err != nil {
+		_ = store.client.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	return store, nil
+}
+
+// Close releases the Redis connection pool
+func (r *RedisStore) Close() error {
+	return r.client.Close()
+}
+
+// Set stores data with Redis expiry at 2x refreshInterval
+func (r *RedisStore) Set(key string, data interface{}, refreshInterval time.Duration, source string) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data for cache: %w", err)
+	}
+
+	now := time.Now()
+	entry := &CacheEntry{
+		Key:             key,
+		Data:            jsonData,
+		CreatedAt:       now,
+		ExpiresAt:       now.Add(refreshInterval),
+		RefreshInterval: refreshInterval,
+		Source:          source,
+	}
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	// Redis rejects expirations under 1ms
+	retention := max(2*refreshInterval, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+	if err := r.client.Set(ctx, r.prefix+key, encoded, retention).Err(); err != nil {
+		return fmt.Errorf("failed to write %s to redis: %w", key, err)
+	}
+	return nil
+}
+
+// SetEnhancedAlert caches an OpenAI-enhanced alert with content-based key
+func (r *RedisStore) SetEnhancedAlert(contentHash string, enhanced interface{}, ttl time.Duration) error {
+	return r.Set(enhancedAlertKeyPrefix+contentHash, enhanced, ttl, "enhanced_alert")
+}
+
+// entry loads the stored entry for key; nil without error when absent
+func (r *RedisStore) entry(key string) (*CacheEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+
+	raw, err := r.client.Get(ctx, r.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from redis: %w", key, err)
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cache entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// Get retrieves data from cache if not stale
+func (r *RedisStore) Get(key string, result interface{}) (bool, error) {
+	entry, err := r.entry(key)
+	if err != nil || entry == nil {
+		return false, err
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(entry.Data, result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal cached data: %w", err)
+	}
+	return true, nil
+}
+
+// GetWithMetadata retrieves data and cache metadata, even if stale
+func (r *RedisStore) GetWithMetadata(key string, result interface{}) (*CacheEntry, bool, error) {
+	entry, err := r.entry(key)
+	if err != nil || entry == nil {
+		return nil, false, err
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(entry.Data, result); err != nil {
+			return entry, true, fmt.Errorf("failed to unmarshal cached data: %w", err)
+		}
+	}
+	return entry, true, nil
+}
+
+// IsStale checks if cache entry is stale (past expiration). Redis errors
+// count as stale so callers refetch.
+func (r *RedisStore) IsStale(key string) bool {
+	entry, err := r.entry(key)
+	if err != nil || entry == nil {
+		return true
+	}
+	return time.Now().After(entry.ExpiresAt)
+}
+
+// IsVeryStale checks if cache entry is very stale (2x refresh interval)
+func (r *RedisStore) IsVeryStale(key string) bool {
+	entry, err := r.entry(key)
+	if err != nil || entry == nil {
+		return true
+	}
+	return time.Now().After(entry.CreatedAt.Add(entry.RefreshInterval * 2))
+}
+
+// Delete removes key if present. Redis errors are ignored; the entry then
+// expires on its own.
+func (r *RedisStore) Delete(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+	_ = r.client.Del(ctx, r.prefix+key).Err()
+}
+
+// Keys returns this store's keys without the prefix. Returns what was
+// gathered before any Redis error.
+func (r *RedisStore) Keys() []string {
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+
+	var keys []string
+	iter := r.client.Scan(ctx, 0, r.prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, strings.TrimPrefix(iter.Val(), r.prefix))
+	}
+	return keys
+}
+
+// Stats returns the summary from the last scan of this store's keys, which
+// StartStatsRefresh keeps current. The first call scans if no scan has run.
+func (r *RedisStore) Stats() CacheStats {
+	r.statsMutex.Lock()
+	stats := r.stats
+	r.statsMutex.Unlock()
+	if stats == nil {
+		return r.refreshStats()
+	}
+	return *stats
+}
+
+// StartStatsRefresh starts a goroutine that rescans the store's keys for
+// Stats every interval until ctx is done
+func (r *RedisStore) StartStatsRefresh(ctx context.Context, interval time.Duration) {
+	go func() {
+		defer func() {
+			// Recover from any panics in the stats refresh goroutine
+			if rec := recover(); rec != nil {
+				err, _ := errors.ParseStack(debug.Stack())
+				skipFrames := 3
+				numFrames := 5
+				logging.Errorw(ctx, "Redis stats refresh: recovered from panic",
+					"error", rec, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refreshStats()
+			}
+		}
+	}()
+}
+
+// refreshStats scans the store's keys, saving and returning their summary
+func (r *RedisStore) refreshStats() CacheStats {
+	stats := r.scanStats()
+	r.statsMutex.Lock()
+	r.stats = &stats
+	r.statsMutex.Unlock()
+	return stats
+}
+
+// scanStats scans this store's keys and summarizes them. Redis does its own
+// eviction, so Evictions is always zero. Returns what was gathered before
+// any Redis error.
+func (r *RedisStore) scanStats() CacheStats {
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+
+	var stats CacheStats
+	now := time.Now()
+	iter := r.client.Scan(ctx, 0, r.prefix+"*", 100).Iterator()
+	var batch []string
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		values, err := r.client.MGet(ctx, batch...).Result()
+		batch = batch[:0]
+		if err != nil {
+			return
+		}
+		for _, value := range values {
+			raw, ok := value.(string)
+			if !ok {
+				continue // Expired between SCAN and MGET
+			}
+			var entry CacheEntry
+			if json.Unmarshal([]byte(raw), &entry) != nil {
+				continue
+			}
+			stats.TotalEntries++
+			stats.SizeBytes += entrySize(&entry)
+			if now.After(entry.ExpiresAt) {
+				stats.StaleEntries++
+			} else {
+				stats.FreshEntries++
+				if strings.HasPrefix(entry.Key, enhancedAlertKeyPrefix) {
+					stats.EnhancedAlerts++
+				}
+			}
+			if stats.OldestEntry.IsZero() || entry.CreatedAt.Before(stats.OldestEntry) {
+				stats.OldestEntry = entry.CreatedAt
+			}
+			if entry.CreatedAt.After(stats.NewestEntry) {
+				stats.NewestEntry = entry.CreatedAt
+			}
+		}
+	}
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == 100 {
+			flush()
+		}
+	}
+	flush()
+
+	return stats
+}
+
+// Compile-time check that RedisStore satisfies Store
+var _ Store = (*RedisStore)(nil)
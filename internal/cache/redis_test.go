@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestRedisStore connects to REDIS_URL under a per-test key prefix,
+// skipping when no Redis server is configured
+func newTestRedisStore(t *testing.T) *RedisStore {
+	t.Helper()
+	url := os.Getenv("REDIS_URL")
+	if url == "" {
+		t.Skip("REDIS_URL not set; skipping Redis integration test")
+	}
+
+	store, err := NewRedisStore(url, fmt.Sprintf("ersn-test:%d:", time.Now().UnixNano()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys, _ := store.client.Keys(ctx, store.prefix+"*").Result()
+		if len(keys) > 0 {
+			store.client.Del(ctx, keys...)
+		}
+		_ = store.Close()
+	})
+	return store
+}
+
+func TestRedisStore_SetGet(t *testing.T) {
+	store := newTestRedisStore(t)
+
+	if err := store.Set("weather:all", map[string]float64{"temp": 12.5}, time.Minute, "openweather"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]float64
+	found, err := store.Get("weather:all", &got)
+	if err != nil || !found || got["temp"] != 12.5 {
+		t.Fatalf("Get = %v (found=%v, err=%v)", got, found, err)
+	}
+
+	entry, exists, err := store.GetWithMetadata("weather:all", nil)
+	if err != nil || !exists || entry.Source != "openweather" || entry.RefreshInterval != time.Minute {
+		t.Errorf("metadata = %+v (exists=%v, err=%v)", entry, exists, err)
+	}
+
+	if found, err := store.Get("missing", &got); found || err != nil {
+		t.Errorf("missing key: found=%v err=%v", found, err)
+	}
+
+	if keys := store.Keys(); len(keys) != 1 || keys[0] != "weather:all" {
+		t.Errorf("keys = %v, want [weather:all]", keys)
+	}
+
+	stats := store.Stats()
+	if stats.TotalEntries != 1 || stats.FreshEntries != 1 {
+		t.Errorf("stats = %+v, want one fresh entry", stats)
+	}
+
+	// Stats reports the last scan until the next one
+	if err := store.Set("roads:all", []string{"hwy4"}, time.Minute, "caltrans"); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Stats().TotalEntries; got != 1 {
+		t.Errorf("entries before rescanning = %d, want the scanned 1", got)
+	}
+	if got := store.refreshStats().TotalEntries; got != 2 {
+		t.Errorf("entries after rescanning = %d, want 2", got)
+	}
+	store.Delete("roads:all")
+
+	store.Delete("weather:all")
+	if _, exists, _ := store.GetWithMetadata("weather:all", nil); exists {
+		t.Error("deleted key should be gone")
+	}
+}
+
+func TestRedisStore_Staleness(t *testing.T) {
+	store := newTestRedisStore(t)
+
+	if err := store.SetEnhancedAlert("abc", "Tree down", 100*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	key := "enhanced_alert:abc"
+	if store.IsStale(key) || store.IsVeryStale(key) {
+		t.Fatal("new entry should be fresh")
+	}
+	if got := store.refreshStats().EnhancedAlerts; got != 1 {
+		t.Errorf("enhanced alerts = %d, want 1", got)
+	}
+
+	time.Sleep(120 * time.Millisecond)
+	if !store.IsStale(key) {
+		t.Error("entry should be stale after its TTL")
+	}
+	if store.IsVeryStale(key) {
+		t.Error("entry should not be very stale before 2x its TTL")
+	}
+	if got := store.refreshStats().EnhancedAlerts; got != 0 {
+		t.Errorf("enhanced alerts = %d, want stale entries left out", got)
+	}
+	var summary string
+	if found, _ := store.Get(key, &summary); found {
+		t.Error("Get should not return stale data")
+	}
+	if _, exists, _ := store.GetWithMetadata(key, &summary); !exists || summary != "Tree down" {
+		t.Errorf("GetWithMetadata should return stale data, got %q (exists=%v)", summary, exists)
+	}
+
+	// Redis expires the entry at 2x the TTL
+	time.Sleep(150 * time.Millisecond)
+	if _, exists, _ := store.GetWithMetadata(key, nil); exists {
+		t.Error("entry should be gone after 2x its TTL")
+	}
+}
@@ -0,0 +1,37 @@
+package cache
+
+import "time"
+
+// Store is the cache surface the services depend on. Cache is the in-memory
+// implementation; RedisStore shares entries across server instances.
+type Store interface {
+	// Get retrieves data if present and not stale
+	Get(key string, result interface{}) (bool, error)
+
+	// GetWithMetadata retrieves data and metadata even when stale
+	GetWithMetadata(key string, result interface{}) (*CacheEntry, bool, error)
+
+	// Set stores data that goes stale after refreshInterval
+	Set(key string, data interface{}, refreshInterval time.Duration, source string) error
+
+	// SetEnhancedAlert caches an enhanced alert by content hash
+	SetEnhancedAlert(contentHash string, enhanced interface{}, ttl time.Duration) error
+
+	// IsStale reports whether key is missing or past expiration
+	IsStale(key string) bool
+
+	// IsVeryStale reports whether key is missing or older than 2x its refresh interval
+	IsVeryStale(key string) bool
+
+	// Delete removes key if present
+	Delete(key string)
+
+	// Keys returns all cache keys, fresh or stale
+	Keys() []string
+
+	// Stats returns cache statistics
+	Stats() CacheStats
+}
+
+// Compile-time check that the in-memory cache satisfies Store
+var _ Store = (*Cache)(nil)
@@ -0,0 +1,158 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// Mount patterns. Both are more specific than the gateway's "/api/", so the
+// gateway keeps serving GET /api/v1/roads/{road_id}.
+const (
+	AllRoadsPattern = "GET /api/v1/roads/closures.ics"
+	RoadPattern     = "GET /api/v1/roads/{road_id}/closures.ics"
+)
+
+// roadsAPI is the slice of the roads service the feed reads. An interface
+// keeps the package testable.
+type roadsAPI interface {
+	ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error)
+	GetRoad(context.Context, *api.GetRoadRequest) (*api.GetRoadResponse, error)
+}
+
+// Handler serves road alerts with a stated start and end time as iCalendar
+// events, for all roads or a single road.
+type Handler struct {
+	roads roadsAPI
+	loc   *time.Location
+}
+
+// NewHandler creates a Handler writing event times in
+// roads.calendarTimezone (DefaultCalendarTimezone when unset)
+func NewHandler(cfg *config.Config, roads roadsAPI) (*Handler, error) {
+	zone := cfg.Roads.CalendarTimezone
+	if zone == "" {
+		zone = config.DefaultCalendarTimezone
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid roads.calendarTimezone %q: %w", zone, err)
+	}
+	return &Handler{roads: roads, loc: loc}, nil
+}
+
+// ServeHTTP handles GET /api/v1/roads/closures.ics and
+// GET /api/v1/roads/{road_id}/closures.ics
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	roadID := r.PathValue("road_id")
+
+	roads, err := h.fetchRoads(ctx, roadID)
+	if err != nil {
+		code := runtime.HTTPStatusFromCode(status.Code(err))
+		if code == http.StatusInternalServerError {
+			logging.Errorw(ctx, "Failed to build closures calendar", "road_id", roadID, "error", err)
+			code = http.StatusServiceUnavailable
+		}
+		http.Error(w, "road data unavailable", code)
+		return
+	}
+
+	name := "ERSN road closures"
+	if roadID != "" && len(roads) == 1 {
+		name = fmt.Sprintf("%s %s closures", roads[0].GetName(), roads[0].GetSection())
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, name, h.loc, time.Now(), Events(roads)); err != nil {
+		logging.Errorw(ctx, "Failed to encode closures calendar", "error", err)
+		http.Error(w, "failed to encode calendar", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// fetchRoads returns the one road requested, or every road when roadID is empty
+func (h *Handler) fetchRoads(ctx context.Context, roadID string) ([]*api.Road, error) {
+	if roadID == "" {
+		resp, err := h.roads.ListRoads(ctx, &api.ListRoadsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetRoads(), nil
+	}
+	resp, err := h.roads.GetRoad(ctx, &api.GetRoadRequest{RoadId: roadID})
+	if err != nil {
+		return nil, err
+	}
+	return []*api.Road{resp.GetRoad()}, nil
+}
+
+// Events converts the roads' alerts to calendar events, ordered by start.
+// Only alerts with both a start and a later end time become events; an alert
+// listed on several roads appears once.
+func Events(roads []*api.Road) []Event {
+	var events []Event
+	seen := make(map[string]bool)
+	for _, road := range roads {
+		for _, alert := range road.GetAlerts() {
+			if alert.GetStartTime() == nil || alert.GetEndTime() == nil {
+				continue
+			}
+			start, end := alert.GetStartTime().AsTime(), alert.GetEndTime().AsTime()
+			if !end.After(start) {
+				continue
+			}
+			uid := eventUID(alert)
+			if seen[uid] {
+				continue
+			}
+			seen[uid] = true
+
+			summary := alert.GetCondensedSummary()
+			if summary == "" {
+				summary = alert.GetTitle()
+			}
+			location := alert.GetLocationDescription()
+			if location == "" {
+				location = road.GetSection()
+			}
+			events = append(events, Event{
+				UID:         uid,
+				Summary:     road.GetName() + ": " + summary,
+				Location:    location,
+				Description: alert.GetDescription(),
+				Latitude:    alert.GetLocation().GetLatitude(),
+				Longitude:   alert.GetLocation().GetLongitude(),
+				Start:       start,
+				End:         end,
+			})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events
+}
+
+// eventUID is stable across refreshes so calendar apps update events in
+// place: the alert's log number, or a hash of its title and location
+func eventUID(alert *api.RoadAlert) string {
+	if id := alert.GetId(); id != "" {
+		return id + "@info.ersn.net"
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%.4f|%.4f", alert.GetTitle(), alert.GetLocation().GetLatitude(), alert.GetLocation().GetLongitude())
+	return fmt.Sprintf("%x@info.ersn.net", h.Sum64())
+}
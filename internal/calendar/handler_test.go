@@ -0,0 +1,175 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+type fakeRoads struct {
+	roads []*api.Road
+}
+
+func (f fakeRoads) ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error) {
+	return &api.ListRoadsResponse{Roads: f.roads}, nil
+}
+
+func (f fakeRoads) GetRoad(_ context.Context, req *api.GetRoadRequest) (*api.GetRoadResponse, error) {
+	for _, rd := range f.roads {
+		if rd.GetId() == req.GetRoadId() {
+			return &api.GetRoadResponse{Road: rd}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "road not found: %s", req.GetRoadId())
+}
+
+func pacificTime(t *testing.T, value string) *timestamppb.Timestamp {
+	t.Helper()
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	ts, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+	require.NoError(t, err)
+	return timestamppb.New(ts)
+}
+
+func testRoads(t *testing.T) fakeRoads {
+	bridgeWork := &api.RoadAlert{
+		Id:                  "C4-102",
+		Type:                api.AlertType_CLOSURE,
+		Title:               "Route 4 One-way Traffic Operation",
+		Description:         "Bridge work, one lane, flagging; expect 10 minute delays",
+		LocationDescription: "Angels Creek Bridge",
+		Location:            &api.Coordinates{Latitude: 38.1, Longitude: -120.5},
+		StartTime:           pacificTime(t, "2025-12-30 07:00"),
+		EndTime:             pacificTime(t, "2025-12-31 15:01"),
+	}
+	return fakeRoads{roads: []*api.Road{
+		{
+			Id: "hwy4", Name: "Hwy 4", Section: "Angels Camp to Murphys",
+			Alerts: []*api.RoadAlert{
+				bridgeWork,
+				{Title: "CHP Incident 251230GG0001", StartTime: pacificTime(t, "2025-12-30 08:00")}, // No end
+				{Title: "Backwards", StartTime: pacificTime(t, "2025-12-30 08:00"), EndTime: pacificTime(t, "2025-12-30 07:00")},
+			},
+		},
+		{
+			Id: "hwy49", Name: "Hwy 49", Section: "Angels Camp to Sonora",
+			Alerts: []*api.RoadAlert{
+				bridgeWork, // NEARBY on a second road
+				{
+					Title:     "Paving",
+					Location:  &api.Coordinates{Latitude: 38.0, Longitude: -120.4},
+					StartTime: pacificTime(t, "2026-06-01 20:00"),
+					EndTime:   pacificTime(t, "2026-06-02 05:00"),
+				},
+			},
+		},
+	}}
+}
+
+func serve(t *testing.T, cfg *config.Config, roads roadsAPI, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	h, err := NewHandler(cfg, roads)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle(AllRoadsPattern, h)
+	mux.Handle(RoadPattern, h)
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+// vevents splits an .ics body into its VEVENT blocks
+func vevents(body string) []string {
+	var events []string
+	for _, part := range strings.Split(body, "BEGIN:VEVENT\r\n")[1:] {
+		events = append(events, strings.SplitN(part, "END:VEVENT", 2)[0])
+	}
+	return events
+}
+
+func TestClosuresCalendar_AllRoads(t *testing.T) {
+	w := serve(t, &config.Config{}, testRoads(t), "/api/v1/roads/closures.ics")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+
+	// One VEVENT per dated closure: undated and backwards alerts are skipped,
+	// the closure listed on both roads appears once
+	events := vevents(body)
+	require.Len(t, events, 2)
+
+	assert.Contains(t, events[0], "UID:C4-102@info.ersn.net\r\n")
+	assert.Contains(t, events[0], "DTSTART;TZID=America/Los_Angeles:20251230T070000\r\n")
+	assert.Contains(t, events[0], "DTEND;TZID=America/Los_Angeles:20251231T150100\r\n")
+	assert.Contains(t, events[0], "SUMMARY:Hwy 4: Route 4 One-way Traffic Operation\r\n")
+	assert.Contains(t, events[0], "LOCATION:Angels Creek Bridge\r\n")
+	assert.Contains(t, events[0], `DESCRIPTION:Bridge work\, one lane\, flagging\; expect 10 minute delays`)
+
+	assert.Contains(t, events[1], "DTSTART;TZID=America/Los_Angeles:20260601T200000\r\n")
+	assert.Contains(t, events[1], "DTEND;TZID=America/Los_Angeles:20260602T050000\r\n")
+	assert.Contains(t, events[1], "LOCATION:Angels Camp to Sonora\r\n")
+
+	// The TZID is defined by a VTIMEZONE covering both closures: PST from
+	// November 2025, PDT from March 2026
+	assert.Contains(t, body, "BEGIN:VTIMEZONE\r\nTZID:America/Los_Angeles\r\n"+
+		"BEGIN:STANDARD\r\nDTSTART:20251102T020000\r\nTZOFFSETFROM:-0700\r\nTZOFFSETTO:-0800\r\nTZNAME:PST\r\nEND:STANDARD\r\n"+
+		"BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0800\r\nTZOFFSETTO:-0700\r\nTZNAME:PDT\r\nEND:DAYLIGHT\r\n"+
+		"END:VTIMEZONE\r\n")
+}
+
+func TestClosuresCalendar_SingleRoad(t *testing.T) {
+	w := serve(t, &config.Config{}, testRoads(t), "/api/v1/roads/hwy49/closures.ics")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "X-WR-CALNAME:Hwy 49 Angels Camp to Sonora closures\r\n")
+	assert.Len(t, vevents(w.Body.String()), 2)
+
+	w = serve(t, &config.Config{}, testRoads(t), "/api/v1/roads/nope/closures.ics")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// The road itself is still the gateway's
+	w = serve(t, &config.Config{}, testRoads(t), "/api/v1/roads/hwy4")
+	assert.Equal(t, http.StatusTeapot, w.Code)
+}
+
+func TestClosuresCalendar_ConfiguredTimezone(t *testing.T) {
+	cfg := &config.Config{Roads: config.RoadsConfig{CalendarTimezone: "UTC"}}
+	w := serve(t, cfg, testRoads(t), "/api/v1/roads/hwy4/closures.ics")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	events := vevents(w.Body.String())
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "DTSTART:20251230T150000Z\r\n")
+	assert.Contains(t, events[0], "DTEND:20251231T230100Z\r\n")
+	assert.NotContains(t, w.Body.String(), "VTIMEZONE")
+
+	_, err := NewHandler(&config.Config{Roads: config.RoadsConfig{CalendarTimezone: "Mars/Olympus"}}, testRoads(t))
+	assert.Error(t, err)
+}
+
+func TestFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 80)
+	folded := fold(line)
+	for _, part := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(part), 75)
+	}
+	assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+}
@@ -0,0 +1,179 @@
+// Package calendar exports dated road alerts (planned closures, roadwork) as
+// an iCalendar (RFC 5545) feed so they can be subscribed to from a calendar
+// app.
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Event is one VEVENT. Start and End are written in the calendar's zone.
+type Event struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	Latitude    float64
+	Longitude   float64
+	Start       time.Time
+	End         time.Time
+}
+
+const (
+	icsDateTime = "20060102T150405"
+	prodID      = "-//ERSN//info.ersn.net road closures//EN"
+)
+
+// Write encodes events as a VCALENDAR named name, with DTSTART/DTEND local to
+// loc. stamp is the DTSTAMP of every event (when the feed was generated).
+func Write(w io.Writer, name string, loc *time.Location, stamp time.Time, events []Event) error {
+	ew := &errWriter{w: w}
+	ew.line("BEGIN:VCALENDAR")
+	ew.line("VERSION:2.0")
+	ew.line("PRODID:" + prodID)
+	ew.line("CALSCALE:GREGORIAN")
+	ew.line("METHOD:PUBLISH")
+	ew.line("X-WR-CALNAME:" + escapeText(name))
+	ew.line("X-WR-TIMEZONE:" + loc.String())
+	if loc != time.UTC && len(events) > 0 {
+		writeTimezone(ew, loc, events)
+	}
+	for _, e := range events {
+		ew.line("BEGIN:VEVENT")
+		ew.line("UID:" + escapeText(e.UID))
+		ew.line("DTSTAMP:" + stamp.UTC().Format(icsDateTime) + "Z")
+		ew.line(dateTimeProperty("DTSTART", e.Start, loc))
+		ew.line(dateTimeProperty("DTEND", e.End, loc))
+		ew.line("SUMMARY:" + escapeText(e.Summary))
+		if e.Location != "" {
+			ew.line("LOCATION:" + escapeText(e.Location))
+		}
+		if e.Latitude != 0 || e.Longitude != 0 {
+			ew.line(fmt.Sprintf("GEO:%.6f;%.6f", e.Latitude, e.Longitude))
+		}
+		if e.Description != "" {
+			ew.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		ew.line("END:VEVENT")
+	}
+	ew.line("END:VCALENDAR")
+	return ew.err
+}
+
+// dateTimeProperty formats t as a local time in loc, e.g.
+// "DTSTART;TZID=America/Los_Angeles:20251231T150100". UTC is written with the
+// "Z" suffix instead of a TZID.
+func dateTimeProperty(name string, t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return name + ":" + t.UTC().Format(icsDateTime) + "Z"
+	}
+	return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format(icsDateTime)
+}
+
+// writeTimezone writes the VTIMEZONE the TZID of DTSTART/DTEND refers to
+// (RFC 5545 §3.6.5): one observance per offset in effect between the
+// earliest start and the latest end of events, each with its onset.
+func writeTimezone(ew *errWriter, loc *time.Location, events []Event) {
+	from, to := events[0].Start, events[0].End
+	for _, e := range events {
+		if e.Start.Before(from) {
+			from = e.Start
+		}
+		if e.End.After(to) {
+			to = e.End
+		}
+	}
+
+	ew.line("BEGIN:VTIMEZONE")
+	ew.line("TZID:" + loc.String())
+	for t := from.In(loc); ; {
+		name, offset := t.Zone()
+		start, end := t.ZoneBounds()
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+
+		// The onset is written in the local time before it, in the offset
+		// it changes from. A zone without transitions starts at the epoch.
+		onset, offsetFrom := "19700101T000000", offset
+		if !start.IsZero() {
+			_, offsetFrom = start.Add(-time.Second).In(loc).Zone()
+			onset = start.In(time.FixedZone("", offsetFrom)).Format(icsDateTime)
+		}
+
+		ew.line("BEGIN:" + kind)
+		ew.line("DTSTART:" + onset)
+		ew.line("TZOFFSETFROM:" + utcOffset(offsetFrom))
+		ew.line("TZOFFSETTO:" + utcOffset(offset))
+		ew.line("TZNAME:" + escapeText(name))
+		ew.line("END:" + kind)
+
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		t = end.In(loc)
+	}
+	ew.line("END:VTIMEZONE")
+}
+
+// utcOffset formats an offset in seconds east of UTC as a UTC-OFFSET value,
+// e.g. "-0800"
+func utcOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	offset := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		offset += fmt.Sprintf("%02d", seconds%60)
+	}
+	return offset
+}
+
+// escapeText escapes a TEXT value (RFC 5545 §3.3.11)
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// errWriter writes CRLF-terminated content lines, folded at 75 octets
+// (RFC 5545 §3.1), keeping the first write error
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) line(s string) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = io.WriteString(ew.w, fold(s)+"\r\n")
+}
+
+// fold splits a content line into 75-octet pieces joined by CRLF + space,
+// never inside a UTF-8 sequence
+func fold(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+	var b strings.Builder
+	width := limit
+	for len(s) > width {
+		cut := width
+		for cut > 0 && !isRuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		width = limit - 1 // Continuation lines start with the folding space
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func isRuneStart(b byte) bool { return b&0xC0 != 0x80 }
@@ -1,18 +1,22 @@
 package caltrans
 
 import (
+	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"html"
 	"io"
 	"net/http"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dpup/prefab/logging"
+
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
 )
 
@@ -34,7 +38,77 @@ type HTTPDoer interface {
 // Implementation per research.md lines 49-67
 type FeedParser struct {
 	HTTPClient HTTPDoer
-	geoUtils   geo.GeoUtils
+	URLs       FeedURLs     // Feed locations; empty fields use the quickmap defaults
+	Timeouts   FeedTimeouts // Per-feed download limits; zero uses DefaultFeedTimeout
+	UserAgent  string       // Sent with every request; empty uses upstream.DefaultUserAgent
+	// MaxBodyBytes is the largest KML body accepted; zero uses
+	// DefaultMaxFeedBytes
+	MaxBodyBytes int64
+	geoUtils     geo.GeoUtils
+}
+
+// Default Caltrans feed locations
+const (
+	DefaultChainControlsURL  = "https://quickmap.dot.ca.gov/data/cc.kml"
+	DefaultLaneClosuresURL   = "https://quickmap.dot.ca.gov/data/lcs2way.kml"
+	DefaultCHPIncidentsURL   = "https://quickmap.dot.ca.gov/data/chp-only.kml"
+	DefaultRoadConditionsURL = "https://roads.dot.ca.gov/roadscell.php?roadnumber=%s" // %s is the highway number
+)
+
+// FeedURLs points the parser at mirrors or staging copies of the feeds
+type FeedURLs struct {
+	ChainControls  string
+	LaneClosures   string
+	CHPIncidents   string
+	RoadConditions string // Pattern with %s for the highway number
+}
+
+// DefaultFeedTimeout bounds a feed download, including reading the body, when
+// no per-feed timeout is configured
+const DefaultFeedTimeout = 30 * time.Second
+
+// DefaultMaxFeedBytes is the largest KML body accepted when no limit is
+// configured. The statewide lane closure feed is a few megabytes.
+const DefaultMaxFeedBytes int64 = 32 << 20
+
+// FeedTimeouts bounds how long each feed's download may take. A shorter
+// deadline on the caller's context still wins.
+type FeedTimeouts struct {
+	ChainControls  time.Duration
+	LaneClosures   time.Duration
+	CHPIncidents   time.Duration
+	RoadConditions time.Duration
+}
+
+// withFeedTimeout derives a context bounded by timeout, or DefaultFeedTimeout
+// when timeout is zero
+func withFeedTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = DefaultFeedTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// readAll reads body until EOF or ctx is done. The body is closed on
+// cancellation, which unblocks a read stalled on a slow upstream even when
+// the HTTPDoer doesn't watch the request context.
+func readAll(ctx context.Context, body io.ReadCloser) ([]byte, error) {
+	stop := context.AfterFunc(ctx, func() { _ = body.Close() })
+	defer stop()
+
+	data, err := io.ReadAll(body)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return data, err
+}
+
+// urlOrDefault returns url, or fallback when url is empty
+func urlOrDefault(url, fallback string) string {
+	if url == "" {
+		return fallback
+	}
+	return url
 }
 
 // CaltransIncident represents parsed incident data from KML feeds
@@ -49,9 +123,35 @@ type CaltransIncident struct {
 	AffectedArea    *api.Polyline     // Polyline/polygon for closures
 	ParsedStatus    string
 	ParsedDates     []string
+	ParsedEndTime   time.Time         // "Expected to end at ..." time; zero when the feed gives none
+	ReportedTime    time.Time         // Earliest CHP log timestamp; zero when the feed gives none
+	UpdatedTime     time.Time         // Latest CHP log or "Last updated" timestamp; zero when none
+	Lanes           LaneClosure       // Lanes closed per the description; zero when not stated
+	Severity        api.AlertSeverity // Keyword-based severity; UNSPECIFIED when the text gives no signal
+	ExtendedData    map[string]string // The placemark's ExtendedData fields; nil when it has none
+	Route           string            // Highway from ExtendedData (e.g. "SR-4"); empty when the feed gives none
 	LastFetched     time.Time
 }
 
+// ExtendedData names, matched case-insensitively, that are preferred over
+// values parsed from the description text
+var (
+	extendedStatusNames = []string{"status"}
+	extendedRouteNames  = []string{"route", "highway"}
+)
+
+// extendedValue returns the first non-empty field with one of names
+func extendedValue(fields map[string]string, names []string) string {
+	for _, name := range names {
+		for key, value := range fields {
+			if strings.EqualFold(key, name) && value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
 // ChainControlData represents parsed chain control information from KML
 type ChainControlData struct {
 	Highway       string           // e.g., "US 50", "Highway 89"
@@ -66,34 +166,103 @@ type ChainControlData struct {
 	District      string           // Caltrans district number
 }
 
-// KML XML structures for parsing
+// KML XML structures for parsing (and EncodeKML)
 type KML struct {
 	XMLName  xml.Name `xml:"kml"`
 	Document Document `xml:"Document"`
 }
 
 type Document struct {
-	XMLName    xml.Name    `xml:"Document"`
-	Name       string      `xml:"name"`
-	Placemarks []Placemark `xml:"Placemark"`
-	Folders    []Folder    `xml:"Folder"`
+	XMLName      xml.Name      `xml:"Document"`
+	Name         string        `xml:"name"`
+	Placemarks   []Placemark   `xml:"Placemark"`
+	Folders      []Folder      `xml:"Folder"`
+	NetworkLinks []NetworkLink `xml:"NetworkLink"`
 }
 
+// Folder groups placemarks; folders nest to any depth
 type Folder struct {
-	XMLName    xml.Name    `xml:"Folder"`
-	Name       string      `xml:"name"`
-	Placemarks []Placemark `xml:"Placemark"`
+	XMLName      xml.Name      `xml:"Folder"`
+	Name         string        `xml:"name"`
+	Placemarks   []Placemark   `xml:"Placemark"`
+	Folders      []Folder      `xml:"Folder"`
+	NetworkLinks []NetworkLink `xml:"NetworkLink"`
+}
+
+// NetworkLink references KML loaded from elsewhere. Links aren't followed.
+type NetworkLink struct {
+	XMLName xml.Name `xml:"NetworkLink"`
+	Name    string   `xml:"name,omitempty"`
+	Link    Link     `xml:"Link"`
+}
+
+type Link struct {
+	XMLName xml.Name `xml:"Link"`
+	Href    string   `xml:"href"`
 }
 
 type Placemark struct {
 	XMLName      xml.Name      `xml:"Placemark"`
 	Name         string        `xml:"name"`
-	Description  string        `xml:"description"`
-	StyleURL     string        `xml:"styleUrl"`
+	Description  string        `xml:"description,omitempty"`
+	StyleURL     string        `xml:"styleUrl,omitempty"`
 	Point        Point         `xml:"Point"`
 	LineString   LineString    `xml:"LineString"`
 	Polygon      Polygon       `xml:"Polygon"`
 	MultiGeometry MultiGeometry `xml:"MultiGeometry"`
+	ExtendedData ExtendedData  `xml:"ExtendedData"`
+}
+
+// ExtendedData holds a placemark's structured fields, either untyped Data
+// pairs or SchemaData typed by a Schema
+type ExtendedData struct {
+	XMLName    xml.Name     `xml:"ExtendedData"`
+	Data       []Data       `xml:"Data"`
+	SchemaData []SchemaData `xml:"SchemaData"`
+}
+
+type Data struct {
+	XMLName xml.Name `xml:"Data"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:"value"`
+}
+
+type SchemaData struct {
+	XMLName    xml.Name     `xml:"SchemaData"`
+	SchemaURL  string       `xml:"schemaUrl,attr,omitempty"`
+	SimpleData []SimpleData `xml:"SimpleData"`
+}
+
+type SimpleData struct {
+	XMLName xml.Name `xml:"SimpleData"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:",chardata"`
+}
+
+// Fields returns the name/value pairs of all Data and SimpleData elements,
+// nil when there are none. Names are kept as given; a repeated name keeps its
+// last value.
+func (d ExtendedData) Fields() map[string]string {
+	var fields map[string]string
+	set := func(name, value string) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return
+		}
+		if fields == nil {
+			fields = make(map[string]string)
+		}
+		fields[name] = strings.TrimSpace(value)
+	}
+	for _, data := range d.Data {
+		set(data.Name, data.Value)
+	}
+	for _, schemaData := range d.SchemaData {
+		for _, simple := range schemaData.SimpleData {
+			set(simple.Name, simple.Value)
+		}
+	}
+	return fields
 }
 
 type Point struct {
@@ -137,17 +306,18 @@ type MultiGeometry struct {
 // NewFeedParser creates a new Caltrans KML feed parser
 func NewFeedParser() *FeedParser {
 	return &FeedParser{
-		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		geoUtils: geo.NewGeoUtils(),
+		// Downloads are bounded by the request context (see FeedTimeouts)
+		HTTPClient: &http.Client{},
+		geoUtils:   geo.NewGeoUtils(),
 	}
 }
 
 // ParseChainControls processes chain control KML feed
 // URL from research.md line 71
 func (p *FeedParser) ParseChainControls(ctx context.Context) ([]CaltransIncident, error) {
-	return p.parseKMLFeed(ctx, "https://quickmap.dot.ca.gov/data/cc.kml", CHAIN_CONTROL)
+	ctx, cancel := withFeedTimeout(ctx, p.Timeouts.ChainControls)
+	defer cancel()
+	return p.ParseFeed(ctx, urlOrDefault(p.URLs.ChainControls, DefaultChainControlsURL), CHAIN_CONTROL)
 }
 
 // ParseChainControlsDetailed processes chain control KML feed with detailed parsing
@@ -171,6 +341,9 @@ func (p *FeedParser) parseChainControlDetails(incidents []CaltransIncident) []Ch
 
 		// Parse name: "Eastbound US 50 Chain Control level R-2"
 		control.Direction, control.Highway, control.Level = parseChainControlName(incident.Name)
+		if incident.Route != "" {
+			control.Highway = incident.Route
+		}
 
 		// Parse description HTML for location, effective time, and requirements
 		control.LocationName, control.EffectiveTime, control.Description, control.LastUpdated, control.District, control.MessageID = parseChainControlDescription(incident.DescriptionHtml)
@@ -271,104 +444,109 @@ func parseChainControlTime(timeStr string) string {
 // ParseLaneClosures processes lane closures KML feed  
 // URL from research.md line 72
 func (p *FeedParser) ParseLaneClosures(ctx context.Context) ([]CaltransIncident, error) {
-	return p.parseKMLFeed(ctx, "https://quickmap.dot.ca.gov/data/lcs2way.kml", LANE_CLOSURE)
+	ctx, cancel := withFeedTimeout(ctx, p.Timeouts.LaneClosures)
+	defer cancel()
+	return p.ParseFeed(ctx, urlOrDefault(p.URLs.LaneClosures, DefaultLaneClosuresURL), LANE_CLOSURE)
 }
 
 // ParseCHPIncidents processes CHP incidents KML feed
 // URL from research.md line 73
 func (p *FeedParser) ParseCHPIncidents(ctx context.Context) ([]CaltransIncident, error) {
-	return p.parseKMLFeed(ctx, "https://quickmap.dot.ca.gov/data/chp-only.kml", CHP_INCIDENT)
+	ctx, cancel := withFeedTimeout(ctx, p.Timeouts.CHPIncidents)
+	defer cancel()
+	return p.ParseFeed(ctx, urlOrDefault(p.URLs.CHPIncidents, DefaultCHPIncidentsURL), CHP_INCIDENT)
 }
 
 
-// parseKMLFeed downloads and parses a KML feed
-func (p *FeedParser) parseKMLFeed(ctx context.Context, url string, feedType CaltransFeedType) ([]CaltransIncident, error) {
+// ParseFeed downloads and parses the KML feed at url, tagging its incidents
+// with feedType. Use RegisterFeedType for feeds beyond the built-in three.
+// The download, body included, stops when ctx is done; a ctx without a
+// deadline is bounded by DefaultFeedTimeout.
+func (p *FeedParser) ParseFeed(ctx context.Context, url string, feedType CaltransFeedType) ([]CaltransIncident, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = withFeedTimeout(ctx, 0)
+		defer cancel()
+	}
+
 	// Download KML file
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	upstream.SetUserAgent(req, p.UserAgent)
 
 	// Default to a new HTTP client if none is set
 	httpClient := p.HTTPClient
 	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+		httpClient = &http.Client{}
 	}
 	
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to download KML: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to download KML: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error %d downloading KML from %s", resp.StatusCode, url)
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("HTTP error %d downloading KML from %s", resp.StatusCode, url))
 	}
-
-	// Parse KML using standard encoding/xml
-	kmlData, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read KML response: %w", err)
+	if err := checkKMLContentType(resp.Header.Get("Content-Type")); err != nil {
+		return nil, fmt.Errorf("failed to parse KML from %s: %w", url, err)
 	}
+	body := limitBody(resp.Body, p.MaxBodyBytes)
 
-	var kml KML
-	err = xml.Unmarshal(kmlData, &kml)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse KML: %w", err)
-	}
-
-	// Process KML placemarks
+	// Decode placemarks as they stream in rather than holding the whole
+	// feed, which for statewide lane closures runs to megabytes
 	var incidents []CaltransIncident
 	now := time.Now()
-
-	// Process placemarks directly in document
-	for _, placemark := range kml.Document.Placemarks {
-		if incident := p.processPlacemark(&placemark, feedType, now); incident != nil {
-			incidents = append(incidents, *incident)
-		}
-	}
-
-	// Process placemarks in folders
-	for _, folder := range kml.Document.Folders {
-		for _, placemark := range folder.Placemarks {
-			if incident := p.processPlacemark(&placemark, feedType, now); incident != nil {
+	err = decodeKML(ctx, body, kmlVisitor{
+		placemark: func(placemark *Placemark) {
+			if swapped := placemark.swappedTuples(); len(swapped) > 0 {
+				warnw(ctx, "Dropping KML coordinates that look swapped (latitude first)",
+					"feed", url, "placemark", placemark.Name, "count", len(swapped), "example", swapped[0])
+			}
+			if incident := p.processPlacemark(placemark, feedType, now); incident != nil {
 				incidents = append(incidents, *incident)
 			}
-		}
+		},
+		networkLink: func(link *NetworkLink) {
+			warnw(ctx, "Skipping KML NetworkLink, linked feeds aren't followed",
+				"feed", url, "name", link.Name, "href", link.Link.Href)
+		},
+	})
+	var readErr *kmlReadError
+	if errors.As(err, &readErr) {
+		return nil, upstream.NewStatusError(0, err)
+	}
+	if err != nil {
+		// Several feeds share this parser, so say which one was bad
+		return nil, fmt.Errorf("failed to parse KML from %s: %w", url, err)
 	}
-
 	return incidents, nil
 }
 
+// warnw logs a problem with a feed. Callers outside the server
+// (cmd/test-caltrans) may not have a logger on their context.
+func warnw(ctx context.Context, msg string, fields ...interface{}) {
+	logging.Warnw(logging.EnsureLogger(ctx), msg, fields...)
+}
+
 // ParseKMLContent parses KML content directly for testing purposes
 // This allows unit tests to work with test fixtures without making HTTP calls
 func (p *FeedParser) ParseKMLContent(kmlData []byte, feedType CaltransFeedType) ([]CaltransIncident, error) {
-	var kml KML
-	err := xml.Unmarshal(kmlData, &kml)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse KML: %w", err)
-	}
-
-	// Process KML placemarks
 	var incidents []CaltransIncident
 	now := time.Now()
-
-	// Process placemarks directly in document
-	for _, placemark := range kml.Document.Placemarks {
-		if incident := p.processPlacemark(&placemark, feedType, now); incident != nil {
-			incidents = append(incidents, *incident)
-		}
-	}
-
-	// Process placemarks in folders
-	for _, folder := range kml.Document.Folders {
-		for _, placemark := range folder.Placemarks {
-			if incident := p.processPlacemark(&placemark, feedType, now); incident != nil {
+	err := decodeKML(context.Background(), io.NopCloser(bytes.NewReader(kmlData)), kmlVisitor{
+		placemark: func(placemark *Placemark) {
+			if incident := p.processPlacemark(placemark, feedType, now); incident != nil {
 				incidents = append(incidents, *incident)
 			}
-		}
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse KML: %w", err)
 	}
-
 	return incidents, nil
 }
 
@@ -412,6 +590,28 @@ func (p *FeedParser) FilterByGeography(incidents []CaltransIncident, routeCoordi
 	return filteredIncidents
 }
 
+// FilterIncidentsByBoundingBox returns the same incidents as
+// FilterByGeography, but first rejects incidents outside a box around the
+// route coordinates expanded by radiusMeters. The box bounds every circle of
+// radiusMeters, so only incidents that could pass reach the per-point
+// Haversine check.
+func (p *FeedParser) FilterIncidentsByBoundingBox(incidents []CaltransIncident, routeCoordinates []geo.Point, radiusMeters float64) []CaltransIncident {
+	bounds := geo.BoundsOf(routeCoordinates).Expand(radiusMeters)
+
+	candidates := make([]CaltransIncident, 0, len(incidents))
+	for _, incident := range incidents {
+		if incident.Coordinates == nil {
+			continue
+		}
+		point := geo.Point{Latitude: incident.Coordinates.Latitude, Longitude: incident.Coordinates.Longitude}
+		if bounds.Intersects(geo.BoundsOf([]geo.Point{point})) {
+			candidates = append(candidates, incident)
+		}
+	}
+
+	return p.FilterByGeography(candidates, routeCoordinates, radiusMeters)
+}
+
 // processPlacemark converts KML Placemark to CaltransIncident
 // Structure mapping per data-model.md lines 80-90
 func (p *FeedParser) processPlacemark(placemark *Placemark, feedType CaltransFeedType, fetchTime time.Time) *CaltransIncident {
@@ -429,9 +629,18 @@ func (p *FeedParser) processPlacemark(placemark *Placemark, feedType CaltransFee
 	// Extract plain text from HTML description
 	descriptionText := extractTextFromHTML(descriptionHtml)
 
-	// Extract status and dates from description
-	parsedStatus := extractStatus(descriptionText)
+	// Structured fields win over status parsed from the description
+	extendedData := placemark.ExtendedData.Fields()
+	parsedStatus := strings.ToLower(extendedValue(extendedData, extendedStatusNames))
+	if parsedStatus == "" {
+		parsedStatus = extractStatus(descriptionText)
+	}
+
+	// Extract dates from description
 	parsedDates := extractDates(descriptionText)
+	parsedEndTime := ParseEndTime(descriptionText)
+	reportedTime, updatedTime := ParseCHPTimestamps(descriptionText)
+	lanes := ParseLaneClosure(descriptionText)
 
 	// As of 2026 the quickmap feeds ship a blank <name> and carry the incident
 	// label inside the description's iw-* markup. Backfill a meaningful name so
@@ -451,6 +660,13 @@ func (p *FeedParser) processPlacemark(placemark *Placemark, feedType CaltransFee
 		AffectedArea:    polyline,
 		ParsedStatus:    parsedStatus,
 		ParsedDates:     parsedDates,
+		ParsedEndTime:   parsedEndTime,
+		ReportedTime:    reportedTime,
+		UpdatedTime:     updatedTime,
+		Lanes:           lanes,
+		Severity:        ClassifySeverity(descriptionText),
+		ExtendedData:    extendedData,
+		Route:           extendedValue(extendedData, extendedRouteNames),
 		LastFetched:     fetchTime,
 	}
 }
@@ -533,76 +749,27 @@ func (p *FeedParser) extractGeometry(placemark *Placemark) (*api.Coordinates, *a
 	return pointCoord, polyline
 }
 
-// parseCoordinates parses KML coordinate string "longitude,latitude,altitude"
+// parseCoordinates parses a KML Point's "longitude,latitude[,altitude]",
+// nil when it has no valid tuple
 func (p *FeedParser) parseCoordinates(coordString string) *api.Coordinates {
-	coordString = strings.TrimSpace(coordString)
-	if coordString == "" {
-		return nil
-	}
-
-	// KML coordinates format: "longitude,latitude,altitude"
-	parts := strings.Split(coordString, ",")
-	if len(parts) < 2 {
-		return nil
-	}
-
-	longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
-	if err != nil {
-		return nil
-	}
-
-	latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
-	if err != nil {
-		return nil
-	}
-
-	return &api.Coordinates{
-		Latitude:  latitude,
-		Longitude: longitude,
+	for _, tuple := range splitCoordinateTuples(coordString) {
+		if coord, err := parseCoordinateTuple(tuple); err == nil {
+			return coord
+		}
 	}
+	return nil
 }
 
 // parseCoordinateList parses KML coordinate string with multiple coordinates
 // Format: "lon1,lat1,alt1 lon2,lat2,alt2 lon3,lat3,alt3"
+// Invalid and out-of-range tuples are skipped.
 func (p *FeedParser) parseCoordinateList(coordString string) []*api.Coordinates {
-	coordString = strings.TrimSpace(coordString)
-	if coordString == "" {
-		return nil
-	}
-
 	var coordinates []*api.Coordinates
-
-	// Split by whitespace or newlines to get individual coordinate sets
-	coordSets := regexp.MustCompile(`\s+`).Split(coordString, -1)
-	
-	for _, coordSet := range coordSets {
-		coordSet = strings.TrimSpace(coordSet)
-		if coordSet == "" {
-			continue
-		}
-
-		// Parse individual coordinate set "longitude,latitude,altitude"
-		parts := strings.Split(coordSet, ",")
-		if len(parts) < 2 {
-			continue
+	for _, tuple := range splitCoordinateTuples(coordString) {
+		if coord, err := parseCoordinateTuple(tuple); err == nil {
+			coordinates = append(coordinates, coord)
 		}
-
-		longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
-		if err != nil {
-			continue
-		}
-
-		latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
-		if err != nil {
-			continue
-		}
-
-		coordinates = append(coordinates, &api.Coordinates{
-			Latitude:  latitude,
-			Longitude: longitude,
-		})
 	}
-
 	return coordinates
 }
 
@@ -671,6 +838,34 @@ func extractStatus(text string) string {
 	return ""
 }
 
+// pacific is the zone of the feeds' zoneless times (falling back to UTC if
+// tzdata is missing; cmd/server blank-imports time/tzdata)
+var pacific = func() *time.Location {
+	if loc, err := time.LoadLocation("America/Los_Angeles"); err == nil {
+		return loc
+	}
+	return time.UTC
+}()
+
+// endTimeRe matches lane closure end times, e.g. "Expected to end at 3:01pm Dec 31, 2025"
+var endTimeRe = regexp.MustCompile(`(?i)expected to end at\s+(\d{1,2}:\d{2})\s*([ap]m)\s+([a-z]{3})[a-z]*\.?\s+(\d{1,2}),\s+(\d{4})`)
+
+// ParseEndTime parses the "Expected to end at" time from description text,
+// returning the zero time when there is none
+func ParseEndTime(text string) time.Time {
+	m := endTimeRe.FindStringSubmatch(text)
+	if m == nil {
+		return time.Time{}
+	}
+	month := strings.ToUpper(m[3][:1]) + strings.ToLower(m[3][1:])
+	value := fmt.Sprintf("%s%s %s %s, %s", m[1], strings.ToLower(m[2]), month, m[4], m[5])
+	t, err := time.ParseInLocation("3:04pm Jan 2, 2006", value, pacific)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
 // extractDates attempts to extract date/time information from description text
 func extractDates(text string) []string {
 	// Pattern for dates like "12/25/2024" or "Dec 25, 2024"
@@ -2,15 +2,21 @@ package caltrans
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
 )
 
@@ -18,6 +24,7 @@ import (
 // mockHTTPClient provides local KML file responses for testing
 type mockHTTPClient struct {
 	testDataDir string
+	files       map[string]string // Additional URL -> fixture file
 }
 
 func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
@@ -30,6 +37,10 @@ func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	case "https://quickmap.dot.ca.gov/data/cc.kml":
 		filename = "chain_controls.kml"
 	default:
+		if file, ok := m.files[req.URL.String()]; ok {
+			filename = file
+			break
+		}
 		return &http.Response{
 			StatusCode: 404,
 			Body:       io.NopCloser(strings.NewReader("Not found")),
@@ -121,6 +132,80 @@ func TestParseChainControls(t *testing.T) {
 }
 
 
+func TestParseKML_NestedFolders(t *testing.T) {
+	const url = "https://quickmap.dot.ca.gov/data/chp-by-area.kml"
+	parser := setupTestParser(t)
+	parser.HTTPClient.(*mockHTTPClient).files = map[string]string{url: "nested_folders.kml"}
+
+	// Placemarks come in document order, from folders at any depth; the
+	// network links are skipped
+	want := []string{
+		"CHP Incident 260112SA0001",
+		"CHP Incident 260112SA0002",
+		"CHP Incident 260112SA0003",
+		"CHP Incident 260112SA0004",
+		"CHP Incident 260112SA0005",
+	}
+	names := func(incidents []CaltransIncident) []string {
+		var names []string
+		for _, incident := range incidents {
+			names = append(names, incident.Name)
+		}
+		return names
+	}
+
+	incidents, err := parser.ParseFeed(context.Background(), url, CHP_INCIDENT)
+	require.NoError(t, err)
+	assert.Equal(t, want, names(incidents))
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "tests", "testdata", "caltrans", "nested_folders.kml"))
+	require.NoError(t, err)
+	incidents, err = parser.ParseKMLContent(content, CHP_INCIDENT)
+	require.NoError(t, err)
+	assert.Equal(t, want, names(incidents))
+}
+
+func TestParseKML_ExtendedData(t *testing.T) {
+	parser := NewFeedParser()
+	kml := `<kml xmlns="http://www.opengis.net/kml/2.2"><Document>
+<Placemark>
+  <name>Eastbound SR 4 Chain Control</name>
+  <description>Chains are required on all vehicles. Restrictions in effect.</description>
+  <ExtendedData>
+    <Data name="Status"><value>Closed</value></Data>
+    <Data name="route"><value>SR-4</value></Data>
+    <SchemaData schemaUrl="#cc">
+      <SimpleData name="district">10</SimpleData>
+      <SimpleData name="level">R-2</SimpleData>
+    </SchemaData>
+  </ExtendedData>
+  <Point><coordinates>-120.280340,38.277415,0</coordinates></Point>
+</Placemark>
+<Placemark>
+  <name>Lane closure</name>
+  <description>Right lane closed for construction</description>
+  <Point><coordinates>-120.541201,38.072104,0</coordinates></Point>
+</Placemark>
+</Document></kml>`
+
+	incidents, err := parser.ParseKMLContent([]byte(kml), CHAIN_CONTROL)
+	require.NoError(t, err)
+	require.Len(t, incidents, 2)
+
+	structured := incidents[0]
+	assert.Equal(t, map[string]string{"Status": "Closed", "route": "SR-4", "district": "10", "level": "R-2"}, structured.ExtendedData)
+	assert.Equal(t, "closed", structured.ParsedStatus, "ExtendedData status wins over the description's \"restrictions\"")
+	assert.Equal(t, "SR-4", structured.Route)
+	controls := parser.parseChainControlDetails(incidents[:1])
+	assert.Equal(t, "SR-4", controls[0].Highway, "ExtendedData route wins over the name's highway")
+	assert.Equal(t, "Eastbound", controls[0].Direction)
+
+	plain := incidents[1]
+	assert.Nil(t, plain.ExtendedData)
+	assert.Equal(t, "closed", plain.ParsedStatus, "status falls back to the description")
+	assert.Empty(t, plain.Route)
+}
+
 func TestHaversineDistance(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -279,6 +364,16 @@ func TestExtractDates(t *testing.T) {
 	}
 }
 
+func TestParseEndTime(t *testing.T) {
+	end := ParseEndTime("From Little Larabee Creek Bridge to Bridgeville Due to Bridge Work Expected to end at 3:01pm Dec 31, 2025 Information courtesy of")
+	assert.Equal(t, time.Date(2025, 12, 31, 15, 1, 0, 0, pacific), end)
+
+	end = ParseEndTime("expected to end at 9:30 AM Sep 5, 2025")
+	assert.Equal(t, time.Date(2025, 9, 5, 9, 30, 0, 0, pacific), end)
+
+	assert.True(t, ParseEndTime("Due to Bridge Work").IsZero(), "No end time should be zero")
+}
+
 func TestExtractGeometry(t *testing.T) {
 	parser := NewFeedParser()
 
@@ -531,3 +626,159 @@ func TestParseChainControlDescription(t *testing.T) {
 	}
 }
 
+
+// pointAtDistance returns the point meters from origin along bearing (degrees)
+func pointAtDistance(origin geo.Point, meters, bearing float64) geo.Point {
+	const earthRadius = 6371000
+	lat1 := origin.Latitude * math.Pi / 180
+	lon1 := origin.Longitude * math.Pi / 180
+	theta := bearing * math.Pi / 180
+	delta := meters / earthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return geo.Point{Latitude: lat2 * 180 / math.Pi, Longitude: lon2 * 180 / math.Pi}
+}
+
+func incidentAt(name string, p geo.Point) CaltransIncident {
+	return CaltransIncident{Name: name, Coordinates: &api.Coordinates{Latitude: p.Latitude, Longitude: p.Longitude}}
+}
+
+func TestFilterIncidentsByBoundingBox_RadiusBoundary(t *testing.T) {
+	parser := NewFeedParser()
+	route := []geo.Point{
+		{Latitude: 38.2552, Longitude: -120.3513}, // Arnold
+		{Latitude: 38.4685, Longitude: -120.0410}, // Bear Valley
+	}
+	const radius = 10000.0
+
+	// Just inside and just outside the radius in every direction around each
+	// route point, so the box's corners and edges are both exercised
+	var incidents []CaltransIncident
+	for _, p := range route {
+		for bearing := 0.0; bearing < 360; bearing += 22.5 {
+			incidents = append(incidents,
+				incidentAt(fmt.Sprintf("inside-%.1f", bearing), pointAtDistance(p, radius-5, bearing)),
+				incidentAt(fmt.Sprintf("outside-%.1f", bearing), pointAtDistance(p, radius+5, bearing)))
+		}
+	}
+	incidents = append(incidents, incidentAt("los-angeles", geo.Point{Latitude: 34.05, Longitude: -118.24}),
+		CaltransIncident{Name: "no-coordinates"})
+
+	want := parser.FilterByGeography(incidents, route, radius)
+	got := parser.FilterIncidentsByBoundingBox(incidents, route, radius)
+	assert.Equal(t, want, got)
+
+	insideCount := 0
+	for _, incident := range got {
+		assert.False(t, strings.HasPrefix(incident.Name, "outside"), "%s passed the filter", incident.Name)
+		if strings.HasPrefix(incident.Name, "inside") {
+			insideCount++
+		}
+	}
+	assert.Equal(t, 2*16, insideCount, "every incident just inside the radius should pass")
+}
+
+// filterBenchmarkFixture returns a 1,000-point route through the Sierra
+// foothills and 2,000 incidents spread statewide
+func filterBenchmarkFixture() ([]geo.Point, []CaltransIncident) {
+	route := make([]geo.Point, 1000)
+	for i := range route {
+		route[i] = geo.Point{Latitude: 38.25 + float64(i)*0.0002, Longitude: -120.35 + float64(i)*0.0003}
+	}
+	incidents := make([]CaltransIncident, 2000)
+	for i := range incidents {
+		incidents[i] = incidentAt(fmt.Sprintf("incident-%d", i), geo.Point{
+			Latitude:  32.5 + float64(i%97)*0.09,
+			Longitude: -124.4 + float64(i%89)*0.1,
+		})
+	}
+	return route, incidents
+}
+
+func BenchmarkFilterByGeography(b *testing.B) {
+	parser := NewFeedParser()
+	route, incidents := filterBenchmarkFixture()
+	for b.Loop() {
+		parser.FilterByGeography(incidents, route, 16000)
+	}
+}
+
+func BenchmarkFilterIncidentsByBoundingBox(b *testing.B) {
+	parser := NewFeedParser()
+	route, incidents := filterBenchmarkFixture()
+	for b.Loop() {
+		parser.FilterIncidentsByBoundingBox(incidents, route, 16000)
+	}
+}
+
+// statusDoer answers every request with the same HTTP status
+type statusDoer int
+
+func (d statusDoer) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: int(d), Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestFeedParser_ErrorTypes(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		status int
+		want   error
+	}{
+		{http.StatusTooManyRequests, upstream.ErrRateLimited},
+		{http.StatusForbidden, upstream.ErrUnauthorized},
+		{http.StatusServiceUnavailable, upstream.ErrUpstreamUnavailable},
+	} {
+		parser := &FeedParser{HTTPClient: statusDoer(tt.status)}
+
+		_, err := parser.ParseCHPIncidents(ctx)
+		assert.ErrorIs(t, err, tt.want, "KML feed status %d", tt.status)
+
+		_, err = parser.ParseRoadConditions(ctx, "4")
+		assert.ErrorIs(t, err, tt.want, "road conditions status %d", tt.status)
+	}
+
+	_, err := (&FeedParser{HTTPClient: statusDoer(http.StatusNotFound)}).ParseLaneClosures(ctx)
+	assert.Error(t, err)
+	assert.False(t, upstream.Transient(err) || errors.Is(err, upstream.ErrUnauthorized), "404 classified as %v", err)
+}
+
+// stallingDoer sends response headers, then a body that never finishes. It
+// ignores the request context, like a transport stuck mid-read.
+type stallingDoer struct{}
+
+func (stallingDoer) Do(req *http.Request) (*http.Response, error) {
+	body, _ := io.Pipe()
+	return &http.Response{StatusCode: http.StatusOK, Body: body}, nil
+}
+
+func TestParseFeed_ContextCanceledDuringBodyRead(t *testing.T) {
+	parser := &FeedParser{HTTPClient: stallingDoer{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := parser.ParseCHPIncidents(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, upstream.Transient(err), "cancelled read should classify as transient: %v", err)
+	assert.Less(t, time.Since(start), time.Second, "parse should stop promptly on cancellation")
+}
+
+func TestParseFeed_PerFeedTimeout(t *testing.T) {
+	parser := &FeedParser{
+		HTTPClient: stallingDoer{},
+		Timeouts:   FeedTimeouts{LaneClosures: 50 * time.Millisecond, RoadConditions: 50 * time.Millisecond},
+	}
+	ctx := context.Background()
+
+	start := time.Now()
+	_, err := parser.ParseLaneClosures(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	start = time.Now()
+	_, err = parser.ParseRoadConditions(ctx, "4")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,100 @@
+package caltrans
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// Errors for KML coordinate tuples that are dropped
+var (
+	errInvalidCoordinates = errors.New("invalid KML coordinates")
+	// errSwappedCoordinates is a tuple whose latitude is out of range but
+	// would be valid as the longitude, suggesting a feed wrote "lat,lon"
+	errSwappedCoordinates = errors.New("KML coordinates look swapped (latitude first)")
+)
+
+var (
+	// commaSpacing is whitespace around a tuple's commas ("-120.5, 38.1"),
+	// which would otherwise split the tuple
+	commaSpacing = regexp.MustCompile(`\s*,\s*`)
+	tupleSpacing = regexp.MustCompile(`\s+`)
+)
+
+// splitCoordinateTuples splits a KML coordinates value into its
+// "lon,lat[,alt]" tuples. Tuples are separated by whitespace; whitespace
+// around the commas within a tuple (tabs and newlines included) is ignored.
+func splitCoordinateTuples(coordString string) []string {
+	coordString = strings.TrimSpace(commaSpacing.ReplaceAllString(coordString, ","))
+	if coordString == "" {
+		return nil
+	}
+	return tupleSpacing.Split(coordString, -1)
+}
+
+// parseCoordinateTuple parses one "lon,lat" or "lon,lat,alt" tuple. The
+// altitude is ignored but must be a number when present. Out-of-range values
+// are rejected, with errSwappedCoordinates when the pair looks reversed.
+func parseCoordinateTuple(tuple string) (*api.Coordinates, error) {
+	parts := strings.Split(tuple, ",")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, errInvalidCoordinates
+	}
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, errInvalidCoordinates
+		}
+		values[i] = value
+	}
+
+	longitude, latitude := values[0], values[1]
+	if math.Abs(latitude) > 90 {
+		if math.Abs(latitude) <= 180 && math.Abs(longitude) <= 90 {
+			return nil, errSwappedCoordinates
+		}
+		return nil, errInvalidCoordinates
+	}
+	if math.Abs(longitude) > 180 {
+		return nil, errInvalidCoordinates
+	}
+	return &api.Coordinates{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// swappedTuples returns the placemark's coordinate tuples that look swapped
+func (pm *Placemark) swappedTuples() []string {
+	var swapped []string
+	for _, coordString := range pm.coordinateStrings() {
+		for _, tuple := range splitCoordinateTuples(coordString) {
+			if _, err := parseCoordinateTuple(tuple); errors.Is(err, errSwappedCoordinates) {
+				swapped = append(swapped, tuple)
+			}
+		}
+	}
+	return swapped
+}
+
+// coordinateStrings returns the coordinates values of every geometry the
+// placemark carries
+func (pm *Placemark) coordinateStrings() []string {
+	coordStrings := []string{
+		pm.Point.Coordinates,
+		pm.LineString.Coordinates,
+		pm.Polygon.OuterBoundary.LinearRing.Coordinates,
+	}
+	for _, point := range pm.MultiGeometry.Points {
+		coordStrings = append(coordStrings, point.Coordinates)
+	}
+	for _, lineString := range pm.MultiGeometry.LineStrings {
+		coordStrings = append(coordStrings, lineString.Coordinates)
+	}
+	for _, polygon := range pm.MultiGeometry.Polygons {
+		coordStrings = append(coordStrings, polygon.OuterBoundary.LinearRing.Coordinates)
+	}
+	return coordStrings
+}
@@ -0,0 +1,74 @@
+package caltrans
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCoordinateTuple(t *testing.T) {
+	for _, tt := range []struct {
+		tuple    string
+		lat, lon float64
+		err      error
+	}{
+		{tuple: "-120.5,38.1", lat: 38.1, lon: -120.5},
+		{tuple: "-120.5,38.1,0", lat: 38.1, lon: -120.5},
+		{tuple: "-120.5,38.1,1234.5", lat: 38.1, lon: -120.5},
+		{tuple: "-180,-90,0", lat: -90, lon: -180},
+		{tuple: "38.1,-120.5,0", err: errSwappedCoordinates},
+		{tuple: "-80.5,95,0", err: errSwappedCoordinates},
+		{tuple: "-120.5,95,0", err: errInvalidCoordinates},
+		{tuple: "-120.5,38.1,0,0", err: errInvalidCoordinates},
+		{tuple: "-120.5", err: errInvalidCoordinates},
+		{tuple: "-120.5,38.1,high", err: errInvalidCoordinates},
+		{tuple: "-190,38.1", err: errInvalidCoordinates},
+		{tuple: "120.5,-190", err: errInvalidCoordinates},
+		{tuple: "NaN,38.1", err: errInvalidCoordinates},
+	} {
+		coord, err := parseCoordinateTuple(tt.tuple)
+		if tt.err != nil {
+			assert.ErrorIs(t, err, tt.err, tt.tuple)
+			assert.Nil(t, coord, tt.tuple)
+			continue
+		}
+		require.NoError(t, err, tt.tuple)
+		assert.Equal(t, tt.lat, coord.Latitude, tt.tuple)
+		assert.Equal(t, tt.lon, coord.Longitude, tt.tuple)
+	}
+}
+
+func TestParseCoordinateList_Tolerant(t *testing.T) {
+	parser := NewFeedParser()
+
+	// Altitude on some tuples only, and whitespace within and between tuples
+	coords := parser.parseCoordinateList("\n\t-120.5000, 38.1000\n\t-120.4500,\n\t\t38.1200,0\t-120.4000 ,38.1400 , 12\n")
+	require.Len(t, coords, 3)
+	assert.Equal(t, 38.1000, coords[0].Latitude)
+	assert.Equal(t, -120.4500, coords[1].Longitude)
+	assert.Equal(t, 38.1400, coords[2].Latitude)
+
+	// Out-of-range and swapped tuples are dropped, the rest kept
+	coords = parser.parseCoordinateList("-120.5,38.1,0 38.12,-120.45,0 -120.4,138.14,0")
+	require.Len(t, coords, 1)
+	assert.Equal(t, -120.5, coords[0].Longitude)
+
+	coord := parser.parseCoordinates(" -120.5000 ,\n 38.1000 , 0 ")
+	require.NotNil(t, coord)
+	assert.Equal(t, 38.1000, coord.Latitude)
+	assert.Nil(t, parser.parseCoordinates("38.1000,-120.5000,0"), "swapped point")
+}
+
+func TestPlacemark_SwappedTuples(t *testing.T) {
+	placemark := &Placemark{
+		Point:      Point{Coordinates: "38.1,-120.5,0"},
+		LineString: LineString{Coordinates: "-120.5,38.1,0 38.2,-120.4,0"},
+		MultiGeometry: MultiGeometry{Polygons: []Polygon{{
+			OuterBoundary: OuterBoundary{LinearRing: LinearRing{Coordinates: "-120.5,38.1,0 -120.4,200,0"}},
+		}}},
+	}
+	assert.Equal(t, []string{"38.1,-120.5,0", "38.2,-120.4,0"}, placemark.swappedTuples(),
+		"out-of-range tuples that can't be swapped aren't reported")
+	assert.Empty(t, (&Placemark{Point: Point{Coordinates: "-120.5,38.1,0"}}).swappedTuples())
+}
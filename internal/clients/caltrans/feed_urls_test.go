@@ -0,0 +1,79 @@
+package caltrans
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
+)
+
+// recordingDoer records requested URLs and User-Agents and serves an empty
+// KML document
+type recordingDoer struct {
+	urls       []string
+	userAgents []string
+}
+
+func (r *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	r.urls = append(r.urls, req.URL.String())
+	r.userAgents = append(r.userAgents, req.Header.Get("User-Agent"))
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`<kml><Document></Document></kml>`)),
+	}, nil
+}
+
+func TestFeedParser_URLOverrides(t *testing.T) {
+	ctx := context.Background()
+	doer := &recordingDoer{}
+	parser := &FeedParser{
+		HTTPClient: doer,
+		URLs: FeedURLs{
+			LaneClosures:   "https://mirror.example.com/lcs2way.kml",
+			CHPIncidents:   "https://staging.example.com/chp-only.kml",
+			RoadConditions: "https://mirror.example.com/roads?hwy=%s",
+		},
+	}
+
+	_, err := parser.ParseLaneClosures(ctx)
+	require.NoError(t, err)
+	_, err = parser.ParseCHPIncidents(ctx)
+	require.NoError(t, err)
+	_, err = parser.ParseChainControls(ctx)
+	require.NoError(t, err)
+	_, err = parser.ParseRoadConditions(ctx, "4")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"https://mirror.example.com/lcs2way.kml",
+		"https://staging.example.com/chp-only.kml",
+		DefaultChainControlsURL, // Not overridden
+		"https://mirror.example.com/roads?hwy=4",
+	}, doer.urls)
+}
+
+func TestFeedParser_UserAgent(t *testing.T) {
+	ctx := context.Background()
+	doer := &recordingDoer{}
+	parser := &FeedParser{HTTPClient: doer}
+
+	_, err := parser.ParseLaneClosures(ctx)
+	require.NoError(t, err)
+	parser.UserAgent = "ersn-staging (ops@example.org)"
+	_, err = parser.ParseCHPIncidents(ctx)
+	require.NoError(t, err)
+	_, err = parser.ParseRoadConditions(ctx, "4")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		upstream.DefaultUserAgent(),
+		"ersn-staging (ops@example.org)",
+		"ersn-staging (ops@example.org)",
+	}, doer.userAgents)
+}
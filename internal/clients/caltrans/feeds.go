@@ -0,0 +1,38 @@
+package caltrans
+
+import (
+	"strconv"
+	"sync"
+)
+
+var (
+	feedTypesMu sync.RWMutex
+	// feedTypeNames is indexed by CaltransFeedType: the built-in types
+	// followed by any added with RegisterFeedType
+	feedTypeNames = []string{"chain_control", "lane_closure", "chp_incident"}
+)
+
+// RegisterFeedType returns the feed type for an additional Caltrans KML feed
+// (e.g. "cms" for message signs, "roadwork"), registering it on first use.
+// Registering a name again, including a built-in one, returns the same type.
+func RegisterFeedType(name string) CaltransFeedType {
+	feedTypesMu.Lock()
+	defer feedTypesMu.Unlock()
+	for i, existing := range feedTypeNames {
+		if existing == name {
+			return CaltransFeedType(i)
+		}
+	}
+	feedTypeNames = append(feedTypeNames, name)
+	return CaltransFeedType(len(feedTypeNames) - 1)
+}
+
+// String returns the feed type's name
+func (t CaltransFeedType) String() string {
+	feedTypesMu.RLock()
+	defer feedTypesMu.RUnlock()
+	if t >= 0 && int(t) < len(feedTypeNames) {
+		return feedTypeNames[t]
+	}
+	return "feed_type_" + strconv.Itoa(int(t))
+}
@@ -0,0 +1,40 @@
+package caltrans
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterFeedType(t *testing.T) {
+	assert.Equal(t, LANE_CLOSURE, RegisterFeedType("lane_closure"))
+	assert.Equal(t, "chp_incident", CHP_INCIDENT.String())
+
+	roadwork := RegisterFeedType("test_roadwork")
+	assert.Greater(t, int(roadwork), int(CHP_INCIDENT))
+	assert.Equal(t, roadwork, RegisterFeedType("test_roadwork"))
+	assert.Equal(t, "test_roadwork", roadwork.String())
+	assert.NotEqual(t, roadwork, RegisterFeedType("test_cms"))
+}
+
+func TestParseFeed_CustomURL(t *testing.T) {
+	const url = "https://quickmap.dot.ca.gov/data/d10-roadwork.kml"
+	parser := &FeedParser{HTTPClient: &mockHTTPClient{
+		testDataDir: filepath.Join("..", "..", "..", "tests", "testdata", "caltrans"),
+		files:       map[string]string{url: "lane_closures.kml"},
+	}}
+	roadwork := RegisterFeedType("test_d10_roadwork")
+
+	incidents, err := parser.ParseFeed(context.Background(), url, roadwork)
+	require.NoError(t, err)
+	require.NotEmpty(t, incidents)
+	for _, incident := range incidents {
+		assert.Equal(t, roadwork, incident.FeedType)
+	}
+
+	_, err = parser.ParseFeed(context.Background(), "https://quickmap.dot.ca.gov/data/missing.kml", roadwork)
+	assert.Error(t, err)
+}
@@ -0,0 +1,183 @@
+package caltrans
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+var (
+	// ErrNotKML is a feed response that isn't KML at all, such as an HTML
+	// error or login page served with a 200
+	ErrNotKML = errors.New("response is not KML")
+
+	// ErrFeedTooLarge is a feed body over the parser's MaxBodyBytes
+	ErrFeedTooLarge = errors.New("KML body exceeds the size limit")
+)
+
+// checkKMLContentType rejects Content-Types no KML is served as. KML comes
+// under many types (application/vnd.google-earth.kml+xml, text/xml,
+// application/octet-stream...), so only known non-KML types are refused.
+func checkKMLContentType(contentType string) error {
+	if contentType == "" {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil
+	}
+	switch mediaType {
+	case "text/html", "application/xhtml+xml", "application/json":
+		return fmt.Errorf("%w: served as %s", ErrNotKML, mediaType)
+	}
+	return nil
+}
+
+// limitBody fails reads past maxBytes with ErrFeedTooLarge, where
+// io.LimitReader alone would silently truncate. Zero uses
+// DefaultMaxFeedBytes.
+func limitBody(body io.ReadCloser, maxBytes int64) io.ReadCloser {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxFeedBytes
+	}
+	return &limitedBody{
+		r:        io.LimitReader(body, maxBytes+1),
+		Closer:   body,
+		maxBytes: maxBytes,
+	}
+}
+
+type limitedBody struct {
+	r io.Reader
+	io.Closer
+	maxBytes int64
+	read     int64
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.maxBytes {
+		return 0, fmt.Errorf("%w of %d bytes", ErrFeedTooLarge, l.maxBytes)
+	}
+	return n, err
+}
+
+// kmlVisitor receives the placemarks and network links of a KML file as they
+// are decoded
+type kmlVisitor struct {
+	placemark   func(*Placemark)
+	networkLink func(*NetworkLink)
+}
+
+// decodeKML streams a KML file from r, decoding one placemark or network link
+// at a time rather than the whole document. It visits the same elements as
+// unmarshalling into KML would, those in the Document and its folders at any
+// depth, in document order.
+//
+// Parse errors are returned as-is, for the caller to say which KML failed;
+// read errors, and ctx ending, are wrapped in kmlReadError. The body is
+// closed when ctx ends, which unblocks a read stalled on a slow upstream.
+func decodeKML(ctx context.Context, r io.ReadCloser, visit kmlVisitor) error {
+	stop := context.AfterFunc(ctx, func() { _ = r.Close() })
+	defer stop()
+
+	dec := xml.NewDecoder(&kmlReader{r: r})
+	// containers[i] reports whether the i'th open element holds placemarks:
+	// the root kml's Document, and folders within containers
+	var containers []bool
+	for {
+		if err := ctx.Err(); err != nil {
+			return &kmlReadError{err: err}
+		}
+		tok, err := dec.Token()
+		if err != nil {
+			if len(containers) == 0 && ctx.Err() == nil && !isReadFailure(err) {
+				// Not even a root element: an empty body, JSON, plain text
+				return fmt.Errorf("%w: %w", ErrNotKML, err)
+			}
+			// Including io.EOF, as the root element's end returns first
+			return kmlError(ctx, err)
+		}
+
+		switch el := tok.(type) {
+		case xml.StartElement:
+			depth := len(containers)
+			if depth == 0 && el.Name.Local != "kml" {
+				if strings.EqualFold(el.Name.Local, "html") {
+					return fmt.Errorf("%w: got an HTML page", ErrNotKML)
+				}
+				return fmt.Errorf("%w: expected element type <kml> but have <%s>", ErrNotKML, el.Name.Local)
+			}
+			inContainer := depth > 0 && containers[depth-1]
+			switch {
+			case inContainer && el.Name.Local == "Placemark":
+				var placemark Placemark
+				if err := dec.DecodeElement(&placemark, &el); err != nil {
+					return kmlError(ctx, err)
+				}
+				visit.placemark(&placemark)
+				continue
+			case inContainer && el.Name.Local == "NetworkLink":
+				var link NetworkLink
+				if err := dec.DecodeElement(&link, &el); err != nil {
+					return kmlError(ctx, err)
+				}
+				if visit.networkLink != nil {
+					visit.networkLink(&link)
+				}
+				continue
+			}
+			isContainer := (depth == 1 && el.Name.Local == "Document") ||
+				(inContainer && el.Name.Local == "Folder")
+			containers = append(containers, isContainer)
+		case xml.EndElement:
+			containers = containers[:len(containers)-1]
+			if len(containers) == 0 {
+				// Anything after the root element is ignored, as Unmarshal does
+				return nil
+			}
+		}
+	}
+}
+
+// kmlError classifies an error from the decoder as a read or parse error
+func kmlError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return &kmlReadError{err: ctxErr}
+	}
+	return err
+}
+
+// isReadFailure reports whether err came from reading the body rather than
+// from the XML in it
+func isReadFailure(err error) bool {
+	var readErr *kmlReadError
+	return errors.As(err, &readErr) || errors.Is(err, ErrFeedTooLarge)
+}
+
+// kmlReadError is a failure reading the KML body, as opposed to parsing it
+type kmlReadError struct {
+	err error
+}
+
+func (e *kmlReadError) Error() string { return "failed to read KML response: " + e.err.Error() }
+func (e *kmlReadError) Unwrap() error { return e.err }
+
+// kmlReader marks errors from the underlying reader so decodeKML can tell
+// them from malformed XML
+type kmlReader struct {
+	r io.Reader
+}
+
+func (k *kmlReader) Read(p []byte) (int, error) {
+	n, err := k.r.Read(p)
+	if err != nil && err != io.EOF && !errors.Is(err, ErrFeedTooLarge) {
+		err = &kmlReadError{err: err}
+	}
+	return n, err
+}
@@ -0,0 +1,177 @@
+package caltrans
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
+)
+
+// unmarshalIncidents is the pre-streaming parse: the whole document
+// unmarshalled, then its placemarks and folders walked
+func unmarshalIncidents(t testing.TB, p *FeedParser, kmlData []byte, feedType CaltransFeedType) []CaltransIncident {
+	var kml KML
+	require.NoError(t, xml.Unmarshal(kmlData, &kml))
+	var walk func(placemarks []Placemark, folders []Folder) []CaltransIncident
+	walk = func(placemarks []Placemark, folders []Folder) []CaltransIncident {
+		var incidents []CaltransIncident
+		for i := range placemarks {
+			if incident := p.processPlacemark(&placemarks[i], feedType, time.Now()); incident != nil {
+				incidents = append(incidents, *incident)
+			}
+		}
+		for _, folder := range folders {
+			incidents = append(incidents, walk(folder.Placemarks, folder.Folders)...)
+		}
+		return incidents
+	}
+	return walk(kml.Document.Placemarks, kml.Document.Folders)
+}
+
+func readFixture(t testing.TB, name string) []byte {
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "tests", "testdata", "caltrans", name))
+	require.NoError(t, err)
+	return data
+}
+
+func TestDecodeKML_MatchesUnmarshal(t *testing.T) {
+	parser := NewFeedParser()
+
+	// The largest feed, and one with placemarks between nested folders; the
+	// rest add seconds without adding cases
+	for _, name := range []string{"lane_closures.kml", "nested_folders.kml"} {
+		data := readFixture(t, name)
+		want := unmarshalIncidents(t, parser, data, LANE_CLOSURE)
+		got, err := parser.ParseKMLContent(data, LANE_CLOSURE)
+		require.NoError(t, err, name)
+		require.Len(t, got, len(want), name)
+		for i := range want {
+			got[i].LastFetched, want[i].LastFetched = time.Time{}, time.Time{}
+		}
+		assert.ElementsMatch(t, want, got, name)
+	}
+}
+
+func TestDecodeKML_Errors(t *testing.T) {
+	decode := func(kml string) error {
+		return decodeKML(context.Background(), io.NopCloser(bytes.NewReader([]byte(kml))), kmlVisitor{
+			placemark: func(*Placemark) {},
+		})
+	}
+
+	assert.ErrorIs(t, decode(""), ErrNotKML)
+	assert.ErrorIs(t, decode(`{"error": "quota exceeded"}`), ErrNotKML)
+	assert.ErrorContains(t, decode("<?xml version=\"1.0\"?><rss></rss>"), "expected element type <kml> but have <rss>")
+	assert.ErrorContains(t, decode("<kml><Document><Placemark><name>Cut off"), "XML syntax error")
+	assert.NotErrorIs(t, decode("<kml><Document><Placemark><name>Cut off"), ErrNotKML)
+	assert.NoError(t, decode("<kml><Document/></kml><!-- trailing -->"))
+
+	// Placemarks outside the Document aren't visited, as Unmarshal ignores them
+	var names []string
+	err := decodeKML(context.Background(), io.NopCloser(bytes.NewReader([]byte(
+		`<kml><Placemark><name>stray</name></Placemark><Document><Placemark><name>kept</name></Placemark>
+		<Style><Placemark><name>styled</name></Placemark></Style></Document></kml>`))), kmlVisitor{
+		placemark: func(p *Placemark) { names = append(names, p.Name) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kept"}, names)
+
+	// Read errors are told apart from parse errors
+	err = decodeKML(context.Background(), io.NopCloser(io.MultiReader(
+		bytes.NewReader([]byte("<kml><Document>")), failingReader{})), kmlVisitor{placemark: func(*Placemark) {}})
+	var readErr *kmlReadError
+	assert.ErrorAs(t, err, &readErr)
+}
+
+// bodyDoer answers every request with body served as contentType
+type bodyDoer struct {
+	contentType string
+	body        string
+}
+
+func (d bodyDoer) Do(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	if d.contentType != "" {
+		header.Set("Content-Type", d.contentType)
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(d.body))}, nil
+}
+
+func TestParseFeed_Guards(t *testing.T) {
+	ctx := context.Background()
+	kml := string(readFixture(t, "nested_folders.kml"))
+	errorPage := "<!DOCTYPE html>\n<html><head><title>Maintenance</title><meta charset=utf-8></head><body>Back soon</body></html>"
+
+	// An HTML error page is an error, whether or not it's labelled as HTML
+	for _, contentType := range []string{"text/html; charset=utf-8", "application/octet-stream", ""} {
+		incidents, err := (&FeedParser{HTTPClient: bodyDoer{contentType, errorPage}}).ParseCHPIncidents(ctx)
+		assert.ErrorIs(t, err, ErrNotKML, contentType)
+		assert.Contains(t, strings.ToLower(err.Error()), "html", contentType)
+		assert.Contains(t, err.Error(), DefaultCHPIncidentsURL, "names the feed")
+		assert.False(t, upstream.Transient(err), "a wrong response isn't retried as an outage")
+		assert.Nil(t, incidents)
+	}
+	_, err := (&FeedParser{HTTPClient: bodyDoer{"text/html", kml}}).ParseCHPIncidents(ctx)
+	assert.ErrorIs(t, err, ErrNotKML, "refused by Content-Type alone")
+
+	// KML comes under all sorts of types
+	for _, contentType := range []string{"application/vnd.google-earth.kml+xml", "text/xml", "application/octet-stream", ""} {
+		incidents, err := (&FeedParser{HTTPClient: bodyDoer{contentType, kml}}).ParseCHPIncidents(ctx)
+		require.NoError(t, err, contentType)
+		assert.Len(t, incidents, 5, contentType)
+	}
+
+	// A body over the limit fails rather than being truncated
+	parser := &FeedParser{HTTPClient: bodyDoer{"", kml}, MaxBodyBytes: int64(len(kml) - 1)}
+	_, err = parser.ParseCHPIncidents(ctx)
+	assert.ErrorIs(t, err, ErrFeedTooLarge)
+	assert.Contains(t, err.Error(), DefaultCHPIncidentsURL, "names the feed")
+	assert.False(t, upstream.Transient(err))
+	parser.MaxBodyBytes = int64(len(kml))
+	incidents, err := parser.ParseCHPIncidents(ctx)
+	require.NoError(t, err, "a body exactly at the limit is accepted")
+	assert.Len(t, incidents, 5)
+}
+
+// failingReader fails every read, like a connection reset mid-body
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, io.ErrUnexpectedEOF }
+
+// BenchmarkDecodeKML compares decoding alone; converting placemarks to
+// incidents costs the same either way. Streaming makes about as many
+// allocations but never holds the body or the whole document, so it
+// allocates fewer bytes and its peak memory is one placemark, not the feed.
+func BenchmarkDecodeKML(b *testing.B) {
+	data := readFixture(b, "lane_closures.kml")
+
+	b.Run("unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			body, err := io.ReadAll(bytes.NewReader(data))
+			require.NoError(b, err)
+			var kml KML
+			require.NoError(b, xml.Unmarshal(body, &kml))
+		}
+	})
+	b.Run("stream", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			err := decodeKML(context.Background(), io.NopCloser(bytes.NewReader(data)), kmlVisitor{
+				placemark: func(*Placemark) {},
+			})
+			require.NoError(b, err)
+		}
+	})
+}
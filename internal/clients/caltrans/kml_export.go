@@ -0,0 +1,91 @@
+package caltrans
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// kmlNamespace is the KML 2.2 namespace the quickmap feeds declare
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// EncodeKML marshals doc as a KML 2.2 file in the shape of the quickmap
+// feeds, so it can be read back by ParseKMLContent or any KML tool
+func EncodeKML(doc Document) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	start := xml.StartElement{
+		Name: xml.Name{Local: "kml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: kmlNamespace}},
+	}
+	if err := enc.EncodeElement(KML{Document: doc}, start); err != nil {
+		return nil, fmt.Errorf("failed to encode KML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode KML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatCoordinates writes points as a KML coordinate list
+// ("lon,lat,0 lon,lat,0 ...")
+func FormatCoordinates(points ...geo.Point) string {
+	tuples := make([]string, len(points))
+	for i, p := range points {
+		tuples[i] = fmt.Sprintf("%.6f,%.6f,0", p.Longitude, p.Latitude)
+	}
+	return strings.Join(tuples, " ")
+}
+
+// The geometry fields of Placemark are values, so without these an encoded
+// placemark would carry an empty element for every geometry type it lacks.
+
+// MarshalXML omits a Point without coordinates
+func (p Point) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if p.Coordinates == "" {
+		return nil
+	}
+	type plain Point
+	return e.EncodeElement(plain(p), start)
+}
+
+// MarshalXML omits a LineString without coordinates
+func (l LineString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if l.Coordinates == "" {
+		return nil
+	}
+	type plain LineString
+	return e.EncodeElement(plain(l), start)
+}
+
+// MarshalXML omits a Polygon without an outer boundary
+func (p Polygon) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if p.OuterBoundary.LinearRing.Coordinates == "" {
+		return nil
+	}
+	type plain Polygon
+	return e.EncodeElement(plain(p), start)
+}
+
+// MarshalXML omits an empty MultiGeometry
+func (m MultiGeometry) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(m.Points) == 0 && len(m.LineStrings) == 0 && len(m.Polygons) == 0 {
+		return nil
+	}
+	type plain MultiGeometry
+	return e.EncodeElement(plain(m), start)
+}
+
+// MarshalXML omits ExtendedData without fields
+func (d ExtendedData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(d.Data) == 0 && len(d.SchemaData) == 0 {
+		return nil
+	}
+	type plain ExtendedData
+	return e.EncodeElement(plain(d), start)
+}
@@ -0,0 +1,99 @@
+package caltrans
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LaneClosure is how many lanes an incident or closure blocks, as stated in
+// its description. The zero value means the description doesn't say.
+type LaneClosure struct {
+	Closed int  // Lanes closed or blocked; 0 when unknown
+	Total  int  // Lanes in that direction; 0 when unknown
+	All    bool // Every lane is closed
+}
+
+// Known reports whether the description stated any lane information
+func (l LaneClosure) Known() bool {
+	return l.All || l.Closed > 0
+}
+
+// String formats the closure for display: "all", "2 of 3", "1", or "" when unknown
+func (l LaneClosure) String() string {
+	switch {
+	case l.All:
+		return "all"
+	case l.Closed > 0 && l.Total > 0:
+		return fmt.Sprintf("%d of %d", l.Closed, l.Total)
+	case l.Closed > 0:
+		return strconv.Itoa(l.Closed)
+	default:
+		return ""
+	}
+}
+
+// laneCount matches a lane count as digits or a word
+const laneCount = `(\d+|one|two|three|four|five)`
+
+// laneWord matches "lane", "lanes", "ln" and "lns"
+const laneWord = `(?:lanes?|lns?)\b`
+
+var (
+	// "ALL LANES CLOSED", "BLOCKING ALL LNS", "all lanes are blocked"
+	allLanesRe = regexp.MustCompile(`(?i)\ball\s+` + laneWord + `\s+(?:are\s+)?(?:closed|blocked|blkd)|\b(?:blocking|blkg|closing|closed|blocked)\s+all\s+` + laneWord)
+
+	// "2 of 3 lanes closed"
+	lanesOfTotalRe = regexp.MustCompile(`(?i)\b` + laneCount + `\s+of\s+` + laneCount + `\s+` + laneWord)
+
+	// "BLOCKING 1 LN", "closing 2 lanes"
+	blockingLanesRe = regexp.MustCompile(`(?i)\b(?:blocking|blkg|closing|closed|blocked)\s+` + laneCount + `\s+` + laneWord)
+
+	// "1 LANE CLOSED", "2 LNS BLOCKED"
+	lanesClosedRe = regexp.MustCompile(`(?i)\b` + laneCount + `\s+` + laneWord + `\s+(?:are\s+|is\s+)?(?:closed|blocked|blkd)`)
+
+	// "RIGHT LANE CLOSED", "#2 LN BLOCKED"
+	namedLaneClosedRe = regexp.MustCompile(`(?i)(?:\b(?:right|left|center|middle|slow|fast|hov)|#\d)\s+(?:lane|ln)\s+(?:is\s+)?(?:closed|blocked|blkd)`)
+)
+
+// ParseLaneClosure extracts closed and total lane counts from description text
+func ParseLaneClosure(text string) LaneClosure {
+	if allLanesRe.MatchString(text) {
+		return LaneClosure{All: true}
+	}
+	if m := lanesOfTotalRe.FindStringSubmatch(text); m != nil {
+		closed, total := parseLaneCount(m[1]), parseLaneCount(m[2])
+		if closed > 0 && total > 0 && closed >= total {
+			return LaneClosure{Closed: closed, Total: total, All: true}
+		}
+		return LaneClosure{Closed: closed, Total: total}
+	}
+	for _, re := range []*regexp.Regexp{blockingLanesRe, lanesClosedRe} {
+		if m := re.FindStringSubmatch(text); m != nil {
+			return LaneClosure{Closed: parseLaneCount(m[1])}
+		}
+	}
+	if namedLaneClosedRe.MatchString(text) {
+		return LaneClosure{Closed: 1}
+	}
+	return LaneClosure{}
+}
+
+// parseLaneCount converts a laneCount match to a number
+func parseLaneCount(s string) int {
+	switch strings.ToLower(s) {
+	case "one":
+		return 1
+	case "two":
+		return 2
+	case "three":
+		return 3
+	case "four":
+		return 4
+	case "five":
+		return 5
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
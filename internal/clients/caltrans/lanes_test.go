@@ -0,0 +1,35 @@
+package caltrans
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLaneClosure(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected LaneClosure
+		display  string
+	}{
+		{"CHP blocking shorthand", "1141-Ambulance Enroute, BLOCKING 1 LN", LaneClosure{Closed: 1}, "1"},
+		{"lane closed", "Due to Paving 1 LANE CLOSED", LaneClosure{Closed: 1}, "1"},
+		{"word count", "Two lanes closed for bridge work", LaneClosure{Closed: 2}, "2"},
+		{"all lanes closed", "SR-4 ALL LANES CLOSED at Pacific Grade", LaneClosure{All: true}, "all"},
+		{"blocking all lanes", "VEH BLKG ALL LNS", LaneClosure{All: true}, "all"},
+		{"closed of total", "2 of 3 lanes closed eastbound", LaneClosure{Closed: 2, Total: 3}, "2 of 3"},
+		{"closed of total is all", "2 of 2 lanes blocked", LaneClosure{Closed: 2, Total: 2, All: true}, "all"},
+		{"named lane", "RIGHT LANE CLOSED due to debris", LaneClosure{Closed: 1}, "1"},
+		{"no lane info", "Traffic collision, no injuries", LaneClosure{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseLaneClosure(tt.input)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.display, result.String())
+			assert.Equal(t, tt.display != "", result.Known())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package caltrans
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// mileMarkerRe matches a mile marker or postmile in description text: "MM 31",
+// "MP 31.5", "mile marker 31", "milepost 31", "post mile 12.40", "PM: 12.4".
+// A bare "PM" needs a following separator so a clock time ("3:00 PM 12/5") or
+// "3pm" isn't read as a postmile.
+var mileMarkerRe = regexp.MustCompile(`(?i)\b(?:mm|mp|mile\s*marker|mile\s*post|milepost|post\s*mile|postmile)[\s.#:-]*(\d+(?:\.\d+)?)\b|\bpm[.#:-]\s*(\d+(?:\.\d+)?)\b`)
+
+// ParseMileMarker extracts the first mile marker or postmile from description
+// text. Caltrans postmiles restart at each county line, so a marker only
+// places an incident on a road whose configured markers are in that county.
+func ParseMileMarker(text string) (float64, bool) {
+	m := mileMarkerRe.FindStringSubmatch(text)
+	if m == nil {
+		return 0, false
+	}
+	value := m[1]
+	if value == "" {
+		value = m[2]
+	}
+	mileMarker, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return mileMarker, true
+}
@@ -0,0 +1,33 @@
+package caltrans
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMileMarker(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+		ok    bool
+	}{
+		{"1183-Trfc Collision-Unkn Inj, SR4 EB MM 31", 31, true},
+		{"Vehicle in ditch near mile marker 22.5", 22.5, true},
+		{"Rock slide at MP31.2", 31.2, true},
+		{"Paving from milepost 12 to milepost 14", 12, true},
+		{"One-way traffic control, Post Mile 12.40", 12.40, true},
+		{"Bridge work at PM: 8.1", 8.1, true},
+		{"Expected to end at 3:01pm Sep 11, 2025", 0, false},
+		{"Closed until 3:00 PM 12/5", 0, false},
+		{"Traffic collision, no injuries", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseMileMarker(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
@@ -3,11 +3,12 @@ package caltrans
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // RoadConditionType represents the type of road condition
@@ -30,36 +31,38 @@ type RoadCondition struct {
 	LastUpdated string            // Timestamp from the page
 }
 
-const roadConditionsURLPattern = "https://roads.dot.ca.gov/roadscell.php?roadnumber=%s"
-
 // ParseRoadConditions fetches and parses the Caltrans road conditions page
 // for the given highway number (e.g., "4" for Highway 4).
 func (p *FeedParser) ParseRoadConditions(ctx context.Context, highwayNumber string) ([]RoadCondition, error) {
-	url := fmt.Sprintf(roadConditionsURLPattern, highwayNumber)
+	url := fmt.Sprintf(urlOrDefault(p.URLs.RoadConditions, DefaultRoadConditionsURL), highwayNumber)
+
+	ctx, cancel := withFeedTimeout(ctx, p.Timeouts.RoadConditions)
+	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	upstream.SetUserAgent(req, p.UserAgent)
 
 	httpClient := p.HTTPClient
 	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+		httpClient = &http.Client{}
 	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch road conditions: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to fetch road conditions: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error %d fetching road conditions for highway %s", resp.StatusCode, highwayNumber)
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("HTTP error %d fetching road conditions for highway %s", resp.StatusCode, highwayNumber))
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := readAll(ctx, resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read road conditions response: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to read road conditions response: %w", err))
 	}
 
 	return ParseRoadConditionsHTML(string(body), highwayNumber)
@@ -0,0 +1,32 @@
+package caltrans
+
+import (
+	"regexp"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+var (
+	// criticalSeverityRe matches text describing deaths, rollovers or a road
+	// closed outright
+	criticalSeverityRe = regexp.MustCompile(`(?i)\b(?:fatal(?:ity|ities)?|overturn(?:ed)?|rollover|full(?:y)?[\s-]+closed?|full[\s-]+closure|road\s+closed|closed\s+in\s+both\s+directions|hard\s+closure)\b`)
+
+	// infoSeverityRe matches minor events: assists, maintenance, shoulder work
+	infoSeverityRe = regexp.MustCompile(`(?i)\b(?:assist(?:ance)?|maintenance|shoulder\s+(?:closed|closure|work)|single[\s-]+lane)\b`)
+)
+
+// ClassifySeverity is a keyword heuristic for how serious an incident is,
+// used where no AI assessment is available: deaths, rollovers and full
+// closures are CRITICAL; assists, maintenance and single-lane closures are
+// INFO. Returns ALERT_SEVERITY_UNSPECIFIED when the text gives no signal.
+func ClassifySeverity(text string) api.AlertSeverity {
+	lanes := ParseLaneClosure(text)
+	switch {
+	case criticalSeverityRe.MatchString(text), lanes.All:
+		return api.AlertSeverity_CRITICAL
+	case infoSeverityRe.MatchString(text), lanes.Closed == 1:
+		return api.AlertSeverity_INFO
+	default:
+		return api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,37 @@
+package caltrans
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+func TestClassifySeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected api.AlertSeverity
+	}{
+		{"fatal collision", "1183-Trfc Collision-Unkn Inj, 1144-Fatality", api.AlertSeverity_CRITICAL},
+		{"overturned vehicle", "Vehicle overturned in roadway", api.AlertSeverity_CRITICAL},
+		{"fully closed", "SR-4 is fully closed at Pacific Grade", api.AlertSeverity_CRITICAL},
+		{"full closure", "Full closure for rock removal", api.AlertSeverity_CRITICAL},
+		{"all lanes blocked", "VEH BLKG ALL LNS", api.AlertSeverity_CRITICAL},
+		{"both directions", "Highway closed in both directions", api.AlertSeverity_CRITICAL},
+		{"single lane", "Traffic Collision - No Details, BLOCKING 1 LN", api.AlertSeverity_INFO},
+		{"single-lane closure", "Single-lane closure with flaggers", api.AlertSeverity_INFO},
+		{"motorist assist", "1125-Traffic Hazard, motorist assist", api.AlertSeverity_INFO},
+		{"maintenance", "Caltrans maintenance crew on scene", api.AlertSeverity_INFO},
+		{"fatal beats single lane", "Fatal collision, BLOCKING 1 LN", api.AlertSeverity_CRITICAL},
+		{"two lanes closed", "2 of 3 lanes closed eastbound", api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED},
+		{"no signal", "Traffic collision, no injuries", api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifySeverity(tt.input))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package caltrans
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// chpTimestampRe matches CHP log timestamps, e.g. "Sep 11 2025 10:54AM"
+	chpTimestampRe = regexp.MustCompile(`\b([A-Z][a-z]{2})\s+(\d{1,2})\s+(\d{4})\s+(\d{1,2}:\d{2})\s*([AaPp][Mm])\b`)
+
+	// updateStampRe matches the feed's "Last updated: 09/11/2025 11:00am" stamp
+	updateStampRe = regexp.MustCompile(`(?i)last updated:\s*(\d{1,2}/\d{1,2}/\d{4})\s+(\d{1,2}:\d{2})\s*([ap]m)`)
+)
+
+// ParseCHPTimestamps returns when an incident was first reported (the
+// earliest CHP log timestamp) and last updated (the latest log timestamp or
+// "Last updated" stamp) in Pacific time. Either is zero when the text has none.
+func ParseCHPTimestamps(text string) (reported, updated time.Time) {
+	for _, m := range chpTimestampRe.FindAllStringSubmatch(text, -1) {
+		t, err := time.ParseInLocation("Jan 2 2006 3:04PM", m[1]+" "+m[2]+" "+m[3]+" "+m[4]+strings.ToUpper(m[5]), pacific)
+		if err != nil {
+			continue
+		}
+		if reported.IsZero() || t.Before(reported) {
+			reported = t
+		}
+		if t.After(updated) {
+			updated = t
+		}
+	}
+	for _, m := range updateStampRe.FindAllStringSubmatch(text, -1) {
+		t, err := time.ParseInLocation("1/2/2006 3:04pm", m[1]+" "+m[2]+strings.ToLower(m[3]), pacific)
+		if err == nil && t.After(updated) {
+			updated = t
+		}
+	}
+	return reported, updated
+}
@@ -0,0 +1,77 @@
+package caltrans
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCHPTimestamps(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		reported time.Time
+		updated  time.Time
+	}{
+		{
+			"log entries and update stamp",
+			"Sep 11 2025 10:43AM 1179-Trfc Collision-1141 Enrt 7225 Us50 Sep 11 2025 10:59AM [19] EXTREME BACK LL Sep 11 2025 10:43AM [1] VEH O/T ON ROOF Last updated: 09/11/2025 11:00am",
+			time.Date(2025, 9, 11, 10, 43, 0, 0, pacific),
+			time.Date(2025, 9, 11, 11, 0, 0, 0, pacific),
+		},
+		{
+			"log entry after update stamp",
+			"Sep 11 2025 11:02AM [3] RDWY OPEN Last updated: 09/11/2025 11:00am",
+			time.Date(2025, 9, 11, 11, 2, 0, 0, pacific),
+			time.Date(2025, 9, 11, 11, 2, 0, 0, pacific),
+		},
+		{
+			"single digit hour",
+			"Sep 11 2025  8:06AM CZP-Assist with Construction Sr49 / Lauren Ln",
+			time.Date(2025, 9, 11, 8, 6, 0, 0, pacific),
+			time.Date(2025, 9, 11, 8, 6, 0, 0, pacific),
+		},
+		{
+			"afternoon",
+			"Dec 24 2025 4:15PM 1125-Traffic Hazard",
+			time.Date(2025, 12, 24, 16, 15, 0, 0, pacific),
+			time.Date(2025, 12, 24, 16, 15, 0, 0, pacific),
+		},
+		{
+			"update stamp only",
+			"Chain controls in effect Last updated: 12/24/2025 6:30pm",
+			time.Time{},
+			time.Date(2025, 12, 24, 18, 30, 0, 0, pacific),
+		},
+		{"no timestamps", "Traffic collision, no injuries", time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reported, updated := ParseCHPTimestamps(tt.input)
+			assert.True(t, tt.reported.Equal(reported), "reported = %v, want %v", reported, tt.reported)
+			assert.True(t, tt.updated.Equal(updated), "updated = %v, want %v", updated, tt.updated)
+		})
+	}
+}
+
+func TestParseCHPIncidents_Timestamps(t *testing.T) {
+	parser := setupTestParser(t)
+
+	incidents, err := parser.ParseCHPIncidents(context.Background())
+	require.NoError(t, err)
+
+	byName := make(map[string]CaltransIncident, len(incidents))
+	for _, incident := range incidents {
+		assert.False(t, incident.ReportedTime.IsZero(), "%s has no report time", incident.Name)
+		byName[incident.Name] = incident
+	}
+
+	incident, ok := byName["CHP Incident 250911SA0440"]
+	require.True(t, ok, "fixture incident missing")
+	assert.True(t, time.Date(2025, 9, 11, 10, 43, 0, 0, pacific).Equal(incident.ReportedTime), "reported = %v", incident.ReportedTime)
+	assert.True(t, time.Date(2025, 9, 11, 11, 0, 0, 0, pacific).Equal(incident.UpdatedTime), "updated = %v", incident.UpdatedTime)
+}
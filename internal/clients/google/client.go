@@ -10,6 +10,7 @@ import (
 	"time"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // HTTPDoer interface for HTTP clients (for testability)
@@ -23,6 +24,14 @@ type Client struct {
 	apiKey     string
 	httpClient HTTPDoer
 	baseURL    string
+
+	// SpeedReadings requests per-segment traffic speeds along the polyline.
+	// Off by default: it bills the request at the Enterprise SKU.
+	SpeedReadings bool
+
+	// UserAgent is sent with every request; empty uses
+	// upstream.DefaultUserAgent
+	UserAgent string
 }
 
 // RouteData represents the processed route information from Google Routes API
@@ -31,8 +40,22 @@ type RouteData struct {
 	StaticDurationSeconds int32
 	DistanceMeters        int32
 	Polyline              string
+	SpeedReadings         []SpeedReading
+}
+
+// SpeedReading is the traffic speed category ("NORMAL", "SLOW" or
+// "TRAFFIC_JAM") for the polyline from point StartIndex to point EndIndex
+type SpeedReading struct {
+	StartIndex int32
+	EndIndex   int32
+	Speed      string
 }
 
+const (
+	baseFieldMask          = "routes.duration,routes.staticDuration,routes.distanceMeters,routes.polyline.encodedPolyline"
+	speedReadingsFieldMask = ",routes.travelAdvisory.speedReadingIntervals"
+)
+
 // NewClient creates a new Google Routes API client
 func NewClient(apiKey string) *Client {
 	return &Client{
@@ -76,12 +99,17 @@ func (c *Client) ComputeRoutes(ctx context.Context, origin, destination *api.Coo
 		},
 		// TRAFFIC_AWARE_OPTIMAL gives traffic-aware duration (so we can compute
 		// delay = duration - staticDuration). This keeps the request on the Pro
-		// SKU. We deliberately do NOT request extraComputations=TRAFFIC_ON_POLYLINE
-		// / speedReadingIntervals: that data is unused and would bump the request
+		// SKU. We only request extraComputations=TRAFFIC_ON_POLYLINE /
+		// speedReadingIntervals when SpeedReadings is set: that bumps the request
 		// to the much pricier Enterprise SKU (1k vs 5k free/month).
 		"travelMode":        "DRIVE",
 		"routingPreference": "TRAFFIC_AWARE_OPTIMAL",
 	}
+	fieldMask := baseFieldMask
+	if c.SpeedReadings {
+		requestBody["extraComputations"] = []string{"TRAFFIC_ON_POLYLINE"}
+		fieldMask += speedReadingsFieldMask
+	}
 
 	jsonBody, err := json.Marshal(requestBody)
 	if err != nil {
@@ -95,24 +123,25 @@ func (c *Client) ComputeRoutes(ctx context.Context, origin, destination *api.Coo
 	}
 
 	// Critical: Field mask is REQUIRED or API returns errors (research.md line 44)
+	upstream.SetUserAgent(req, c.UserAgent)
 	req.Header.Set("X-Goog-Api-Key", c.apiKey)
-	req.Header.Set("X-Goog-FieldMask", "routes.duration,routes.staticDuration,routes.distanceMeters,routes.polyline.encodedPolyline")
+	req.Header.Set("X-Goog-FieldMask", fieldMask)
 	req.Header.Set("Content-Type", "application/json")
 
 	// Execute request with rate limiting awareness (3K QPM from research.md line 56)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to execute request: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Handle rate limiting and errors per research.md line 57
 	if resp.StatusCode == 429 {
-		return nil, fmt.Errorf("rate limit exceeded (3K QPM)")
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("rate limit exceeded (3K QPM)"))
 	}
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body)))
 	}
 
 	// Parse response
@@ -136,10 +165,23 @@ func (c *Client) processRouteResponse(route GoogleRoute) (*RouteData, error) {
 		return nil, fmt.Errorf("failed to parse duration: %w", err)
 	}
 
-	// Parse static duration (baseline without traffic)
-	staticDurationSeconds, err := parseDuration(route.StaticDuration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse static duration: %w", err)
+	// Parse static duration (baseline without traffic). Google occasionally
+	// omits it; leave it at zero so callers can fall back to speed readings.
+	var staticDurationSeconds int32
+	if route.StaticDuration != "" {
+		staticDurationSeconds, err = parseDuration(route.StaticDuration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse static duration: %w", err)
+		}
+	}
+
+	var readings []SpeedReading
+	for _, interval := range route.TravelAdvisory.SpeedReadingIntervals {
+		readings = append(readings, SpeedReading{
+			StartIndex: interval.StartPolylinePointIndex,
+			EndIndex:   interval.EndPolylinePointIndex,
+			Speed:      interval.Speed,
+		})
 	}
 
 	return &RouteData{
@@ -147,6 +189,7 @@ func (c *Client) processRouteResponse(route GoogleRoute) (*RouteData, error) {
 		StaticDurationSeconds: staticDurationSeconds,
 		DistanceMeters:        route.DistanceMeters,
 		Polyline:              route.Polyline.EncodedPolyline,
+		SpeedReadings:         readings,
 	}, nil
 }
 
@@ -173,10 +216,24 @@ type GoogleRoutesResponse struct {
 
 // GoogleRoute represents a single route in the response
 type GoogleRoute struct {
-	Duration       string         `json:"duration"`
-	StaticDuration string         `json:"staticDuration"`
-	DistanceMeters int32          `json:"distanceMeters"`
-	Polyline       GooglePolyline `json:"polyline"`
+	Duration       string               `json:"duration"`
+	StaticDuration string               `json:"staticDuration"`
+	DistanceMeters int32                `json:"distanceMeters"`
+	Polyline       GooglePolyline       `json:"polyline"`
+	TravelAdvisory GoogleTravelAdvisory `json:"travelAdvisory"`
+}
+
+// GoogleTravelAdvisory carries traffic details, populated only when
+// TRAFFIC_ON_POLYLINE is requested
+type GoogleTravelAdvisory struct {
+	SpeedReadingIntervals []GoogleSpeedReadingInterval `json:"speedReadingIntervals"`
+}
+
+// GoogleSpeedReadingInterval is the traffic speed over a polyline span
+type GoogleSpeedReadingInterval struct {
+	StartPolylinePointIndex int32  `json:"startPolylinePointIndex"`
+	EndPolylinePointIndex   int32  `json:"endPolylinePointIndex"`
+	Speed                   string `json:"speed"`
 }
 
 // GooglePolyline represents the route polyline
@@ -2,6 +2,7 @@ package google
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // MockHTTPDoer is a mock implementation of HTTPDoer
@@ -262,3 +264,107 @@ func TestComputeRoutes_InvalidJSON(t *testing.T) {
 
 	mockHTTP.AssertExpectations(t)
 }
+
+func TestComputeRoutes_SpeedReadings(t *testing.T) {
+	var capturedRequest *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedRequest = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, loadTestFixture(t, "seattle_portland_20250912_194108.json")), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+	client.SpeedReadings = true
+
+	origin := &api.Coordinates{Latitude: 47.6062, Longitude: -122.3321}
+	destination := &api.Coordinates{Latitude: 45.5152, Longitude: -122.6784}
+
+	routeData, err := client.ComputeRoutes(context.Background(), origin, destination)
+	require.NoError(t, err)
+
+	// Opting in requests traffic-on-polyline and the speed intervals
+	assert.Contains(t, capturedRequest.Header.Get("X-Goog-FieldMask"), "routes.travelAdvisory.speedReadingIntervals")
+	body, err := io.ReadAll(capturedRequest.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "TRAFFIC_ON_POLYLINE")
+
+	require.Len(t, routeData.SpeedReadings, 17)
+	assert.Equal(t, SpeedReading{StartIndex: 1, EndIndex: 2, Speed: "SLOW"}, routeData.SpeedReadings[1])
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestComputeRoutes_MissingStaticDuration(t *testing.T) {
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(
+		createMockResponse(200, loadTestFixture(t, "traffic_jam_no_static_duration.json")), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+
+	origin := &api.Coordinates{Latitude: 47.6062, Longitude: -122.3321}
+	destination := &api.Coordinates{Latitude: 45.5152, Longitude: -122.6784}
+
+	routeData, err := client.ComputeRoutes(context.Background(), origin, destination)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3120), routeData.DurationSeconds)
+	assert.Equal(t, int32(0), routeData.StaticDurationSeconds)
+	assert.Len(t, routeData.SpeedReadings, 4)
+}
+
+func TestComputeRoutes_ErrorTypes(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{429, upstream.ErrRateLimited},
+		{401, upstream.ErrUnauthorized},
+		{403, upstream.ErrUnauthorized},
+		{503, upstream.ErrUpstreamUnavailable},
+	}
+	for _, tt := range tests {
+		mockHTTP := &MockHTTPDoer{}
+		mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(
+			createMockResponse(tt.status, `{"error": {}}`), nil)
+		client := NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+
+		_, err := client.ComputeRoutes(context.Background(),
+			&api.Coordinates{Latitude: 47.6062, Longitude: -122.3321},
+			&api.Coordinates{Latitude: 45.5152, Longitude: -122.6784})
+		assert.ErrorIs(t, err, tt.want, "status %d", tt.status)
+	}
+
+	// A request that never got a response is unavailable too
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return((*http.Response)(nil), errors.New("connection refused"))
+	client := NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+	_, err := client.ComputeRoutes(context.Background(), &api.Coordinates{}, &api.Coordinates{})
+	assert.ErrorIs(t, err, upstream.ErrUpstreamUnavailable)
+
+	// A bad request is none of the above
+	mockHTTP = &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(createMockResponse(400, `{}`), nil)
+	client = NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+	_, err = client.ComputeRoutes(context.Background(), &api.Coordinates{}, &api.Coordinates{})
+	assert.False(t, upstream.Transient(err) || errors.Is(err, upstream.ErrUnauthorized), "400 classified as %v", err)
+}
+
+func TestUserAgent(t *testing.T) {
+	fixtureData := loadTestFixture(t, "seattle_portland.json")
+	for _, userAgent := range []string{"", "ersn-staging (ops@example.org)"} {
+		want := userAgent
+		if want == "" {
+			want = upstream.DefaultUserAgent()
+		}
+		mockHTTP := &MockHTTPDoer{}
+		mockHTTP.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.Header.Get("User-Agent") == want
+		})).Return(createMockResponse(200, fixtureData), nil)
+
+		client := NewClientWithHTTPDoer("test-api-key", "https://routes.googleapis.com", mockHTTP)
+		client.UserAgent = userAgent
+		_, err := client.ComputeRoutes(context.Background(),
+			&api.Coordinates{Latitude: 47.6062, Longitude: -122.3321},
+			&api.Coordinates{Latitude: 45.5152, Longitude: -122.6784})
+		require.NoError(t, err)
+		mockHTTP.AssertExpectations(t)
+	}
+}
@@ -1,6 +1,7 @@
 // Package nws provides a client for the National Weather Service (api.weather.gov)
-// public alerts API. It is the authoritative source for zone-based watches and
-// warnings (including fire-weather products) for the ERSN service area.
+// public API. It is the authoritative source for zone-based watches and
+// warnings (including fire-weather products) for the ERSN service area, and a
+// keyless alternative to OpenWeatherMap for current conditions and forecasts.
 //
 // The NWS API requires no API key but does require a descriptive User-Agent
 // identifying the application (https://www.weather.gov/documentation/services-web-api).
@@ -9,13 +10,14 @@ package nws
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // HTTPDoer interface for HTTP clients (for testability).
@@ -23,18 +25,21 @@ type HTTPDoer interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
-// Client provides access to the NWS active-alerts API.
+// Client provides access to the NWS alerts, observations and forecast APIs.
 type Client struct {
 	httpClient HTTPDoer
 	baseURL    string
 	userAgent  string
+
+	pointsMu sync.Mutex
+	points   map[string]point // Forecast office URLs by rounded coordinates
 }
 
 // NewClient creates a new NWS client. userAgent should identify the app and
 // include a contact, e.g. "info.ersn.net (contact@ersn.net)".
 func NewClient(userAgent string) *Client {
 	if userAgent == "" {
-		userAgent = "info.ersn.net"
+		userAgent = upstream.DefaultUserAgent()
 	}
 	return &Client{
 		httpClient: &http.Client{Timeout: 30 * time.Second},
@@ -47,7 +52,7 @@ func NewClient(userAgent string) *Client {
 // (for testing).
 func NewClientWithHTTPDoer(userAgent, baseURL string, httpClient HTTPDoer) *Client {
 	if userAgent == "" {
-		userAgent = "info.ersn.net"
+		userAgent = upstream.DefaultUserAgent()
 	}
 	return &Client{
 		httpClient: httpClient,
@@ -86,27 +91,9 @@ func (c *Client) GetActiveZoneAlerts(ctx context.Context, zones []string) ([]Ale
 	params.Set("status", "actual")
 	requestURL := fmt.Sprintf("%s/alerts/active?%s", c.baseURL, params.Encode())
 
-	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create NWS request: %w", err)
-	}
-	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Accept", "application/geo+json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute NWS request: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("NWS API error %d: %s", resp.StatusCode, string(body))
-	}
-
 	var parsed alertsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
-		return nil, fmt.Errorf("failed to decode NWS response: %w", err)
+	if err := c.getJSON(ctx, requestURL, &parsed); err != nil {
+		return nil, err
 	}
 
 	return parsed.toAlerts(), nil
@@ -0,0 +1,308 @@
+package nws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Observation is the latest reading from the observation station nearest a
+// point. Readings the station didn't report are nil.
+type Observation struct {
+	StationID        string
+	City             string // Nearest place to the point, e.g. "Murphys"
+	Time             time.Time
+	Description      string // e.g. "Mostly Cloudy"
+	TemperatureC     *float64
+	DewpointC        *float64
+	WindChillC       *float64
+	HeatIndexC       *float64
+	HumidityPercent  *float64
+	WindSpeedKmh     *float64
+	WindGustKmh      *float64
+	WindDirectionDeg *float64
+	VisibilityMeters *float64
+}
+
+// ForecastPeriod is one period of an NWS forecast: an hour, or a 12-hour day
+// or night
+type ForecastPeriod struct {
+	Name                 string // e.g. "Tonight", "Wednesday"; empty for hourly periods
+	Start                time.Time
+	End                  time.Time
+	IsDaytime            bool
+	TemperatureC         float64
+	PrecipitationPercent int32
+	WindSpeedKmh         int32  // The upper end when NWS gives a range
+	WindDirection        string // Compass point, e.g. "SW"
+	ShortForecast        string // e.g. "Chance Rain And Snow"
+	DetailedForecast     string // Empty for hourly periods
+}
+
+// point is the forecast office metadata NWS resolves a location to
+type point struct {
+	forecast            string
+	forecastHourly      string
+	observationStations string
+	forecastZone        string // e.g. "CAZ069"
+	city                string
+}
+
+// GetLatestObservation returns the latest observation from the station
+// nearest the coordinates
+func (c *Client) GetLatestObservation(ctx context.Context, latitude, longitude float64) (*Observation, error) {
+	p, err := c.point(ctx, latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	var stations struct {
+		ObservationStations []string `json:"observationStations"`
+	}
+	if err := c.getJSON(ctx, p.observationStations, &stations); err != nil {
+		return nil, err
+	}
+	if len(stations.ObservationStations) == 0 {
+		return nil, fmt.Errorf("no NWS observation stations near %.4f,%.4f", latitude, longitude)
+	}
+	station := stations.ObservationStations[0]
+
+	var response struct {
+		Properties observationProperties `json:"properties"`
+	}
+	if err := c.getJSON(ctx, station+"/observations/latest", &response); err != nil {
+		return nil, err
+	}
+	o := response.Properties
+	return &Observation{
+		StationID:        station[strings.LastIndex(station, "/")+1:],
+		City:             p.city,
+		Time:             parseTime(o.Timestamp),
+		Description:      o.TextDescription,
+		TemperatureC:     o.Temperature.celsius(),
+		DewpointC:        o.Dewpoint.celsius(),
+		WindChillC:       o.WindChill.celsius(),
+		HeatIndexC:       o.HeatIndex.celsius(),
+		HumidityPercent:  o.RelativeHumidity.Value,
+		WindSpeedKmh:     o.WindSpeed.kmh(),
+		WindGustKmh:      o.WindGust.kmh(),
+		WindDirectionDeg: o.WindDirection.Value,
+		VisibilityMeters: o.Visibility.Value,
+	}, nil
+}
+
+// GetForecast returns the forecast for the coordinates in metric units:
+// hourly periods when hourly is set, else 12-hour day and night periods
+func (c *Client) GetForecast(ctx context.Context, latitude, longitude float64, hourly bool) ([]ForecastPeriod, error) {
+	p, err := c.point(ctx, latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+	forecastURL := p.forecast
+	if hourly {
+		forecastURL = p.forecastHourly
+	}
+
+	var response struct {
+		Properties struct {
+			Periods []forecastPeriod `json:"periods"`
+		} `json:"properties"`
+	}
+	if err := c.getJSON(ctx, forecastURL+"?units=si", &response); err != nil {
+		return nil, err
+	}
+
+	periods := make([]ForecastPeriod, 0, len(response.Properties.Periods))
+	for _, fp := range response.Properties.Periods {
+		temperature := fp.Temperature
+		if fp.TemperatureUnit == "F" {
+			temperature = (temperature - 32) * 5 / 9
+		}
+		period := ForecastPeriod{
+			Name:             fp.Name,
+			Start:            parseTime(fp.StartTime),
+			End:              parseTime(fp.EndTime),
+			IsDaytime:        fp.IsDaytime,
+			TemperatureC:     temperature,
+			WindSpeedKmh:     parseWindSpeed(fp.WindSpeed),
+			WindDirection:    fp.WindDirection,
+			ShortForecast:    fp.ShortForecast,
+			DetailedForecast: fp.DetailedForecast,
+		}
+		if fp.ProbabilityOfPrecipitation.Value != nil {
+			period.PrecipitationPercent = int32(math.Round(*fp.ProbabilityOfPrecipitation.Value))
+		}
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+// GetPointAlerts returns the active alerts covering the coordinates
+func (c *Client) GetPointAlerts(ctx context.Context, latitude, longitude float64) ([]Alert, error) {
+	params := url.Values{}
+	params.Set("point", formatCoordinate(latitude)+","+formatCoordinate(longitude))
+	params.Set("status", "actual")
+
+	var parsed alertsResponse
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/alerts/active?%s", c.baseURL, params.Encode()), &parsed); err != nil {
+		return nil, err
+	}
+	return parsed.toAlerts(), nil
+}
+
+// point resolves coordinates to their forecast URLs and zone. The mapping only
+// changes when NWS redraws its grids, so it is looked up once per location.
+func (c *Client) point(ctx context.Context, latitude, longitude float64) (point, error) {
+	key := formatCoordinate(latitude) + "," + formatCoordinate(longitude)
+
+	c.pointsMu.Lock()
+	p, ok := c.points[key]
+	c.pointsMu.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	var response struct {
+		Properties struct {
+			Forecast            string `json:"forecast"`
+			ForecastHourly      string `json:"forecastHourly"`
+			ObservationStations string `json:"observationStations"`
+			ForecastZone        string `json:"forecastZone"`
+			RelativeLocation    struct {
+				Properties struct {
+					City string `json:"city"`
+				} `json:"properties"`
+			} `json:"relativeLocation"`
+		} `json:"properties"`
+	}
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/points/%s", c.baseURL, key), &response); err != nil {
+		return point{}, err
+	}
+	props := response.Properties
+	if props.Forecast == "" || props.ForecastHourly == "" || props.ObservationStations == "" {
+		return point{}, errors.New("NWS points response is missing forecast or station URLs")
+	}
+	p = point{
+		forecast:            props.Forecast,
+		forecastHourly:      props.ForecastHourly,
+		observationStations: props.ObservationStations,
+		forecastZone:        props.ForecastZone[strings.LastIndex(props.ForecastZone, "/")+1:],
+		city:                props.RelativeLocation.Properties.City,
+	}
+
+	c.pointsMu.Lock()
+	if c.points == nil {
+		c.points = make(map[string]point)
+	}
+	c.points[key] = p
+	c.pointsMu.Unlock()
+	return p, nil
+}
+
+// getJSON fetches an NWS resource and decodes it into out
+func (c *Client) getJSON(ctx context.Context, requestURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create NWS request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/geo+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute NWS request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("NWS API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode NWS response: %w", err)
+	}
+	return nil
+}
+
+// formatCoordinate rounds to the 4 decimals NWS accepts, without trailing
+// zeros (NWS redirects requests that have them)
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'f', -1, 64)
+}
+
+// parseWindSpeed reads the upper end of an NWS wind speed such as "10 km/h"
+// or "5 to 15 km/h"
+func parseWindSpeed(s string) int32 {
+	var speed int32
+	for _, field := range strings.Fields(s) {
+		if v, err := strconv.Atoi(field); err == nil && int32(v) > speed {
+			speed = int32(v)
+		}
+	}
+	if strings.HasSuffix(s, "mph") {
+		speed = int32(math.Round(float64(speed) * 1.609344))
+	}
+	return speed
+}
+
+// JSON-LD observation and forecast types (only the fields we use)
+type observationProperties struct {
+	Timestamp        string   `json:"timestamp"`
+	TextDescription  string   `json:"textDescription"`
+	Temperature      quantity `json:"temperature"`
+	Dewpoint         quantity `json:"dewpoint"`
+	WindChill        quantity `json:"windChill"`
+	HeatIndex        quantity `json:"heatIndex"`
+	RelativeHumidity quantity `json:"relativeHumidity"`
+	WindSpeed        quantity `json:"windSpeed"`
+	WindGust         quantity `json:"windGust"`
+	WindDirection    quantity `json:"windDirection"`
+	Visibility       quantity `json:"visibility"`
+}
+
+// quantity is an NWS measurement; Value is null when not reported
+type quantity struct {
+	UnitCode string   `json:"unitCode"`
+	Value    *float64 `json:"value"`
+}
+
+// celsius returns a temperature in Celsius, or nil if not reported
+func (q quantity) celsius() *float64 {
+	if q.Value == nil || q.UnitCode != "wmoUnit:degF" {
+		return q.Value
+	}
+	c := (*q.Value - 32) * 5 / 9
+	return &c
+}
+
+// kmh returns a speed in km/h, or nil if not reported
+func (q quantity) kmh() *float64 {
+	if q.Value == nil || q.UnitCode != "wmoUnit:m_s-1" {
+		return q.Value
+	}
+	kmh := *q.Value * 3.6
+	return &kmh
+}
+
+type forecastPeriod struct {
+	Name                       string   `json:"name"`
+	StartTime                  string   `json:"startTime"`
+	EndTime                    string   `json:"endTime"`
+	IsDaytime                  bool     `json:"isDaytime"`
+	Temperature                float64  `json:"temperature"`
+	TemperatureUnit            string   `json:"temperatureUnit"`
+	ProbabilityOfPrecipitation quantity `json:"probabilityOfPrecipitation"`
+	WindSpeed                  string   `json:"windSpeed"`
+	WindDirection              string   `json:"windDirection"`
+	ShortForecast              string   `json:"shortForecast"`
+	DetailedForecast           string   `json:"detailedForecast"`
+}
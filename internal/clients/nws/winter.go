@@ -0,0 +1,65 @@
+package nws
+
+import (
+	"context"
+	"fmt"
+)
+
+// winterEvents are the NWS products for snow and ice, the hazards that bring
+// chain control to the passes
+var winterEvents = map[string]bool{
+	"Winter Storm Warning":    true,
+	"Winter Storm Watch":      true,
+	"Winter Weather Advisory": true,
+	"Blizzard Warning":        true,
+	"Ice Storm Warning":       true,
+	"Freezing Rain Advisory":  true,
+	"Snow Squall Warning":     true,
+}
+
+// IsWinterProduct reports whether event names an NWS winter weather product
+func IsWinterProduct(event string) bool {
+	return winterEvents[event]
+}
+
+// WinterAlerts returns the winter products among alerts. If zones is
+// non-empty, only alerts intersecting those zones are kept.
+func WinterAlerts(alerts []Alert, zones []string) []Alert {
+	zoneSet := make(map[string]bool)
+	for _, z := range cleanZones(zones) {
+		zoneSet[z] = true
+	}
+
+	var winter []Alert
+	for i := range alerts {
+		a := &alerts[i]
+		if !IsWinterProduct(a.Event) || (len(zoneSet) > 0 && !alertIntersectsZones(a, zoneSet)) {
+			continue
+		}
+		winter = append(winter, *a)
+	}
+	return winter
+}
+
+// GetWinterAlerts returns the active winter products for the given zone
+// codes. An empty zone list returns no alerts.
+func (c *Client) GetWinterAlerts(ctx context.Context, zones []string) ([]Alert, error) {
+	alerts, err := c.GetActiveZoneAlerts(ctx, zones)
+	if err != nil {
+		return nil, err
+	}
+	return WinterAlerts(alerts, zones), nil
+}
+
+// GetForecastZone returns the forecast zone (e.g. "CAZ069") containing the
+// coordinates, the unit NWS issues winter products for
+func (c *Client) GetForecastZone(ctx context.Context, latitude, longitude float64) (string, error) {
+	p, err := c.point(ctx, latitude, longitude)
+	if err != nil {
+		return "", err
+	}
+	if p.forecastZone == "" {
+		return "", fmt.Errorf("no NWS forecast zone for %.4f,%.4f", latitude, longitude)
+	}
+	return p.forecastZone, nil
+}
@@ -0,0 +1,92 @@
+package nws
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const winterGeoJSON = `{
+  "features": [
+    {
+      "properties": {
+        "id": "urn:oid:2.49.0.1.840.0.wsw",
+        "event": "Winter Storm Warning",
+        "severity": "Severe",
+        "headline": "Winter Storm Warning until 4 AM PST Friday",
+        "description": "Heavy snow above 4000 feet. Chain controls likely.",
+        "senderName": "NWS Sacramento CA",
+        "geocode": { "UGC": ["CAZ069"] }
+      }
+    },
+    {
+      "properties": {
+        "id": "urn:oid:2.49.0.1.840.0.wwy",
+        "event": "Winter Weather Advisory",
+        "severity": "Moderate",
+        "headline": "Winter Weather Advisory",
+        "senderName": "NWS Reno NV",
+        "geocode": { "UGC": ["CAZ072"] }
+      }
+    },
+    {
+      "properties": {
+        "id": "urn:oid:2.49.0.1.840.0.rfw",
+        "event": "Red Flag Warning",
+        "severity": "Severe",
+        "headline": "Red Flag Warning",
+        "senderName": "NWS Sacramento CA",
+        "geocode": { "UGC": ["CAZ069"] }
+      }
+    },
+    {
+      "properties": {
+        "id": "urn:oid:2.49.0.1.840.0.heat",
+        "event": "Heat Advisory",
+        "severity": "Moderate",
+        "headline": "Heat Advisory",
+        "senderName": "NWS Sacramento CA",
+        "geocode": { "UGC": ["CAZ258"] }
+      }
+    }
+  ]
+}`
+
+func TestGetWinterAlerts(t *testing.T) {
+	doer := &fakeDoer{resp: winterGeoJSON}
+	c := NewClientWithHTTPDoer("test-agent", "https://nws.test", doer)
+
+	alerts, err := c.GetWinterAlerts(context.Background(), []string{"caz069"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected 1 winter alert, got %d: %+v", len(alerts), alerts)
+	}
+	if alerts[0].Event != "Winter Storm Warning" || alerts[0].ID != "urn:oid:2.49.0.1.840.0.wsw" {
+		t.Errorf("got %q (%s), want the Winter Storm Warning", alerts[0].Event, alerts[0].ID)
+	}
+	if !strings.Contains(doer.lastURL, "zone=CAZ069") {
+		t.Errorf("URL missing zone param: %q", doer.lastURL)
+	}
+}
+
+func TestGetForecastZone(t *testing.T) {
+	doer := &fakeDoer{resp: `{"properties": {
+		"forecast": "https://nws.test/gridpoints/STO/80,43/forecast",
+		"forecastHourly": "https://nws.test/gridpoints/STO/80,43/forecast/hourly",
+		"observationStations": "https://nws.test/gridpoints/STO/80,43/stations",
+		"forecastZone": "https://nws.test/zones/forecast/CAZ069"}}`}
+	c := NewClientWithHTTPDoer("test-agent", "https://nws.test", doer)
+
+	zone, err := c.GetForecastZone(context.Background(), 38.4610, -120.0424)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zone != "CAZ069" {
+		t.Errorf("zone = %q, want CAZ069", zone)
+	}
+	if doer.lastURL != "https://nws.test/points/38.461,-120.0424" {
+		t.Errorf("URL = %q, want the points lookup without trailing zeros", doer.lastURL)
+	}
+}
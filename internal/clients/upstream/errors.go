@@ -0,0 +1,62 @@
+// Package upstream defines the errors the external API clients return, so
+// callers can branch with errors.Is instead of matching error text.
+package upstream
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrRateLimited matches upstream 429 responses
+	ErrRateLimited = errors.New("upstream rate limit exceeded")
+
+	// ErrUnauthorized matches upstream 401/403 responses, usually a missing or
+	// invalid API key
+	ErrUnauthorized = errors.New("upstream rejected credentials")
+
+	// ErrUpstreamUnavailable matches upstream 5xx responses and requests that
+	// never got a response
+	ErrUpstreamUnavailable = errors.New("upstream unavailable")
+)
+
+// StatusError is a failed upstream request. It matches ErrRateLimited,
+// ErrUnauthorized or ErrUpstreamUnavailable under errors.Is according to
+// StatusCode, while keeping the client's own message.
+type StatusError struct {
+	StatusCode int // HTTP status, 0 when the request never got a response
+	Err        error
+}
+
+// NewStatusError wraps err as a failed request with the given HTTP status
+func NewStatusError(statusCode int, err error) *StatusError {
+	return &StatusError{StatusCode: statusCode, Err: err}
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the status falls in target's category
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrUpstreamUnavailable:
+		return e.StatusCode == 0 || e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+// Transient reports whether err is worth retrying later: rate limiting or an
+// unavailable upstream
+func Transient(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUpstreamUnavailable)
+}
@@ -0,0 +1,52 @@
+package upstream
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestStatusError_Is(t *testing.T) {
+	tests := []struct {
+		status       int
+		rateLimited  bool
+		unauthorized bool
+		unavailable  bool
+	}{
+		{0, false, false, true},
+		{400, false, false, false},
+		{401, false, true, false},
+		{403, false, true, false},
+		{404, false, false, false},
+		{429, true, false, false},
+		{500, false, false, true},
+		{503, false, false, true},
+	}
+	for _, tt := range tests {
+		// Wrapped the way callers see it
+		err := fmt.Errorf("failed to compute routes: %w", NewStatusError(tt.status, fmt.Errorf("API error %d", tt.status)))
+		if got := errors.Is(err, ErrRateLimited); got != tt.rateLimited {
+			t.Errorf("status %d: Is(ErrRateLimited) = %v", tt.status, got)
+		}
+		if got := errors.Is(err, ErrUnauthorized); got != tt.unauthorized {
+			t.Errorf("status %d: Is(ErrUnauthorized) = %v", tt.status, got)
+		}
+		if got := errors.Is(err, ErrUpstreamUnavailable); got != tt.unavailable {
+			t.Errorf("status %d: Is(ErrUpstreamUnavailable) = %v", tt.status, got)
+		}
+		if got := Transient(err); got != (tt.rateLimited || tt.unavailable) {
+			t.Errorf("status %d: Transient = %v", tt.status, got)
+		}
+	}
+}
+
+func TestStatusError_KeepsMessageAndCause(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := NewStatusError(0, fmt.Errorf("failed to execute request: %w", cause))
+	if err.Error() != "failed to execute request: connection refused" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Error("StatusError should unwrap to its cause")
+	}
+}
@@ -0,0 +1,23 @@
+package upstream
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/dpup/info.ersn.net/server/internal/buildinfo"
+)
+
+// DefaultUserAgent identifies the server and its version to upstream APIs,
+// e.g. "info.ersn.net/v1.4.0 (+https://info.ersn.net)"
+var DefaultUserAgent = sync.OnceValue(func() string {
+	return "info.ersn.net/" + buildinfo.Get().Version + " (+https://info.ersn.net)"
+})
+
+// SetUserAgent sets req's User-Agent to userAgent, or DefaultUserAgent when
+// userAgent is empty
+func SetUserAgent(req *http.Request, userAgent string) {
+	if userAgent == "" {
+		userAgent = DefaultUserAgent()
+	}
+	req.Header.Set("User-Agent", userAgent)
+}
@@ -0,0 +1,28 @@
+package upstream
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestSetUserAgent(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://quickmap.dot.ca.gov/data/cc.kml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetUserAgent(req, "")
+	got := req.Header.Get("User-Agent")
+	if got != DefaultUserAgent() {
+		t.Errorf("User-Agent = %q, want the default %q", got, DefaultUserAgent())
+	}
+	if !regexp.MustCompile(`^info\.ersn\.net/\S+ \(\+https://info\.ersn\.net\)$`).MatchString(got) {
+		t.Errorf("default User-Agent %q doesn't identify info.ersn.net and its version", got)
+	}
+
+	SetUserAgent(req, "ersn-staging (ops@example.org)")
+	if got := req.Header.Get("User-Agent"); got != "ersn-staging (ops@example.org)" {
+		t.Errorf("User-Agent = %q, want the configured one", got)
+	}
+}
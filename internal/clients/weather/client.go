@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // HTTPDoer interface for HTTP clients (for testability)
@@ -25,6 +29,10 @@ type Client struct {
 	apiKey     string
 	httpClient HTTPDoer
 	baseURL    string
+
+	// UserAgent is sent with every request; empty uses
+	// upstream.DefaultUserAgent
+	UserAgent string
 }
 
 // NewClient creates a new OpenWeatherMap API client
@@ -47,15 +55,48 @@ func NewClientWithHTTPDoer(apiKey, baseURL string, httpClient HTTPDoer) *Client
 	}
 }
 
-// GetCurrentWeather retrieves current weather conditions for coordinates
+// Units selects the unit system OpenWeatherMap reports values in
+type Units string
+
+const (
+	UnitsMetric   Units = "metric"   // Celsius, m/s
+	UnitsImperial Units = "imperial" // Fahrenheit, mph
+	UnitsStandard Units = "standard" // Kelvin, m/s
+)
+
+// ParseUnits validates a units string, defaulting to metric when empty
+func ParseUnits(units string) (Units, error) {
+	switch u := Units(strings.ToLower(units)); u {
+	case "":
+		return UnitsMetric, nil
+	case UnitsMetric, UnitsImperial, UnitsStandard:
+		return u, nil
+	default:
+		return "", fmt.Errorf("unsupported units %q (want metric, imperial or standard)", units)
+	}
+}
+
+// GetCurrentWeather retrieves current weather conditions for coordinates in metric units
 // Endpoint per research.md line 92
 func (c *Client) GetCurrentWeather(ctx context.Context, coordinates *api.Coordinates) (*api.WeatherData, error) {
+	return c.GetCurrentWeatherInUnits(ctx, coordinates, UnitsMetric)
+}
+
+// GetCurrentWeatherInUnits retrieves current weather conditions with the
+// unrounded values (Temperature, FeelsLike, WindSpeed) in the requested units.
+// The *Celsius / *Kmh fields are always metric.
+func (c *Client) GetCurrentWeatherInUnits(ctx context.Context, coordinates *api.Coordinates, units Units) (*api.WeatherData, error) {
+	units, err := ParseUnits(string(units))
+	if err != nil {
+		return nil, err
+	}
+
 	// Build URL with query parameters
 	params := url.Values{}
 	params.Set("lat", fmt.Sprintf("%.6f", coordinates.Latitude))
 	params.Set("lon", fmt.Sprintf("%.6f", coordinates.Longitude))
 	params.Set("appid", c.apiKey)
-	params.Set("units", "metric") // Get temperature in Celsius
+	params.Set("units", string(units))
 
 	requestURL := fmt.Sprintf("%s/data/2.5/weather?%s", c.baseURL, params.Encode())
 
@@ -64,24 +105,25 @@ func (c *Client) GetCurrentWeather(ctx context.Context, coordinates *api.Coordin
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	upstream.SetUserAgent(req, c.UserAgent)
 
 	// Execute request with rate limiting awareness (60/minute from research.md line 99)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to execute request: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Handle rate limiting and errors per research.md line 100
 	if resp.StatusCode == 429 {
-		return nil, fmt.Errorf("rate limit exceeded (60/minute)")
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("rate limit exceeded (60/minute)"))
 	}
 	if resp.StatusCode == 401 {
-		return nil, fmt.Errorf("invalid API key")
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("invalid API key"))
 	}
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body)))
 	}
 
 	// Parse response
@@ -90,7 +132,7 @@ func (c *Client) GetCurrentWeather(ctx context.Context, coordinates *api.Coordin
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return c.processCurrentWeatherResponse(response)
+	return c.processCurrentWeatherResponse(response, units)
 }
 
 // GetWeatherAlerts retrieves weather alerts using One Call API 3.0
@@ -109,19 +151,20 @@ func (c *Client) GetWeatherAlerts(ctx context.Context, coordinates *api.Coordina
 	if err != nil {
 		return nil, fmt.Errorf("failed to create alerts request: %w", err)
 	}
+	upstream.SetUserAgent(req, c.UserAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute alerts request: %w", err)
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to execute alerts request: %w", err))
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode == 429 {
-		return nil, fmt.Errorf("rate limit exceeded (60/minute)")
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("rate limit exceeded (60/minute)"))
 	}
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("alerts API error %d: %s", resp.StatusCode, string(body))
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("alerts API error %d: %s", resp.StatusCode, string(body)))
 	}
 
 	var response OpenWeatherOneCallResponse
@@ -132,9 +175,145 @@ func (c *Client) GetWeatherAlerts(ctx context.Context, coordinates *api.Coordina
 	return c.processWeatherAlerts(response.Alerts)
 }
 
+// ForecastOptions selects which One Call forecast blocks GetForecast requests.
+// When neither is set both are returned.
+type ForecastOptions struct {
+	Hourly bool // 48 one-hour periods
+	Daily  bool // 8 one-day periods
+}
+
+// GetForecast retrieves the hourly and/or daily forecast using One Call API 3.0
+func (c *Client) GetForecast(ctx context.Context, coordinates *api.Coordinates, opts ForecastOptions) (*api.WeatherForecast, error) {
+	if !opts.Hourly && !opts.Daily {
+		opts.Hourly, opts.Daily = true, true
+	}
+
+	// Exclude every block we don't need to keep the payload small
+	exclude := []string{"current", "minutely", "alerts"}
+	if !opts.Hourly {
+		exclude = append(exclude, "hourly")
+	}
+	if !opts.Daily {
+		exclude = append(exclude, "daily")
+	}
+
+	params := url.Values{}
+	params.Set("lat", fmt.Sprintf("%.6f", coordinates.Latitude))
+	params.Set("lon", fmt.Sprintf("%.6f", coordinates.Longitude))
+	params.Set("appid", c.apiKey)
+	params.Set("units", "metric") // Get temperature in Celsius
+	params.Set("exclude", strings.Join(exclude, ","))
+
+	requestURL := fmt.Sprintf("%s/data/3.0/onecall?%s", c.baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create forecast request: %w", err)
+	}
+	upstream.SetUserAgent(req, c.UserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to execute forecast request: %w", err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 429 {
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("rate limit exceeded (60/minute)"))
+	}
+	if resp.StatusCode == 401 {
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("invalid API key"))
+	}
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("forecast API error %d: %s", resp.StatusCode, string(body)))
+	}
+
+	var response OpenWeatherOneCallResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode forecast response: %w", err)
+	}
+
+	return c.processForecastResponse(response, opts), nil
+}
+
+// GetAirQuality retrieves current air quality using the Air Pollution API.
+// Unlike One Call this lives under /data/2.5 and takes no units parameter
+// (concentrations are always μg/m³).
+func (c *Client) GetAirQuality(ctx context.Context, coordinates *api.Coordinates) (*api.AirQuality, error) {
+	params := url.Values{}
+	params.Set("lat", fmt.Sprintf("%.6f", coordinates.Latitude))
+	params.Set("lon", fmt.Sprintf("%.6f", coordinates.Longitude))
+	params.Set("appid", c.apiKey)
+
+	requestURL := fmt.Sprintf("%s/data/2.5/air_pollution?%s", c.baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create air quality request: %w", err)
+	}
+	upstream.SetUserAgent(req, c.UserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, upstream.NewStatusError(0, fmt.Errorf("failed to execute air quality request: %w", err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == 429 {
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("rate limit exceeded (60/minute)"))
+	}
+	if resp.StatusCode == 401 {
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("invalid API key"))
+	}
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, upstream.NewStatusError(resp.StatusCode, fmt.Errorf("air quality API error %d: %s", resp.StatusCode, string(body)))
+	}
+
+	var response OpenWeatherAirPollutionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode air quality response: %w", err)
+	}
+	if len(response.List) == 0 {
+		return nil, fmt.Errorf("air quality response contained no readings")
+	}
+
+	reading := response.List[0]
+	airQuality := &api.AirQuality{
+		Aqi:      reading.Main.AQI,
+		AqiLabel: aqiLabel(reading.Main.AQI),
+		Pm25:     reading.Components.PM25,
+		Pm10:     reading.Components.PM10,
+		Ozone:    reading.Components.O3,
+	}
+	if reading.Dt > 0 {
+		airQuality.MeasuredAt = timestamppb.New(time.Unix(reading.Dt, 0).UTC())
+	}
+	return airQuality, nil
+}
+
+// aqiLabel names OpenWeatherMap's 1-5 air quality index
+func aqiLabel(aqi int32) string {
+	switch aqi {
+	case 1:
+		return "Good"
+	case 2:
+		return "Fair"
+	case 3:
+		return "Moderate"
+	case 4:
+		return "Poor"
+	case 5:
+		return "Very Poor"
+	default:
+		return ""
+	}
+}
+
 // processCurrentWeatherResponse converts OpenWeatherMap response to our WeatherData format
 // Mapping per data-model.md lines 123-146
-func (c *Client) processCurrentWeatherResponse(response OpenWeatherCurrentResponse) (*api.WeatherData, error) {
+func (c *Client) processCurrentWeatherResponse(response OpenWeatherCurrentResponse, units Units) (*api.WeatherData, error) {
 	// Extract primary weather condition
 	var weatherMain, weatherDescription, weatherIcon string
 	if len(response.Weather) > 0 {
@@ -143,20 +322,118 @@ func (c *Client) processCurrentWeatherResponse(response OpenWeatherCurrentRespon
 		weatherIcon = response.Weather[0].Icon
 	}
 
-	return &api.WeatherData{
+	data := &api.WeatherData{
 		LocationId:           "", // Will be set by calling service
 		LocationName:         response.Name,
 		WeatherMain:          weatherMain,
 		WeatherDescription:   weatherDescription,
 		WeatherIcon:          weatherIcon,
-		TemperatureCelsius:   int32(response.Main.Temp),      // Round to int
-		FeelsLikeCelsius:     int32(response.Main.FeelsLike), // Round to int
+		TemperatureCelsius:   int32(toCelsius(response.Main.Temp, units)),      // Round to int
+		FeelsLikeCelsius:     int32(toCelsius(response.Main.FeelsLike, units)), // Round to int
 		HumidityPercent:      response.Main.Humidity,
-		WindSpeedKmh:         int32(response.Wind.Speed * 3.6), // Convert m/s to km/h
+		WindSpeedKmh:         int32(toKmh(response.Wind.Speed, units)),
 		WindDirectionDegrees: response.Wind.Deg,
-		VisibilityKm:         int32(response.Visibility / 1000), // Convert meters to km
+		VisibilityKm:         int32(response.Visibility / 1000), // Convert meters to km (always metric)
 		Alerts:               nil,                               // Alerts fetched separately
-	}, nil
+		Units:                string(units),
+		Temperature:          response.Main.Temp,
+		FeelsLike:            response.Main.FeelsLike,
+		WindSpeed:            response.Wind.Speed,
+		SnowLastHourMm:       response.Snow.OneHour,
+	}
+
+	// Calm air and a 0°C dew point are real readings, so these are only set
+	// when reported
+	if response.Wind.Gust != nil {
+		data.WindGustKmh = proto.Int32(int32(math.Round(float64(toKmh(*response.Wind.Gust, units)))))
+	}
+	if response.Main.Humidity > 0 {
+		data.DewPointCelsius = proto.Int32(dewPointCelsius(toCelsius(response.Main.Temp, units), response.Main.Humidity))
+	}
+	return data, nil
+}
+
+// dewPointCelsius estimates the dew point from temperature and relative
+// humidity with the Magnus formula; the current weather endpoint doesn't
+// report it. humidityPercent must be positive.
+func dewPointCelsius(tempCelsius float32, humidityPercent int32) int32 {
+	const b, c = 17.62, 243.12
+	t := float64(tempCelsius)
+	gamma := math.Log(float64(humidityPercent)/100) + b*t/(c+t)
+	return int32(math.Round(c * gamma / (b - gamma)))
+}
+
+// processForecastResponse converts One Call hourly/daily blocks to our forecast format
+func (c *Client) processForecastResponse(response OpenWeatherOneCallResponse, opts ForecastOptions) *api.WeatherForecast {
+	forecast := &api.WeatherForecast{}
+
+	if opts.Hourly {
+		for _, h := range response.Hourly {
+			period := &api.ForecastPeriod{
+				Time:                            timestamppb.New(time.Unix(h.Dt, 0).UTC()),
+				TemperatureCelsius:              int32(h.Temp),
+				PrecipitationProbabilityPercent: popPercent(h.Pop),
+				WindSpeedKmh:                    int32(h.WindSpeed * 3.6), // Convert m/s to km/h
+				WindDirectionDegrees:            h.WindDeg,
+			}
+			setForecastConditions(period, h.Weather)
+			forecast.Hourly = append(forecast.Hourly, period)
+		}
+	}
+
+	if opts.Daily {
+		for _, d := range response.Daily {
+			period := &api.ForecastPeriod{
+				Time:                            timestamppb.New(time.Unix(d.Dt, 0).UTC()),
+				TemperatureCelsius:              int32(d.Temp.Day),
+				TemperatureMinCelsius:           int32(d.Temp.Min),
+				TemperatureMaxCelsius:           int32(d.Temp.Max),
+				PrecipitationProbabilityPercent: popPercent(d.Pop),
+				WindSpeedKmh:                    int32(d.WindSpeed * 3.6),
+				WindDirectionDegrees:            d.WindDeg,
+				Summary:                         d.Summary,
+			}
+			setForecastConditions(period, d.Weather)
+			forecast.Daily = append(forecast.Daily, period)
+		}
+	}
+
+	return forecast
+}
+
+// popPercent converts OpenWeather's 0-1 precipitation probability to a percent
+func popPercent(pop float32) int32 {
+	return int32(math.Round(float64(pop) * 100))
+}
+
+// setForecastConditions copies the primary weather condition onto a period
+func setForecastConditions(period *api.ForecastPeriod, weather []OpenWeatherWeather) {
+	if len(weather) == 0 {
+		return
+	}
+	period.WeatherMain = weather[0].Main
+	period.WeatherDescription = weather[0].Description
+	period.WeatherIcon = weather[0].Icon
+}
+
+// toCelsius converts a temperature reported in units to Celsius
+func toCelsius(temp float32, units Units) float32 {
+	switch units {
+	case UnitsImperial:
+		return (temp - 32) * 5 / 9
+	case UnitsStandard:
+		return temp - 273.15
+	default:
+		return temp
+	}
+}
+
+// toKmh converts a wind speed reported in units (mph or m/s) to km/h
+func toKmh(speed float32, units Units) float32 {
+	if units == UnitsImperial {
+		return speed * 1.609344
+	}
+	return speed * 3.6
 }
 
 // processWeatherAlerts converts OpenWeatherMap alerts to our WeatherAlert format
@@ -195,16 +472,75 @@ type OpenWeatherCurrentResponse struct {
 	Main       OpenWeatherMain      `json:"main"`
 	Wind       OpenWeatherWind      `json:"wind"`
 	Clouds     OpenWeatherClouds    `json:"clouds"`
+	Snow       OpenWeatherPrecip    `json:"snow"` // Absent when it isn't snowing
 	Visibility int32                `json:"visibility"`
 	Name       string               `json:"name"`
 	Dt         int64                `json:"dt"`
 }
 
 // OpenWeatherOneCallResponse represents One Call API response with alerts
+// and, when not excluded, the hourly/daily forecast blocks
 type OpenWeatherOneCallResponse struct {
-	Lat    float64            `json:"lat"`
-	Lon    float64            `json:"lon"`
-	Alerts []OpenWeatherAlert `json:"alerts,omitempty"`
+	Lat    float64             `json:"lat"`
+	Lon    float64             `json:"lon"`
+	Hourly []OpenWeatherHourly `json:"hourly,omitempty"`
+	Daily  []OpenWeatherDaily  `json:"daily,omitempty"`
+	Alerts []OpenWeatherAlert  `json:"alerts,omitempty"`
+}
+
+// OpenWeatherHourly represents one hour of the One Call hourly forecast
+type OpenWeatherHourly struct {
+	Dt        int64                `json:"dt"`
+	Temp      float32              `json:"temp"`
+	Pop       float32              `json:"pop"` // Probability of precipitation, 0-1
+	WindSpeed float32              `json:"wind_speed"`
+	WindDeg   int32                `json:"wind_deg"`
+	Weather   []OpenWeatherWeather `json:"weather"`
+}
+
+// OpenWeatherDaily represents one day of the One Call daily forecast
+type OpenWeatherDaily struct {
+	Dt        int64                `json:"dt"`
+	Summary   string               `json:"summary"`
+	Temp      OpenWeatherDailyTemp `json:"temp"`
+	Pop       float32              `json:"pop"` // Probability of precipitation, 0-1
+	WindSpeed float32              `json:"wind_speed"`
+	WindDeg   int32                `json:"wind_deg"`
+	Weather   []OpenWeatherWeather `json:"weather"`
+}
+
+// OpenWeatherDailyTemp represents the daily temperature breakdown
+type OpenWeatherDailyTemp struct {
+	Day float32 `json:"day"`
+	Min float32 `json:"min"`
+	Max float32 `json:"max"`
+}
+
+// OpenWeatherAirPollutionResponse represents the Air Pollution API response
+type OpenWeatherAirPollutionResponse struct {
+	Coord OpenWeatherCoord              `json:"coord"`
+	List  []OpenWeatherAirPollutionItem `json:"list"`
+}
+
+// OpenWeatherAirPollutionItem represents a single air-pollution reading
+type OpenWeatherAirPollutionItem struct {
+	Dt   int64 `json:"dt"`
+	Main struct {
+		AQI int32 `json:"aqi"`
+	} `json:"main"`
+	Components OpenWeatherAirComponents `json:"components"`
+}
+
+// OpenWeatherAirComponents represents pollutant concentrations in μg/m³
+type OpenWeatherAirComponents struct {
+	CO   float32 `json:"co"`
+	NO   float32 `json:"no"`
+	NO2  float32 `json:"no2"`
+	O3   float32 `json:"o3"`
+	SO2  float32 `json:"so2"`
+	PM25 float32 `json:"pm2_5"`
+	PM10 float32 `json:"pm10"`
+	NH3  float32 `json:"nh3"`
 }
 
 // OpenWeatherCoord represents coordinates in response
@@ -230,8 +566,14 @@ type OpenWeatherMain struct {
 
 // OpenWeatherWind represents wind data
 type OpenWeatherWind struct {
-	Speed float32 `json:"speed"`
-	Deg   int32   `json:"deg"`
+	Speed float32  `json:"speed"`
+	Deg   int32    `json:"deg"`
+	Gust  *float32 `json:"gust"` // Absent in calm conditions
+}
+
+// OpenWeatherPrecip represents recent precipitation, always in mm
+type OpenWeatherPrecip struct {
+	OneHour float32 `json:"1h"`
 }
 
 // OpenWeatherClouds represents cloud cover
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 )
 
 // MockHTTPDoer is a mock implementation of HTTPDoer
@@ -252,3 +253,296 @@ func TestGetWeatherAlerts_InvalidJSON(t *testing.T) {
 
 	mockHTTP.AssertExpectations(t)
 }
+
+func TestGetForecast_Daily(t *testing.T) {
+	fixtureData := loadTestFixture(t, "murphys_forecast.json")
+
+	// Capture the request so we can verify the excluded blocks
+	var capturedReq *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedReq = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, fixtureData), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	forecast, err := client.GetForecast(context.Background(), coordinates, ForecastOptions{Daily: true})
+	require.NoError(t, err)
+	require.NotNil(t, forecast)
+
+	require.NotNil(t, capturedReq)
+	assert.Equal(t, "/data/3.0/onecall", capturedReq.URL.Path)
+	assert.Equal(t, "current,minutely,alerts,hourly", capturedReq.URL.Query().Get("exclude"))
+	assert.Equal(t, "metric", capturedReq.URL.Query().Get("units"))
+
+	// Only the daily block was requested
+	assert.Empty(t, forecast.Hourly)
+	require.Len(t, forecast.Daily, 2)
+
+	day := forecast.Daily[0]
+	assert.Equal(t, int64(1766606400), day.GetTime().AsTime().Unix())
+	assert.Equal(t, int32(8), day.TemperatureCelsius)
+	assert.Equal(t, int32(3), day.TemperatureMinCelsius)
+	assert.Equal(t, int32(10), day.TemperatureMaxCelsius)
+	assert.Equal(t, int32(92), day.PrecipitationProbabilityPercent)
+	assert.Equal(t, int32(15), day.WindSpeedKmh) // 4.2 m/s
+	assert.Equal(t, int32(225), day.WindDirectionDegrees)
+	assert.Equal(t, "Rain", day.WeatherMain)
+	assert.Equal(t, "moderate rain", day.WeatherDescription)
+	assert.Equal(t, "10d", day.WeatherIcon)
+	assert.Contains(t, day.Summary, "snow above 5000 feet")
+
+	assert.Equal(t, int32(10), forecast.Daily[1].PrecipitationProbabilityPercent)
+	assert.Equal(t, "Clouds", forecast.Daily[1].WeatherMain)
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetForecast_DefaultsToHourlyAndDaily(t *testing.T) {
+	fixtureData := loadTestFixture(t, "murphys_forecast.json")
+
+	var capturedReq *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedReq = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, fixtureData), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	forecast, err := client.GetForecast(context.Background(), coordinates, ForecastOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "current,minutely,alerts", capturedReq.URL.Query().Get("exclude"))
+	require.Len(t, forecast.Hourly, 2)
+	assert.Len(t, forecast.Daily, 2)
+
+	hour := forecast.Hourly[1]
+	assert.Equal(t, int64(1766595600), hour.GetTime().AsTime().Unix())
+	assert.Equal(t, int32(5), hour.TemperatureCelsius)
+	assert.Equal(t, int32(60), hour.PrecipitationProbabilityPercent)
+	assert.Equal(t, int32(10), hour.WindSpeedKmh) // 3.0 m/s
+	assert.Equal(t, "moderate rain", hour.WeatherDescription)
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestProcessForecastResponse_RoundsPrecipitationProbability(t *testing.T) {
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", &MockHTTPDoer{})
+	response := OpenWeatherOneCallResponse{
+		Hourly: []OpenWeatherHourly{{Dt: 1766592000, Pop: 0.59}}, // 0.59 * 100 is just under 59 as a float32
+		Daily:  []OpenWeatherDaily{{Dt: 1766606400, Pop: 0.53}},
+	}
+
+	forecast := client.processForecastResponse(response, ForecastOptions{Hourly: true, Daily: true})
+	require.Len(t, forecast.Hourly, 1)
+	require.Len(t, forecast.Daily, 1)
+	assert.Equal(t, int32(59), forecast.Hourly[0].PrecipitationProbabilityPercent)
+	assert.Equal(t, int32(53), forecast.Daily[0].PrecipitationProbabilityPercent)
+}
+
+func TestGetCurrentWeatherInUnits_Imperial(t *testing.T) {
+	fixtureData := loadTestFixture(t, "murphys_current_imperial.json")
+
+	var capturedReq *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedReq = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, fixtureData), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	weather, err := client.GetCurrentWeatherInUnits(context.Background(), coordinates, UnitsImperial)
+	require.NoError(t, err)
+
+	require.NotNil(t, capturedReq)
+	assert.Equal(t, "imperial", capturedReq.URL.Query().Get("units"))
+
+	// Values in the requested units
+	assert.Equal(t, "imperial", weather.Units)
+	assert.InDelta(t, 77.4, weather.Temperature, 0.01)
+	assert.InDelta(t, 76.8, weather.FeelsLike, 0.01)
+	assert.InDelta(t, 10.0, weather.WindSpeed, 0.01) // mph
+
+	// Metric fields stay metric for existing consumers
+	assert.Equal(t, int32(25), weather.TemperatureCelsius) // 77.4°F = 25.2°C
+	assert.Equal(t, int32(24), weather.FeelsLikeCelsius)   // 76.8°F = 24.9°C
+	assert.Equal(t, int32(16), weather.WindSpeedKmh)       // 10 mph = 16.1 km/h
+	assert.Equal(t, int32(30), weather.GetWindGustKmh())   // 18.4 mph = 29.6 km/h
+	assert.Equal(t, int32(5), weather.GetDewPointCelsius())
+	assert.Equal(t, int32(10), weather.VisibilityKm)
+	assert.Equal(t, "Clear", weather.WeatherMain)
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetCurrentWeather_DefaultsToMetric(t *testing.T) {
+	fixtureData := loadTestFixture(t, "murphys_current_imperial.json")
+
+	var capturedReq *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedReq = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, fixtureData), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	weather, err := client.GetCurrentWeather(context.Background(), coordinates)
+	require.NoError(t, err)
+
+	assert.Equal(t, "metric", capturedReq.URL.Query().Get("units"))
+	assert.Equal(t, "metric", weather.Units)
+
+	_, err = client.GetCurrentWeatherInUnits(context.Background(), coordinates, Units("kelvin"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported units")
+}
+
+func TestGetCurrentWeather_GroundConditions(t *testing.T) {
+	coordinates := &api.Coordinates{Latitude: 38.461, Longitude: -120.0424}
+
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(createMockResponse(200, loadTestFixture(t, "bearvalley_current_snow.json")), nil).Once()
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+
+	weather, err := client.GetCurrentWeather(context.Background(), coordinates)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.54, weather.SnowLastHourMm, 0.001)
+	assert.Equal(t, int32(45), weather.GetWindGustKmh())     // 12.5 m/s
+	assert.Equal(t, int32(-4), weather.GetDewPointCelsius()) // -3.4°C at 96%
+
+	// No snow block: zero snowfall; gust and dew point still parsed
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(createMockResponse(200, loadTestFixture(t, "murphys_current.json")), nil).Once()
+	weather, err = client.GetCurrentWeather(context.Background(), coordinates)
+	require.NoError(t, err)
+	assert.Zero(t, weather.SnowLastHourMm)
+	assert.Equal(t, int32(30), weather.GetWindGustKmh())    // 8.23 m/s
+	assert.Equal(t, int32(5), weather.GetDewPointCelsius()) // 25.2°C at 28%
+
+	// Nothing reported: zero snowfall, gust and dew point unset
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(createMockResponse(200, `{"main": {"temp": 2}, "wind": {"speed": 1}}`), nil).Once()
+	weather, err = client.GetCurrentWeather(context.Background(), coordinates)
+	require.NoError(t, err)
+	assert.Zero(t, weather.SnowLastHourMm)
+	assert.Nil(t, weather.WindGustKmh)
+	assert.Nil(t, weather.DewPointCelsius)
+
+	// Calm air is a reading, not a missing one
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(createMockResponse(200, `{"main": {"temp": 0, "humidity": 100}, "wind": {"speed": 0, "gust": 0}}`), nil).Once()
+	weather, err = client.GetCurrentWeather(context.Background(), coordinates)
+	require.NoError(t, err)
+	require.NotNil(t, weather.WindGustKmh)
+	require.NotNil(t, weather.DewPointCelsius)
+	assert.Zero(t, *weather.WindGustKmh)
+	assert.Zero(t, *weather.DewPointCelsius)
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetAirQuality(t *testing.T) {
+	fixtureData := loadTestFixture(t, "murphys_air_pollution.json")
+
+	var capturedReq *http.Request
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		capturedReq = args.Get(0).(*http.Request)
+	}).Return(createMockResponse(200, fixtureData), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	airQuality, err := client.GetAirQuality(context.Background(), coordinates)
+	require.NoError(t, err)
+
+	// Air Pollution lives under /data/2.5, not the One Call /data/3.0 path
+	require.NotNil(t, capturedReq)
+	assert.Equal(t, "/data/2.5/air_pollution", capturedReq.URL.Path)
+	assert.Equal(t, "38.137700", capturedReq.URL.Query().Get("lat"))
+	assert.Equal(t, "test-api-key", capturedReq.URL.Query().Get("appid"))
+
+	assert.Equal(t, int32(4), airQuality.Aqi)
+	assert.Equal(t, "Poor", airQuality.AqiLabel)
+	assert.InDelta(t, 62.37, airQuality.Pm25, 0.001)
+	assert.InDelta(t, 78.9, airQuality.Pm10, 0.001)
+	assert.InDelta(t, 98.71, airQuality.Ozone, 0.001)
+	assert.Equal(t, int64(1754006400), airQuality.GetMeasuredAt().AsTime().Unix())
+
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetAirQuality_EmptyList(t *testing.T) {
+	mockHTTP := &MockHTTPDoer{}
+	mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(
+		createMockResponse(200, `{"coord": {"lon": -120.46, "lat": 38.14}, "list": []}`), nil)
+
+	client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+	coordinates := &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+	airQuality, err := client.GetAirQuality(context.Background(), coordinates)
+	assert.Error(t, err)
+	assert.Nil(t, airQuality)
+	assert.Contains(t, err.Error(), "no readings")
+}
+
+func TestClient_ErrorTypes(t *testing.T) {
+	coordinates := &api.Coordinates{Latitude: 38.1391, Longitude: -120.4561}
+	calls := map[string]func(c *Client) error{
+		"current": func(c *Client) error {
+			_, err := c.GetCurrentWeather(context.Background(), coordinates)
+			return err
+		},
+		"alerts": func(c *Client) error {
+			_, err := c.GetWeatherAlerts(context.Background(), coordinates)
+			return err
+		},
+		"forecast": func(c *Client) error {
+			_, err := c.GetForecast(context.Background(), coordinates, ForecastOptions{})
+			return err
+		},
+		"air quality": func(c *Client) error {
+			_, err := c.GetAirQuality(context.Background(), coordinates)
+			return err
+		},
+	}
+	statuses := []struct {
+		status int
+		want   error
+	}{
+		{429, upstream.ErrRateLimited},
+		{401, upstream.ErrUnauthorized},
+		{502, upstream.ErrUpstreamUnavailable},
+	}
+
+	for name, call := range calls {
+		for _, tt := range statuses {
+			mockHTTP := &MockHTTPDoer{}
+			mockHTTP.On("Do", mock.AnythingOfType("*http.Request")).Return(
+				createMockResponse(tt.status, `{"cod": 0, "message": "error"}`), nil)
+			err := call(NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP))
+			assert.ErrorIs(t, err, tt.want, "%s: status %d", name, tt.status)
+		}
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	fixtureData := loadTestFixture(t, "seattle_alerts_test.json")
+	for _, userAgent := range []string{"", "ersn-staging (ops@example.org)"} {
+		want := userAgent
+		if want == "" {
+			want = upstream.DefaultUserAgent()
+		}
+		mockHTTP := &MockHTTPDoer{}
+		mockHTTP.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.Header.Get("User-Agent") == want
+		})).Return(createMockResponse(200, fixtureData), nil)
+
+		client := NewClientWithHTTPDoer("test-api-key", "https://api.openweathermap.org", mockHTTP)
+		client.UserAgent = userAgent
+		_, err := client.GetWeatherAlerts(context.Background(), &api.Coordinates{Latitude: 47.6062, Longitude: -122.3321})
+		require.NoError(t, err)
+		mockHTTP.AssertExpectations(t)
+	}
+}
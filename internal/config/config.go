@@ -1,10 +1,21 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/dpup/prefab"
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/env"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
 )
@@ -17,8 +28,105 @@ type Config struct {
 	Roads        RoadsConfig        `koanf:"roads"`
 	Weather      WeatherConfig      `koanf:"weather"`
 	Hazards      HazardsConfig      `koanf:"hazards"`
+	Cache        CacheConfig        `koanf:"cache"`
+	Logging      LoggingConfig      `koanf:"logging"`
+	Offline      OfflineConfig      `koanf:"offline"`
+	Admin        AdminConfig        `koanf:"admin"`
+	Upstream     UpstreamConfig     `koanf:"upstream"`
 }
 
+// Validate reports every problem with the roads and weather sections
+func (c *Config) Validate() error {
+	return errors.Join(c.Roads.Validate(), c.Weather.Validate())
+}
+
+// LoggingConfig selects how the server writes logs
+type LoggingConfig struct {
+	// Format is "json" (default) for one structured JSON object per line, or
+	// "console" for human-readable development output
+	Format string `koanf:"format"`
+}
+
+// Log formats accepted in logging.format
+const (
+	LogFormatJSON    = "json"
+	LogFormatConsole = "console"
+)
+
+// OfflineConfig runs the server against local fixtures instead of live
+// upstreams, for development and demos without network access or API keys
+type OfflineConfig struct {
+	// Enabled serves Caltrans, Google Routes, OpenWeatherMap and NWS requests
+	// from TestDataDir and enhances alerts with a deterministic stub instead
+	// of an LLM. Hazard layers from keyless feeds (USGS, CAL FIRE, WFIGS,
+	// Cal OES) still go to the network.
+	Enabled bool `koanf:"enabled"`
+	// TestDataDir holds the fixtures; defaults to DefaultOfflineTestDataDir
+	TestDataDir string `koanf:"testDataDir"`
+}
+
+// AdminConfig guards the operator RPCs (ForceRefresh)
+type AdminConfig struct {
+	// APIKey authenticates admin requests, sent as "Authorization: admin_<key>".
+	// Empty disables the admin RPCs. Set it with PF__ADMIN__API_KEY rather
+	// than in prefab.yaml.
+	APIKey string `koanf:"apiKey"`
+}
+
+// UpstreamConfig holds settings shared by the clients of external APIs
+type UpstreamConfig struct {
+	// UserAgent is sent to Caltrans, Google Routes and OpenWeatherMap, and
+	// to NWS unless weather.nws.userAgent is set. Empty uses
+	// upstream.DefaultUserAgent, which names info.ersn.net and its version.
+	UserAgent string `koanf:"userAgent"`
+}
+
+// DefaultOfflineTestDataDir is the repository's fixture directory, relative
+// to the repository root the server is normally run from
+const DefaultOfflineTestDataDir = "tests/testdata"
+
+// OfflineAPIKey stands in for API keys left empty in offline mode. The
+// services skip an upstream whose key is empty; the fixtures ignore it.
+const OfflineAPIKey = "offline"
+
+// CacheConfig selects and tunes the cache backend. Size limits and
+// persistence apply to the in-memory backend; zero limits mean unbounded.
+type CacheConfig struct {
+	// Backend is "memory" (default) or "redis"
+	Backend string `koanf:"backend"`
+	// RedisURL is the server for the redis backend (e.g. "redis://localhost:6379/0")
+	RedisURL string `koanf:"redisURL"`
+	// RedisKeyPrefix namespaces cache keys; defaults to "ersn:"
+	RedisKeyPrefix string `koanf:"redisKeyPrefix"`
+	// MaxEntries caps the number of cached entries
+	MaxEntries int `koanf:"maxEntries"`
+	// MaxBytes caps the approximate size of cached data
+	MaxBytes int64 `koanf:"maxBytes"`
+	// PersistPath, when set, saves the cache to this file periodically and on
+	// shutdown and reloads it on startup, so enhanced alerts survive restarts.
+	PersistPath string `koanf:"persistPath"`
+	// PersistInterval is how often the cache is saved. Defaults to
+	// DefaultCachePersistInterval when unset.
+	PersistInterval time.Duration `koanf:"persistInterval"`
+	// JanitorInterval is how often very stale entries are reaped from the
+	// in-memory cache. Defaults to DefaultCacheJanitorInterval when unset.
+	JanitorInterval time.Duration `koanf:"janitorInterval"`
+}
+
+// DefaultCacheJanitorInterval is how often the in-memory cache is swept when
+// cache.janitorInterval isn't configured.
+const DefaultCacheJanitorInterval = 10 * time.Minute
+
+// DefaultCachePersistInterval is how often the cache is saved to
+// cache.persistPath when cache.persistInterval isn't configured.
+const DefaultCachePersistInterval = 5 * time.Minute
+
+// Cache backends accepted in cache.backend
+const (
+	CacheBackendMemory = "memory"
+	CacheBackendRedis  = "redis"
+)
+
 // HazardsConfig holds the unified hazard/situation feed configuration
 // (docs/hazard-aggregation-design.md). Each area is a named region the
 // /api/v1/hazards/{area}/{layer}.geojson endpoints serve.
@@ -59,6 +167,60 @@ type RefreshConfig struct {
 // Client configurations - moved to top level
 type GoogleRoutesClient struct {
 	APIKey string `koanf:"apiKey"`
+	// CacheTTL is how long a road's Google Routes result (traffic and
+	// polyline) is reused. Longer conserves API quota; shorter tracks traffic
+	// more closely. Defaults to DefaultGoogleRoutesCacheTTL when unset.
+	CacheTTL time.Duration `koanf:"cacheTTL"`
+	// DailyCallBudget caps Compute Routes calls over a rolling 24 hours; once
+	// spent, roads reuse their last Google Routes result. Zero is unlimited.
+	DailyCallBudget int `koanf:"dailyCallBudget"`
+	// SpeedReadings requests per-segment traffic speeds, used to judge
+	// congestion when Google omits the no-traffic duration. Off by default:
+	// it bills Compute Routes at the Enterprise SKU instead of Pro.
+	SpeedReadings bool `koanf:"speedReadings"`
+	// After CircuitBreakerFailures consecutive failed Compute Routes calls,
+	// roads are served their last-known traffic for CircuitBreakerCooldown
+	// before one call probes Google again. Default to
+	// DefaultGoogleRoutesCircuitBreakerFailures and
+	// DefaultGoogleRoutesCircuitBreakerCooldown when unset; negative failures
+	// disables the breaker.
+	CircuitBreakerFailures int           `koanf:"circuitBreakerFailures"`
+	CircuitBreakerCooldown time.Duration `koanf:"circuitBreakerCooldown"`
+}
+
+// Circuit breaker defaults for Google Routes. A refresh calls Google once per
+// uncached road, so a few failures in a row mean Google is down or rate
+// limiting rather than one road being unroutable.
+const (
+	DefaultGoogleRoutesCircuitBreakerFailures = 3
+	DefaultGoogleRoutesCircuitBreakerCooldown = 10 * time.Minute
+)
+
+// CircuitBreaker returns the Google Routes circuit breaker settings with
+// defaults applied. Failures <= 0 means the breaker never opens.
+func (g GoogleRoutesClient) CircuitBreaker() (failures int, cooldown time.Duration) {
+	failures, cooldown = g.CircuitBreakerFailures, g.CircuitBreakerCooldown
+	if failures == 0 {
+		failures = DefaultGoogleRoutesCircuitBreakerFailures
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultGoogleRoutesCircuitBreakerCooldown
+	}
+	return failures, cooldown
+}
+
+// DefaultGoogleRoutesCacheTTL keeps Google Routes calls to ~1 per road every
+// 45 minutes (~32/day/road, ~3.9k/month for 4 roads), under the Compute
+// Routes Pro free tier of 5,000/month.
+const DefaultGoogleRoutesCacheTTL = 45 * time.Minute
+
+// RouteCacheTTL returns the configured cache lifetime for Google Routes
+// results, or DefaultGoogleRoutesCacheTTL.
+func (g GoogleRoutesClient) RouteCacheTTL() time.Duration {
+	if g.CacheTTL > 0 {
+		return g.CacheTTL
+	}
+	return DefaultGoogleRoutesCacheTTL
 }
 
 type OpenAIClient struct {
@@ -66,6 +228,47 @@ type OpenAIClient struct {
 	Model      string        `koanf:"model"`
 	Timeout    time.Duration `koanf:"timeout"`
 	MaxRetries int           `koanf:"maxRetries"`
+	// FallbackModel is tried once Model has failed (after MaxRetries retries
+	// of transient errors). Optional.
+	FallbackModel string `koanf:"fallbackModel"`
+	// Prompt/CompletionPricePer1K are USD per 1,000 tokens, used to estimate
+	// enhancement spend in GET /api/v1/metrics. Optional.
+	PromptPricePer1K     float64 `koanf:"promptPricePer1K"`
+	CompletionPricePer1K float64 `koanf:"completionPricePer1K"`
+	// Provider selects the road-alert LLM backend: "openai" (default) or
+	// "openai-compatible" for a local/self-hosted /chat/completions endpoint
+	// at BaseURL (e.g. Ollama at http://localhost:11434/v1).
+	Provider string `koanf:"provider"`
+	BaseURL  string `koanf:"baseURL"`
+	// SummaryLanguages lists extra language codes (e.g. "es") road-alert
+	// condensed summaries are translated into. English is always produced.
+	SummaryLanguages []string `koanf:"summaryLanguages"`
+	// After CircuitBreakerFailures consecutive failed enhancements, alerts
+	// are served with their raw descriptions for CircuitBreakerCooldown
+	// before one alert probes the LLM again. Default to
+	// DefaultCircuitBreakerFailures and DefaultCircuitBreakerCooldown when
+	// unset; negative failures disables the breaker.
+	CircuitBreakerFailures int           `koanf:"circuitBreakerFailures"`
+	CircuitBreakerCooldown time.Duration `koanf:"circuitBreakerCooldown"`
+}
+
+// Circuit breaker defaults for alert enhancement
+const (
+	DefaultCircuitBreakerFailures = 5
+	DefaultCircuitBreakerCooldown = 2 * time.Minute
+)
+
+// CircuitBreaker returns the enhancement circuit breaker settings with
+// defaults applied. Failures <= 0 means the breaker never opens.
+func (o OpenAIClient) CircuitBreaker() (failures int, cooldown time.Duration) {
+	failures, cooldown = o.CircuitBreakerFailures, o.CircuitBreakerCooldown
+	if failures == 0 {
+		failures = DefaultCircuitBreakerFailures
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCircuitBreakerCooldown
+	}
+	return failures, cooldown
 }
 
 type OpenWeatherClient struct {
@@ -74,11 +277,240 @@ type OpenWeatherClient struct {
 
 // RoadsConfig holds road monitoring configuration
 type RoadsConfig struct {
-	CaltransFeeds   CaltransConfig  `koanf:"caltransFeeds"`
-	MonitoredRoads  []MonitoredRoad `koanf:"monitoredRoads"`
-	IncidentAreas   []IncidentArea  `koanf:"incidentAreas"`
-	RefreshInterval time.Duration   `koanf:"refreshInterval"`
-	StaleThreshold  time.Duration   `koanf:"staleThreshold"`
+	CaltransFeeds        CaltransConfig       `koanf:"caltransFeeds"`
+	MonitoredRoads       []MonitoredRoad      `koanf:"monitoredRoads"`
+	IncidentAreas        []IncidentArea       `koanf:"incidentAreas"`
+	CongestionThresholds CongestionThresholds `koanf:"congestionThresholds"`
+	RefreshInterval      time.Duration        `koanf:"refreshInterval"`
+	StaleThreshold       time.Duration        `koanf:"staleThreshold"`
+	// EnhancedAlertTTL is how long an AI-enhanced alert is reused for the same
+	// alert content. Defaults to DefaultEnhancedAlertTTL when unset.
+	EnhancedAlertTTL time.Duration `koanf:"enhancedAlertTTL"`
+	// EnhancedAlertTTLByType overrides EnhancedAlertTTL per alert type
+	// ("weather" for chain control, "closure", "incident").
+	EnhancedAlertTTLByType map[string]time.Duration `koanf:"enhancedAlertTTLByType"`
+	// EnhancementWorkers > 0 enhances alerts in the background with that many
+	// workers: refreshes serve raw alerts until the enhancement is cached.
+	// Zero enhances inline during the refresh.
+	EnhancementWorkers int `koanf:"enhancementWorkers"`
+	// EnhancementQueueSize bounds alerts waiting for an enhancement worker.
+	// Zero uses DefaultEnhancementQueueSize.
+	EnhancementQueueSize int `koanf:"enhancementQueueSize"`
+	// EnhancementQueueWait is how long a refresh waits for room when the
+	// enhancement queue is full before dropping the alert (it is queued again
+	// on a later refresh). Zero drops at once, never holding up a refresh.
+	EnhancementQueueWait time.Duration `koanf:"enhancementQueueWait"`
+	// AlertHashLocationDecimals rounds alert coordinates to this many decimal
+	// places when hashing alert content, so the same incident reported a
+	// short distance away reuses its enhancement (2 = ~1.1km, 3 = ~110m).
+	// Zero keeps the 4 decimals (~11m) alerts are described with.
+	AlertHashLocationDecimals int `koanf:"alertHashLocationDecimals"`
+	// OnRouteThresholdMeters is the distance from a route within which an
+	// alert is ON_ROUTE. Zero uses routing.DefaultOnRouteThresholdMeters (100).
+	OnRouteThresholdMeters float64 `koanf:"onRouteThresholdMeters"`
+	// ClosureOverlapPercent classifies a lane closure ON_ROUTE when more than
+	// this share of its length runs along the route (within
+	// ClosureOverlapMeters), even if its nearest point is past the ON_ROUTE
+	// distance. Zero uses routing.DefaultOnRouteOverlapPercent (10); 100 disables.
+	ClosureOverlapPercent float64 `koanf:"closureOverlapPercent"`
+	// ClosureOverlapMeters is the distance from a route within which a lane
+	// closure counts as running along it for ClosureOverlapPercent. Zero uses
+	// twice OnRouteThresholdMeters.
+	ClosureOverlapMeters float64 `koanf:"closureOverlapMeters"`
+	// RefreshConcurrency caps how many monitored roads a refresh processes at
+	// once (Google Routes calls and alert enhancement). Keep it low enough for
+	// Google's rate limits. Zero uses DefaultRefreshConcurrency; 1 is sequential.
+	RefreshConcurrency int `koanf:"refreshConcurrency"`
+	// RefreshJitter delays each periodic refresh after its tick by a random
+	// amount up to this, so refreshes don't fire on a fixed beat alongside
+	// other clients of the same APIs. Zero refreshes on the tick.
+	RefreshJitter time.Duration `koanf:"refreshJitter"`
+	// RefreshStagger spreads a periodic refresh's per-road Google Routes
+	// lookups evenly across this window instead of starting them together.
+	// Zero starts them at once (up to RefreshConcurrency).
+	RefreshStagger time.Duration `koanf:"refreshStagger"`
+	// WeatherChainAdvisories marks a road's chain control ADVISED when a
+	// severe winter weather alert (OpenWeatherMap) covers its origin or
+	// destination and Caltrans reports none. Off by default.
+	WeatherChainAdvisories bool `koanf:"weatherChainAdvisories"`
+	// PrefilterRadiusMeters is how far around a route's bounding box an alert
+	// may be and still be classified against it; alerts farther out are
+	// skipped as DISTANT. Never less than a road's maxDistanceMeters (the
+	// default), so NEARBY alerts are always classified.
+	PrefilterRadiusMeters float64 `koanf:"prefilterRadiusMeters"`
+	// CalendarTimezone is the IANA zone closure calendar (.ics) event times
+	// are written in and maintenance windows are read in. Empty uses
+	// DefaultCalendarTimezone.
+	CalendarTimezone string `koanf:"calendarTimezone"`
+	// IgnoredIncidentPatterns are case-insensitive regular expressions for
+	// incidents to drop before classification, so low-value categories never
+	// reach road alerts or status. They match the normalized description:
+	// CHP codes dropped, abbreviations expanded, and hyphens and whitespace
+	// collapsed to single spaces (e.g. "traffic hazard animal").
+	IgnoredIncidentPatterns []string `koanf:"ignoredIncidentPatterns"`
+}
+
+// Validate reports every problem with the monitored roads and the refresh
+// settings, so a typo'd coordinate or duplicate road ID fails startup
+// instead of surfacing as misclassified alerts later.
+func (r RoadsConfig) Validate() error {
+	var errs []error
+	if r.RefreshInterval <= 0 {
+		errs = append(errs, fmt.Errorf("roads.refreshInterval must be positive, got %s", r.RefreshInterval))
+	}
+	if r.StaleThreshold < 0 {
+		errs = append(errs, fmt.Errorf("roads.staleThreshold must not be negative, got %s", r.StaleThreshold))
+	}
+	switch {
+	case r.RefreshJitter < 0:
+		errs = append(errs, fmt.Errorf("roads.refreshJitter must not be negative, got %s", r.RefreshJitter))
+	case r.RefreshStagger < 0:
+		errs = append(errs, fmt.Errorf("roads.refreshStagger must not be negative, got %s", r.RefreshStagger))
+	case r.RefreshInterval > 0 && r.RefreshJitter+r.RefreshStagger >= r.RefreshInterval:
+		errs = append(errs, fmt.Errorf("roads.refreshJitter plus roads.refreshStagger (%s) must be shorter than roads.refreshInterval (%s)",
+			r.RefreshJitter+r.RefreshStagger, r.RefreshInterval))
+	}
+	if r.EnhancementQueueSize < 0 {
+		errs = append(errs, fmt.Errorf("roads.enhancementQueueSize must not be negative, got %d", r.EnhancementQueueSize))
+	}
+	if r.EnhancementQueueWait < 0 {
+		errs = append(errs, fmt.Errorf("roads.enhancementQueueWait must not be negative, got %s", r.EnhancementQueueWait))
+	}
+	if r.CaltransFeeds.MaxBodyBytes < 0 {
+		errs = append(errs, fmt.Errorf("roads.caltransFeeds.maxBodyBytes must not be negative, got %d", r.CaltransFeeds.MaxBodyBytes))
+	}
+
+	firstIndex := make(map[string]int)
+	for i, road := range r.MonitoredRoads {
+		field := fmt.Sprintf("roads.monitoredRoads[%d]", i)
+		if road.ID != "" {
+			field += fmt.Sprintf(" (%s)", road.ID)
+		}
+
+		switch first, seen := firstIndex[road.ID]; {
+		case road.ID == "":
+			errs = append(errs, fmt.Errorf("%s: id is required", field))
+		case seen:
+			errs = append(errs, fmt.Errorf("%s: duplicate id, also used by roads.monitoredRoads[%d]", field, first))
+		default:
+			firstIndex[road.ID] = i
+		}
+
+		if err := road.Origin.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: origin %w", field, err))
+		}
+		if err := road.Destination.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: destination %w", field, err))
+		}
+		if road.Origin == road.Destination {
+			errs = append(errs, fmt.Errorf("%s: origin and destination are the same point", field))
+		}
+		for j, window := range road.MaintenanceWindows {
+			if err := window.validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%s: maintenanceWindows[%d]: %w", field, j, err))
+			}
+		}
+	}
+
+	for i, pattern := range r.IgnoredIncidentPatterns {
+		if _, err := compileIgnoredIncidentPattern(pattern); err != nil {
+			errs = append(errs, fmt.Errorf("roads.ignoredIncidentPatterns[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// IgnoredIncidentRegexps compiles IgnoredIncidentPatterns, skipping any that
+// don't compile (Validate reports those)
+func (r RoadsConfig) IgnoredIncidentRegexps() []*regexp.Regexp {
+	var res []*regexp.Regexp
+	for _, pattern := range r.IgnoredIncidentPatterns {
+		if re, err := compileIgnoredIncidentPattern(pattern); err == nil {
+			res = append(res, re)
+		}
+	}
+	return res
+}
+
+// compileIgnoredIncidentPattern compiles an ignored-incident pattern, case
+// insensitively
+func compileIgnoredIncidentPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// DefaultCalendarTimezone is the closure calendar's zone when
+// roads.calendarTimezone isn't configured; Caltrans reports Pacific times.
+const DefaultCalendarTimezone = "America/Los_Angeles"
+
+// DefaultRefreshConcurrency is how many roads a refresh processes at once
+// when roads.refreshConcurrency isn't configured.
+const DefaultRefreshConcurrency = 4
+
+// DefaultEnhancementQueueSize bounds alerts waiting for an enhancement
+// worker when roads.enhancementQueueSize isn't configured.
+const DefaultEnhancementQueueSize = 256
+
+// DefaultEnhancedAlertTTL is the enhanced alert cache lifetime used when
+// roads.enhancedAlertTTL isn't configured.
+const DefaultEnhancedAlertTTL = 24 * time.Hour
+
+// EnhancedAlertCacheTTL returns the enhanced alert cache lifetime for an
+// alert type: the per-type override, then the global setting, then
+// DefaultEnhancedAlertTTL.
+func (r RoadsConfig) EnhancedAlertCacheTTL(alertType string) time.Duration {
+	if ttl := r.EnhancedAlertTTLByType[alertType]; ttl > 0 {
+		return ttl
+	}
+	if r.EnhancedAlertTTL > 0 {
+		return r.EnhancedAlertTTL
+	}
+	return DefaultEnhancedAlertTTL
+}
+
+// CongestionThresholds holds the minimum delay, in minutes, at which a road is
+// classified at each congestion level. A zero value means "use the default",
+// so a road can override a single cutoff and inherit the rest.
+type CongestionThresholds struct {
+	LightMinutes    int32 `koanf:"lightMinutes"`
+	ModerateMinutes int32 `koanf:"moderateMinutes"`
+	HeavyMinutes    int32 `koanf:"heavyMinutes"`
+	SevereMinutes   int32 `koanf:"severeMinutes"`
+}
+
+// DefaultCongestionThresholds returns the delay cutoffs used when none are
+// configured: 2/5/10/20 minutes for light/moderate/heavy/severe.
+func DefaultCongestionThresholds() CongestionThresholds {
+	return CongestionThresholds{
+		LightMinutes:    2,
+		ModerateMinutes: 5,
+		HeavyMinutes:    10,
+		SevereMinutes:   20,
+	}
+}
+
+// Merge returns t with any unset (zero) cutoffs filled in from fallback.
+func (t CongestionThresholds) Merge(fallback CongestionThresholds) CongestionThresholds {
+	if t.LightMinutes == 0 {
+		t.LightMinutes = fallback.LightMinutes
+	}
+	if t.ModerateMinutes == 0 {
+		t.ModerateMinutes = fallback.ModerateMinutes
+	}
+	if t.HeavyMinutes == 0 {
+		t.HeavyMinutes = fallback.HeavyMinutes
+	}
+	if t.SevereMinutes == 0 {
+		t.SevereMinutes = fallback.SevereMinutes
+	}
+	return t
+}
+
+// CongestionThresholdsFor resolves the effective cutoffs for a road: the road's
+// own overrides, then the roads-wide section, then the defaults.
+func (r RoadsConfig) CongestionThresholdsFor(road MonitoredRoad) CongestionThresholds {
+	return road.CongestionThresholds.
+		Merge(r.CongestionThresholds).
+		Merge(DefaultCongestionThresholds())
 }
 
 // IncidentArea defines a named geographic region for the region-wide incidents
@@ -106,15 +538,41 @@ func (b GeoBounds) Contains(lat, lon float64) bool {
 
 // CaltransConfig holds Caltrans KML feed settings
 type CaltransConfig struct {
+	ChainControls  CaltransFeedConfig `koanf:"chainControls"`
 	LaneClosures   CaltransFeedConfig `koanf:"laneClosures"`
 	CHPIncidents   CaltransFeedConfig `koanf:"chpIncidents"`
 	RoadConditions CaltransFeedConfig `koanf:"roadConditions"`
+	// Additional lists extra Caltrans KML feeds (e.g. district CMS signs or
+	// roadwork) whose placemarks are classified against monitored roads
+	// alongside lane closures and CHP incidents.
+	Additional []AdditionalCaltransFeed `koanf:"additional"`
+	// MaxBodyBytes is the largest KML download accepted from any feed; a
+	// larger body fails the fetch. Zero uses the caltrans package default.
+	MaxBodyBytes int64 `koanf:"maxBodyBytes"`
+}
+
+// AdditionalCaltransFeed is an extra Caltrans KML feed to monitor
+type AdditionalCaltransFeed struct {
+	// Name identifies the feed type, e.g. "roadwork"
+	Name string `koanf:"name"`
+	URL  string `koanf:"url"`
+	// AlertType is the alert type its placemarks become: "closure",
+	// "incident", "construction" or "weather". Defaults to "incident".
+	AlertType string `koanf:"alertType"`
+	// Timeout bounds each download of the feed. Zero uses the caltrans
+	// package default.
+	Timeout time.Duration `koanf:"timeout"`
 }
 
 // CaltransFeedConfig holds individual feed configuration
 type CaltransFeedConfig struct {
 	RefreshInterval time.Duration `koanf:"refreshInterval"`
-	URL             string        `koanf:"url"`
+	// URL overrides the feed location, e.g. to use a mirror or staging copy.
+	// Empty uses the caltrans package default.
+	URL string `koanf:"url"`
+	// Timeout bounds each download of the feed, including reading the body.
+	// Zero uses the caltrans package default.
+	Timeout time.Duration `koanf:"timeout"`
 }
 
 // MonitoredRoad represents a road to monitor
@@ -125,6 +583,134 @@ type MonitoredRoad struct {
 	Origin           Coordinates `koanf:"origin"`
 	Destination      Coordinates `koanf:"destination"`
 	LocationKeywords []string    `koanf:"locationKeywords"`
+	// MaxDistanceMeters is the NEARBY classification radius around the route.
+	// Low-density mountain highways may want a larger radius. Defaults to
+	// DefaultMaxDistanceMeters when unset.
+	MaxDistanceMeters float64 `koanf:"maxDistanceMeters"`
+	// CongestionThresholds optionally overrides roads.congestionThresholds for
+	// this road (e.g. a corridor with a higher baseline delay).
+	CongestionThresholds CongestionThresholds `koanf:"congestionThresholds"`
+	// StartMileMarker and EndMileMarker are the Caltrans mile markers
+	// (postmiles) at the origin and destination. When set, an alert naming a
+	// marker between them ("MM 31") is placed along the route by that marker
+	// rather than its coordinates. Leave both unset when unknown.
+	StartMileMarker float64 `koanf:"startMileMarker"`
+	EndMileMarker   float64 `koanf:"endMileMarker"`
+	// MaintenanceWindows are recurring times of scheduled work (e.g. nightly
+	// lane closures). An on-route closure during one is reported as
+	// maintenance rather than an unexpected restriction.
+	MaintenanceWindows []MaintenanceWindow `koanf:"maintenanceWindows"`
+}
+
+// MaintenanceWindow is a recurring span of local time, in
+// roads.calendarTimezone, when a road has scheduled maintenance.
+type MaintenanceWindow struct {
+	// Days the window starts on ("mon", "tuesday", ...). Empty means every
+	// day.
+	Days []string `koanf:"days"`
+	// Start and End are "HH:MM" times. A window that ends at or before its
+	// start runs past midnight into the next day.
+	Start string `koanf:"start"`
+	End   string `koanf:"end"`
+	// Pattern optionally limits the window to closures whose title or
+	// description matches this case-insensitive regular expression.
+	Pattern string `koanf:"pattern"`
+}
+
+// Contains reports whether t, in the window's timezone, falls within the
+// window. An invalid window contains nothing.
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	start, startErr := parseClock(w.Start)
+	end, endErr := parseClock(w.End)
+	days, daysErr := w.weekdays()
+	if startErr != nil || endErr != nil || daysErr != nil {
+		return false
+	}
+	startsOn := func(day time.Weekday) bool { return days == nil || days[day] }
+
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return startsOn(t.Weekday()) && minute >= start && minute < end
+	}
+	// Overnight: the evening part on a start day, or the morning after one
+	return (startsOn(t.Weekday()) && minute >= start) ||
+		(startsOn(t.AddDate(0, 0, -1).Weekday()) && minute < end)
+}
+
+// Matches reports whether a closure's text matches the window's Pattern;
+// every closure matches a window without one
+func (w MaintenanceWindow) Matches(text string) bool {
+	if w.Pattern == "" {
+		return true
+	}
+	re, err := regexp.Compile("(?i)" + w.Pattern)
+	return err == nil && re.MatchString(text)
+}
+
+func (w MaintenanceWindow) validate() error {
+	if _, err := parseClock(w.Start); err != nil {
+		return fmt.Errorf("start %w", err)
+	}
+	if _, err := parseClock(w.End); err != nil {
+		return fmt.Errorf("end %w", err)
+	}
+	if _, err := w.weekdays(); err != nil {
+		return err
+	}
+	if _, err := regexp.Compile("(?i)" + w.Pattern); err != nil {
+		return fmt.Errorf("pattern: %w", err)
+	}
+	return nil
+}
+
+// weekdays returns the days the window starts on, or nil for every day
+func (w MaintenanceWindow) weekdays() (map[time.Weekday]bool, error) {
+	if len(w.Days) == 0 {
+		return nil, nil
+	}
+	days := make(map[time.Weekday]bool, len(w.Days))
+	for _, name := range w.Days {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q", name)
+		}
+		days[day] = true
+	}
+	return days, nil
+}
+
+// parseWeekday parses a day name, full or abbreviated to three letters
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock parses an "HH:MM" time of day into minutes past midnight
+func parseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an HH:MM time", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// DefaultMaxDistanceMeters is the NEARBY radius used when a monitored road
+// doesn't configure one.
+const DefaultMaxDistanceMeters = 5000
+
+// MaxDistance returns the road's NEARBY radius in meters, falling back to
+// DefaultMaxDistanceMeters.
+func (m MonitoredRoad) MaxDistance() float64 {
+	if m.MaxDistanceMeters > 0 {
+		return m.MaxDistanceMeters
+	}
+	return DefaultMaxDistanceMeters
 }
 
 // WeatherConfig holds weather monitoring configuration
@@ -133,12 +719,102 @@ type WeatherConfig struct {
 	NWS             NWSConfig         `koanf:"nws"`
 	RefreshInterval time.Duration     `koanf:"refreshInterval"`
 	StaleThreshold  time.Duration     `koanf:"staleThreshold"`
+	// LocationCacheTTL is how long an OpenWeatherMap response for one set of
+	// coordinates is reused before calling the API again. Defaults to
+	// DefaultLocationCacheTTL when unset.
+	LocationCacheTTL time.Duration `koanf:"locationCacheTTL"`
+	// Provider supplies current conditions, forecasts and per-location
+	// alerts: WeatherProviderOpenWeather (the default) or WeatherProviderNWS.
+	// FallbackProvider, when set, is tried whenever Provider fails. Air
+	// quality always comes from OpenWeatherMap.
+	Provider         string `koanf:"provider"`
+	FallbackProvider string `koanf:"fallbackProvider"`
+}
+
+// Weather providers
+const (
+	WeatherProviderOpenWeather = "openweather"
+	WeatherProviderNWS         = "nws"
+)
+
+// Providers returns the weather providers to try, in order
+func (w WeatherConfig) Providers() []string {
+	providers := []string{WeatherProviderOpenWeather}
+	if w.Provider != "" {
+		providers[0] = w.Provider
+	}
+	if w.FallbackProvider != "" {
+		providers = append(providers, w.FallbackProvider)
+	}
+	return providers
+}
+
+// Validate reports every problem with the weather locations, providers and
+// the refresh settings
+func (w WeatherConfig) Validate() error {
+	var errs []error
+	for _, setting := range []struct{ field, provider string }{
+		{"provider", w.Provider},
+		{"fallbackProvider", w.FallbackProvider},
+	} {
+		switch setting.provider {
+		case "", WeatherProviderOpenWeather, WeatherProviderNWS:
+		default:
+			errs = append(errs, fmt.Errorf("weather.%s must be %q or %q, got %q",
+				setting.field, WeatherProviderOpenWeather, WeatherProviderNWS, setting.provider))
+		}
+	}
+	if providers := w.Providers(); len(providers) == 2 && providers[0] == providers[1] {
+		errs = append(errs, fmt.Errorf("weather.fallbackProvider must differ from weather.provider, both are %q", providers[0]))
+	}
+	if w.RefreshInterval <= 0 {
+		errs = append(errs, fmt.Errorf("weather.refreshInterval must be positive, got %s", w.RefreshInterval))
+	}
+	if w.StaleThreshold < 0 {
+		errs = append(errs, fmt.Errorf("weather.staleThreshold must not be negative, got %s", w.StaleThreshold))
+	}
+
+	firstIndex := make(map[string]int)
+	for i, location := range w.Locations {
+		field := fmt.Sprintf("weather.locations[%d]", i)
+		if location.ID != "" {
+			field += fmt.Sprintf(" (%s)", location.ID)
+		}
+
+		switch first, seen := firstIndex[location.ID]; {
+		case location.ID == "":
+			errs = append(errs, fmt.Errorf("%s: id is required", field))
+		case seen:
+			errs = append(errs, fmt.Errorf("%s: duplicate id, also used by weather.locations[%d]", field, first))
+		default:
+			firstIndex[location.ID] = i
+		}
+
+		if err := location.Coordinates.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: coordinates %w", field, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DefaultLocationCacheTTL is the per-location OpenWeatherMap cache lifetime
+// used when weather.locationCacheTTL isn't configured.
+const DefaultLocationCacheTTL = 10 * time.Minute
+
+// LocationTTL returns the per-location cache lifetime, falling back to
+// DefaultLocationCacheTTL.
+func (w WeatherConfig) LocationTTL() time.Duration {
+	if w.LocationCacheTTL > 0 {
+		return w.LocationCacheTTL
+	}
+	return DefaultLocationCacheTTL
 }
 
 // NWSConfig holds National Weather Service (api.weather.gov) settings used for
 // authoritative zone alerts (issue #4) and fire-weather classification (issue #5).
 type NWSConfig struct {
 	// UserAgent identifies the app to api.weather.gov (required by NWS).
+	// Empty uses upstream.userAgent.
 	UserAgent string `koanf:"userAgent"`
 	// Zones is the set of NWS forecast zones covering the service area
 	// (e.g. CAZ064, CAZ065, CAZ258, CAZ259).
@@ -158,6 +834,20 @@ type Coordinates struct {
 	Longitude float64 `koanf:"longitude"`
 }
 
+// validate checks c is set and within latitude/longitude ranges. (0, 0) is
+// treated as unset: it's in the Atlantic, far from any monitored road.
+func (c Coordinates) validate() error {
+	switch {
+	case c.Latitude == 0 && c.Longitude == 0:
+		return errors.New("is not set")
+	case math.IsNaN(c.Latitude) || c.Latitude < -90 || c.Latitude > 90:
+		return fmt.Errorf("latitude %v is outside [-90, 90]", c.Latitude)
+	case math.IsNaN(c.Longitude) || c.Longitude < -180 || c.Longitude > 180:
+		return fmt.Errorf("longitude %v is outside [-180, 180]", c.Longitude)
+	}
+	return nil
+}
+
 // ToProto converts Coordinates to protobuf Coordinates
 func (c Coordinates) ToProto() *api.Coordinates {
 	return &api.Coordinates{
@@ -174,26 +864,105 @@ func (w WeatherLocation) ToProto() *api.Coordinates {
 // LoadConfig loads configuration using Prefab's config system
 // Configuration is loaded from prefab.yaml and environment variables with PF__ prefix
 func LoadConfig() *Config {
+	appConfig, err := unmarshal(prefab.Config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return appConfig
+}
+
+// Reload re-reads prefab.yaml and PF__ environment variables the way Prefab
+// does at startup, into a fresh Config, and validates it. prefab.Config is
+// left alone: only the sections the services re-read can change at runtime.
+func Reload() (*Config, error) {
+	k := koanf.New(".")
+	if path := findConfigFile(prefab.ConfigFile, "."); path != "" {
+		if err := k.Load(file.Provider(path), yaml.Parser()); err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", path, err)
+		}
+	}
+	if err := k.Load(env.Provider("PF__", ".", envKey), nil); err != nil {
+		return nil, fmt.Errorf("failed to load environment: %w", err)
+	}
+
+	appConfig, err := unmarshal(k)
+	if err != nil {
+		return nil, err
+	}
+	if err := appConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return appConfig, nil
+}
+
+// unmarshal reads each configuration section from k
+func unmarshal(k *koanf.Koanf) (*Config, error) {
 	appConfig := &Config{}
-	// Unmarshal client configurations
-	if err := prefab.Config.Unmarshal("googleRoutes", &appConfig.GoogleRoutes); err != nil {
-		log.Fatalf("Failed to unmarshal googleRoutes section: %v", err)
+	sections := []struct {
+		key    string
+		target any
+	}{
+		// Client configurations
+		{"googleRoutes", &appConfig.GoogleRoutes},
+		{"openai", &appConfig.OpenAI},
+		{"openweather", &appConfig.OpenWeather},
+		// Service configurations
+		{"roads", &appConfig.Roads},
+		{"weather", &appConfig.Weather},
+		{"hazards", &appConfig.Hazards},
+		{"logging", &appConfig.Logging},
+		{"offline", &appConfig.Offline},
+		{"admin", &appConfig.Admin},
+		{"upstream", &appConfig.Upstream},
 	}
-	if err := prefab.Config.Unmarshal("openai", &appConfig.OpenAI); err != nil {
-		log.Fatalf("Failed to unmarshal openai section: %v", err)
+	for _, section := range sections {
+		if err := k.Unmarshal(section.key, section.target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s section: %w", section.key, err)
+		}
 	}
-	if err := prefab.Config.Unmarshal("openweather", &appConfig.OpenWeather); err != nil {
-		log.Fatalf("Failed to unmarshal openweather section: %v", err)
+
+	if appConfig.Offline.Enabled {
+		for _, key := range []*string{&appConfig.GoogleRoutes.APIKey, &appConfig.OpenAI.APIKey, &appConfig.OpenWeather.APIKey} {
+			if *key == "" {
+				*key = OfflineAPIKey
+			}
+		}
 	}
-	// Unmarshal service configurations
-	if err := prefab.Config.Unmarshal("roads", &appConfig.Roads); err != nil {
-		log.Fatalf("Failed to unmarshal roads section: %v", err)
+	return appConfig, nil
+}
+
+// findConfigFile looks for filename in dir and then each parent, as Prefab
+// does for prefab.yaml
+func findConfigFile(filename, dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
 	}
-	if err := prefab.Config.Unmarshal("weather", &appConfig.Weather); err != nil {
-		log.Fatalf("Failed to unmarshal weather section: %v", err)
+	for {
+		path := filepath.Join(dir, filename)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
 	}
-	if err := prefab.Config.Unmarshal("hazards", &appConfig.Hazards); err != nil {
-		log.Fatalf("Failed to unmarshal hazards section: %v", err)
+}
+
+// envKey maps an environment variable to a config key as Prefab does:
+// PF__ROADS__REFRESH_INTERVAL becomes roads.refreshInterval
+func envKey(name string) string {
+	segments := strings.Split(strings.ToLower(strings.TrimPrefix(name, "PF__")), "__")
+	for i, segment := range segments {
+		parts := strings.Split(segment, "_")
+		for j := 1; j < len(parts); j++ {
+			if parts[j] != "" {
+				parts[j] = strings.ToUpper(parts[j][:1]) + parts[j][1:]
+			}
+		}
+		segments[i] = strings.Join(parts, "")
 	}
-	return appConfig
+	return strings.Join(segments, ".")
 }
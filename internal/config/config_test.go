@@ -0,0 +1,316 @@
+package config
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validRoads is two distinct Hwy 4 / Hwy 49 corridors
+func validRoads() RoadsConfig {
+	return RoadsConfig{
+		RefreshInterval: 5 * time.Minute,
+		StaleThreshold:  10 * time.Minute,
+		MonitoredRoads: []MonitoredRoad{
+			{
+				ID:          "hwy4-angels-murphys",
+				Origin:      Coordinates{Latitude: 38.0674, Longitude: -120.5402},
+				Destination: Coordinates{Latitude: 38.139117, Longitude: -120.456111},
+			},
+			{
+				ID:          "hwy49-angels-sonora",
+				Origin:      Coordinates{Latitude: 38.0674, Longitude: -120.5402},
+				Destination: Coordinates{Latitude: 37.9841, Longitude: -120.3822},
+			},
+		},
+	}
+}
+
+func TestRoadsConfigValidate_Valid(t *testing.T) {
+	assert.NoError(t, validRoads().Validate())
+
+	// Unset stale threshold is allowed
+	cfg := validRoads()
+	cfg.StaleThreshold = 0
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestRoadsConfigValidate_Failures(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*RoadsConfig)
+		want   string
+	}{
+		{
+			name:   "zero refresh interval",
+			modify: func(r *RoadsConfig) { r.RefreshInterval = 0 },
+			want:   "roads.refreshInterval must be positive, got 0s",
+		},
+		{
+			name:   "negative refresh interval",
+			modify: func(r *RoadsConfig) { r.RefreshInterval = -time.Minute },
+			want:   "roads.refreshInterval must be positive, got -1m0s",
+		},
+		{
+			name:   "negative stale threshold",
+			modify: func(r *RoadsConfig) { r.StaleThreshold = -time.Minute },
+			want:   "roads.staleThreshold must not be negative",
+		},
+		{
+			name: "stagger longer than the refresh interval",
+			modify: func(r *RoadsConfig) {
+				r.RefreshJitter = time.Minute
+				r.RefreshStagger = 4 * time.Minute
+			},
+			want: "roads.refreshJitter plus roads.refreshStagger (5m0s) must be shorter than roads.refreshInterval (5m0s)",
+		},
+		{
+			name: "bad maintenance window",
+			modify: func(r *RoadsConfig) {
+				r.MonitoredRoads[0].MaintenanceWindows = []MaintenanceWindow{{Days: []string{"mon", "funday"}, Start: "21:00", End: "05:00"}}
+			},
+			want: `roads.monitoredRoads[0] (hwy4-angels-murphys): maintenanceWindows[0]: unknown day "funday"`,
+		},
+		{
+			name:   "negative enhancement queue wait",
+			modify: func(r *RoadsConfig) { r.EnhancementQueueWait = -time.Second },
+			want:   "roads.enhancementQueueWait must not be negative, got -1s",
+		},
+		{
+			name:   "negative KML body limit",
+			modify: func(r *RoadsConfig) { r.CaltransFeeds.MaxBodyBytes = -1 },
+			want:   "roads.caltransFeeds.maxBodyBytes must not be negative, got -1",
+		},
+		{
+			name:   "missing id",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[1].ID = "" },
+			want:   "roads.monitoredRoads[1]: id is required",
+		},
+		{
+			name:   "duplicate id",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[1].ID = "hwy4-angels-murphys" },
+			want:   "roads.monitoredRoads[1] (hwy4-angels-murphys): duplicate id, also used by roads.monitoredRoads[0]",
+		},
+		{
+			name:   "latitude out of range",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[0].Origin.Latitude = 380.674 },
+			want:   "roads.monitoredRoads[0] (hwy4-angels-murphys): origin latitude 380.674 is outside [-90, 90]",
+		},
+		{
+			name: "latitude and longitude swapped",
+			modify: func(r *RoadsConfig) {
+				r.MonitoredRoads[0].Destination = Coordinates{Latitude: -120.456111, Longitude: 38.139117}
+			},
+			want: "destination latitude -120.456111 is outside [-90, 90]",
+		},
+		{
+			name:   "longitude out of range",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[0].Destination.Longitude = -1204.56 },
+			want:   "destination longitude -1204.56 is outside [-180, 180]",
+		},
+		{
+			name:   "NaN coordinate",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[0].Origin.Longitude = math.NaN() },
+			want:   "origin longitude NaN is outside [-180, 180]",
+		},
+		{
+			name:   "coordinates not set",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[1].Destination = Coordinates{} },
+			want:   "roads.monitoredRoads[1] (hwy49-angels-sonora): destination is not set",
+		},
+		{
+			name:   "origin equals destination",
+			modify: func(r *RoadsConfig) { r.MonitoredRoads[1].Destination = r.MonitoredRoads[1].Origin },
+			want:   "roads.monitoredRoads[1] (hwy49-angels-sonora): origin and destination are the same point",
+		},
+		{
+			name:   "invalid ignored incident pattern",
+			modify: func(r *RoadsConfig) { r.IgnoredIncidentPatterns = []string{"animal", "hazard ("} },
+			want:   "roads.ignoredIncidentPatterns[1]: error parsing regexp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validRoads()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestRoadsConfigValidate_ReportsEveryProblem(t *testing.T) {
+	cfg := validRoads()
+	cfg.RefreshInterval = 0
+	cfg.MonitoredRoads[0].ID = ""
+	cfg.MonitoredRoads[1].Origin = Coordinates{}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "roads.refreshInterval")
+	assert.Contains(t, err.Error(), "roads.monitoredRoads[0]: id is required")
+	assert.Contains(t, err.Error(), "roads.monitoredRoads[1] (hwy49-angels-sonora): origin is not set")
+}
+
+func TestWeatherConfigValidate(t *testing.T) {
+	valid := func() WeatherConfig {
+		return WeatherConfig{
+			RefreshInterval: 5 * time.Minute,
+			Locations: []WeatherLocation{
+				{ID: "murphys", Coordinates: Coordinates{Latitude: 38.139117, Longitude: -120.456111}},
+				{ID: "arnold", Coordinates: Coordinates{Latitude: 38.2555, Longitude: -120.3516}},
+			},
+		}
+	}
+	assert.NoError(t, valid().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*WeatherConfig)
+		want   string
+	}{
+		{
+			name:   "zero refresh interval",
+			modify: func(w *WeatherConfig) { w.RefreshInterval = 0 },
+			want:   "weather.refreshInterval must be positive",
+		},
+		{
+			name:   "duplicate id",
+			modify: func(w *WeatherConfig) { w.Locations[1].ID = "murphys" },
+			want:   "weather.locations[1] (murphys): duplicate id, also used by weather.locations[0]",
+		},
+		{
+			name:   "coordinates not set",
+			modify: func(w *WeatherConfig) { w.Locations[0].Coordinates = Coordinates{} },
+			want:   "weather.locations[0] (murphys): coordinates is not set",
+		},
+		{
+			name:   "unknown provider",
+			modify: func(w *WeatherConfig) { w.Provider = "darksky" },
+			want:   `weather.provider must be "openweather" or "nws", got "darksky"`,
+		},
+		{
+			name:   "fallback same as default provider",
+			modify: func(w *WeatherConfig) { w.FallbackProvider = WeatherProviderOpenWeather },
+			want:   `weather.fallbackProvider must differ from weather.provider, both are "openweather"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+const reloadYAML = `
+roads:
+  refreshInterval: "5m"
+  monitoredRoads:
+    - id: "hwy4-angels-murphys"
+      name: "Hwy 4"
+      origin: {latitude: 38.0674, longitude: -120.5402}
+      destination: {latitude: 38.139117, longitude: -120.456111}
+weather:
+  refreshInterval: "5m"
+  locations:
+    - id: "murphys"
+      coordinates: {latitude: 38.139117, longitude: -120.456111}
+`
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "prefab.yaml"), []byte(reloadYAML), 0o600))
+	t.Chdir(dir)
+	t.Setenv("PF__ROADS__REFRESH_INTERVAL", "10m")
+
+	cfg, err := Reload()
+	require.NoError(t, err)
+	require.Len(t, cfg.Roads.MonitoredRoads, 1)
+	assert.Equal(t, "hwy4-angels-murphys", cfg.Roads.MonitoredRoads[0].ID)
+	assert.Equal(t, 10*time.Minute, cfg.Roads.RefreshInterval, "environment overrides the file")
+	assert.Equal(t, 5*time.Minute, cfg.Weather.RefreshInterval)
+	require.Len(t, cfg.Weather.Locations, 1)
+}
+
+func TestReload_RejectsInvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	invalid := strings.Replace(reloadYAML, "latitude: 38.0674", "latitude: 380.674", 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "prefab.yaml"), []byte(invalid), 0o600))
+	t.Chdir(dir)
+
+	_, err := Reload()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "origin latitude 380.674 is outside [-90, 90]")
+}
+
+func TestReload_OfflineFillsEmptyAPIKeys(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "prefab.yaml"), []byte(reloadYAML), 0o600))
+	t.Chdir(dir)
+	t.Setenv("PF__OFFLINE__ENABLED", "true")
+	t.Setenv("PF__OPENWEATHER__API_KEY", "real-key")
+
+	cfg, err := Reload()
+	require.NoError(t, err)
+	assert.True(t, cfg.Offline.Enabled)
+	assert.Equal(t, OfflineAPIKey, cfg.GoogleRoutes.APIKey)
+	assert.Equal(t, OfflineAPIKey, cfg.OpenAI.APIKey)
+	assert.Equal(t, "real-key", cfg.OpenWeather.APIKey, "configured keys are kept")
+}
+
+func TestOpenAIClientCircuitBreaker(t *testing.T) {
+	failures, cooldown := OpenAIClient{}.CircuitBreaker()
+	assert.Equal(t, DefaultCircuitBreakerFailures, failures)
+	assert.Equal(t, DefaultCircuitBreakerCooldown, cooldown)
+
+	failures, cooldown = OpenAIClient{CircuitBreakerFailures: -1, CircuitBreakerCooldown: time.Minute}.CircuitBreaker()
+	assert.Equal(t, -1, failures, "negative disables the breaker")
+	assert.Equal(t, time.Minute, cooldown)
+}
+
+func TestGoogleRoutesClientCircuitBreaker(t *testing.T) {
+	failures, cooldown := GoogleRoutesClient{}.CircuitBreaker()
+	assert.Equal(t, DefaultGoogleRoutesCircuitBreakerFailures, failures)
+	assert.Equal(t, DefaultGoogleRoutesCircuitBreakerCooldown, cooldown)
+
+	failures, cooldown = GoogleRoutesClient{CircuitBreakerFailures: 1, CircuitBreakerCooldown: time.Minute}.CircuitBreaker()
+	assert.Equal(t, 1, failures)
+	assert.Equal(t, time.Minute, cooldown)
+}
+
+func TestEnvKey(t *testing.T) {
+	assert.Equal(t, "roads.refreshInterval", envKey("PF__ROADS__REFRESH_INTERVAL"))
+	assert.Equal(t, "openai.apiKey", envKey("PF__OPENAI__API_KEY"))
+}
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	nightly := MaintenanceWindow{Days: []string{"Monday", "tue"}, Start: "22:00", End: "05:30"}
+	daytime := MaintenanceWindow{Start: "09:00", End: "15:00"}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC) // Oct 12 2026 is a Monday
+	}
+
+	assert.True(t, nightly.Contains(at(12, 22, 0)), "Monday night")
+	assert.True(t, nightly.Contains(at(14, 5, 29)), "early Wednesday, after a Tuesday start")
+	assert.False(t, nightly.Contains(at(14, 5, 30)), "the end is exclusive")
+	assert.False(t, nightly.Contains(at(12, 4, 0)), "early Monday follows a Sunday, not a start day")
+	assert.False(t, nightly.Contains(at(14, 23, 0)), "Wednesday night")
+	assert.False(t, nightly.Contains(at(12, 12, 0)), "Monday midday")
+
+	assert.True(t, daytime.Contains(at(17, 9, 0)), "no days means every day")
+	assert.False(t, daytime.Contains(at(17, 15, 0)))
+
+	assert.False(t, MaintenanceWindow{Start: "25:00", End: "05:00"}.Contains(at(12, 23, 0)), "an invalid window contains nothing")
+}
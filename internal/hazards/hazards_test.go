@@ -7,6 +7,8 @@ import (
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -154,6 +156,8 @@ func TestSafeURL(t *testing.T) {
 type fakeRoads struct {
 	incidents []*api.Incident
 	roads     []*api.Road
+	geometry  *api.GetRouteGeometryResponse
+	monitored []config.MonitoredRoad
 }
 
 func (f fakeRoads) ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error) {
@@ -162,6 +166,21 @@ func (f fakeRoads) ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListR
 func (f fakeRoads) ListIncidents(context.Context, *api.ListIncidentsRequest) (*api.ListIncidentsResponse, error) {
 	return &api.ListIncidentsResponse{Incidents: f.incidents}, nil
 }
+func (f fakeRoads) GetRoad(_ context.Context, req *api.GetRoadRequest) (*api.GetRoadResponse, error) {
+	for _, rd := range f.roads {
+		if rd.GetId() == req.GetRoadId() {
+			return &api.GetRoadResponse{Road: rd}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "road not found: %s", req.GetRoadId())
+}
+func (f fakeRoads) MonitoredRoads() []config.MonitoredRoad { return f.monitored }
+func (f fakeRoads) GetRouteGeometry(_ context.Context, req *api.GetRouteGeometryRequest) (*api.GetRouteGeometryResponse, error) {
+	if f.geometry == nil || f.geometry.GetRoadId() != req.GetRoadId() {
+		return nil, status.Errorf(codes.NotFound, "road not found: %s", req.GetRoadId())
+	}
+	return f.geometry, nil
+}
 
 func TestRoadIncidents_Reprojection(t *testing.T) {
 	s := &Service{
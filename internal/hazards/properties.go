@@ -52,9 +52,12 @@ type Source struct {
 	FetchedAt   string `json:"fetched_at,omitempty"`
 }
 
-// IncidentProps is the road_incident kind block.
+// IncidentProps is the road_incident kind block. Classification and distance
+// are only set on a single road's alerts (GET /api/v1/roads/{road_id}/geojson).
 type IncidentProps struct {
-	LogNumber string `json:"log_number,omitempty"`
+	LogNumber             string  `json:"log_number,omitempty"`
+	Classification        string  `json:"classification,omitempty"` // on_route | nearby
+	DistanceToRouteMeters float64 `json:"distance_to_route_meters,omitempty"`
 }
 
 // RoadProps is the road_segment kind block. The numeric fields are pointers so a
@@ -0,0 +1,177 @@
+package hazards
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// RoadGeoJSONPattern mounts the per-road GeoJSON endpoint. It is a method +
+// wildcard ServeMux pattern, more specific than the gateway's "/api/", so the
+// gateway keeps serving the rest of /api/v1/roads/{road_id}/...
+const RoadGeoJSONPattern = "GET /api/v1/roads/{road_id}/geojson"
+
+// ServeRoadGeoJSON handles GET /api/v1/roads/{road_id}/geojson: the road's
+// route as a LineString plus a feature per alert classified against it. The
+// geometry is the same the road was built from (GetRouteGeometry); severity
+// and type come from the road's alerts.
+func (s *Service) ServeRoadGeoJSON(w http.ResponseWriter, r *http.Request) {
+	roadID := r.PathValue("road_id")
+	ctx := r.Context()
+
+	fc, err := s.roadCollection(ctx, roadID)
+	if err != nil {
+		code := runtime.HTTPStatusFromCode(status.Code(err))
+		if code == http.StatusInternalServerError {
+			logging.Errorw(ctx, "Failed to build road GeoJSON", "road_id", roadID, "error", err)
+			code = http.StatusServiceUnavailable
+		}
+		http.Error(w, fmt.Sprintf("road geometry unavailable: %s", roadID), code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	if err := json.NewEncoder(w).Encode(fc); err != nil {
+		logging.Errorw(ctx, "Failed to encode road GeoJSON", "error", err)
+	}
+}
+
+// roadCollection builds a road's FeatureCollection. The route feature comes
+// first so map clients draw alerts on top of it.
+func (s *Service) roadCollection(ctx context.Context, roadID string) (FeatureCollection, error) {
+	geometry, err := s.roads.GetRouteGeometry(ctx, &api.GetRouteGeometryRequest{RoadId: roadID})
+	if err != nil {
+		return FeatureCollection{}, err
+	}
+	roadResp, err := s.roads.GetRoad(ctx, &api.GetRoadRequest{RoadId: roadID})
+	if err != nil {
+		return FeatureCollection{}, err
+	}
+	road := roadResp.GetRoad()
+
+	features := []Feature{routeFeature(road, geometry)}
+
+	alertsByKey := make(map[string]*api.RoadAlert, len(road.GetAlerts()))
+	for _, a := range road.GetAlerts() {
+		alertsByKey[alertKey(a.GetId(), a.GetTitle())] = a
+	}
+	for _, ag := range geometry.GetAlerts() {
+		features = append(features, alertFeature(ag, alertsByKey[alertKey(ag.GetId(), ag.GetTitle())]))
+	}
+
+	// A single road's collection is scoped by road, not hazard area
+	return newCollection(features, &Metadata{
+		Layer:            LayerRoadSegment,
+		Area:             roadID,
+		GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+		SourceStatus:     "OK",
+		LastSourceUpdate: tsToRFC3339(geometry.GetLastUpdated()),
+		SchemaVersion:    schemaVersion,
+	}), nil
+}
+
+// routeFeature is the road's route polyline with the road_segment envelope
+func routeFeature(road *api.Road, geometry *api.GetRouteGeometryResponse) Feature {
+	var line []LatLng
+	if points, err := geo.NewGeoUtils().DecodePolyline(geometry.GetEncodedPolyline()); err == nil {
+		line = latLngs(points)
+	}
+	geom := LineStringGeom(line)
+	if geom == nil {
+		// No usable polyline: fall back to the straight segment between endpoints
+		origin, dest := geometry.GetOrigin(), geometry.GetDestination()
+		geom = LineStringGeom([]LatLng{
+			{Lat: origin.GetLatitude(), Lng: origin.GetLongitude()},
+			{Lat: dest.GetLatitude(), Lng: dest.GetLongitude()},
+		})
+	}
+
+	p := Properties{
+		ID:          "road:" + geometry.GetRoadId(),
+		Layer:       LayerRoadSegment,
+		Kind:        "Road segment",
+		Headline:    strings.TrimSpace(road.GetName() + " — " + road.GetSection()),
+		Description: road.GetStatusExplanation(),
+		Status:      strings.ToLower(strings.TrimPrefix(road.GetStatus().String(), "ROAD_STATUS_")),
+		AreaLabel:   road.GetSection(),
+		UpdatedAt:   tsToRFC3339(geometry.GetLastUpdated()),
+		Source:      Source{ID: "google", Name: "Google Routes + Caltrans"},
+		Road: &RoadProps{
+			RoadID:          geometry.GetRoadId(),
+			Congestion:      strings.TrimPrefix(road.GetCongestionLevel().String(), "CONGESTION_LEVEL_"),
+			DelayMinutes:    i32ptr(road.GetDelayMinutes()),
+			DurationMinutes: i32ptr(road.GetDurationMinutes()),
+			DistanceKm:      i32ptr(road.GetDistanceKm()),
+		},
+	}
+	p.setSeverity(roadSeverity(road))
+	return Feature{Type: "Feature", Geometry: geom, Properties: p}
+}
+
+// alertFeature is an alert's closure extent as a LineString, or its location
+// as a Point for point incidents. alert is the matching RoadAlert, nil when
+// the road no longer lists it.
+func alertFeature(ag *api.AlertGeometry, alert *api.RoadAlert) Feature {
+	var geom *Geometry
+	if encoded := ag.GetAffectedPolyline(); encoded != "" {
+		if points, err := geo.NewGeoUtils().DecodePolyline(encoded); err == nil {
+			geom = LineStringGeom(latLngs(points))
+		}
+	}
+	if geom == nil {
+		geom = PointGeom(ag.GetLocation().GetLatitude(), ag.GetLocation().GetLongitude())
+	}
+
+	p := Properties{
+		ID:       "alert:" + nonEmpty(ag.GetId(), ag.GetTitle()),
+		Layer:    LayerRoadIncident,
+		Kind:     "Road alert",
+		Headline: ag.GetTitle(),
+		Source:   Source{ID: "chp", Name: "CHP / Caltrans", Attribution: "quickmap.dot.ca.gov"},
+		Incident: &IncidentProps{
+			LogNumber:             ag.GetId(),
+			Classification:        strings.ToLower(strings.TrimPrefix(ag.GetClassification().String(), "ALERT_CLASSIFICATION_")),
+			DistanceToRouteMeters: ag.GetDistanceToRouteMeters(),
+		},
+	}
+	severity := SevInfo
+	if alert != nil {
+		p.Category = strings.ToLower(strings.TrimPrefix(alert.GetType().String(), "ALERT_TYPE_"))
+		p.Description = alert.GetDescription()
+		p.AreaLabel = alert.GetLocationDescription()
+		p.Effective = tsToRFC3339(alert.GetStartTime())
+		p.Expires = tsToRFC3339(alert.GetEndTime())
+		p.UpdatedAt = tsToRFC3339(alert.GetLastUpdated())
+		severity = fromAlertSeverity(alert.GetSeverity())
+	}
+	p.setSeverity(severity)
+	return Feature{Type: "Feature", Geometry: geom, Properties: p}
+}
+
+// alertKey pairs an AlertGeometry with its RoadAlert: by log number, or by
+// title for alerts without one
+func alertKey(id, title string) string {
+	if id != "" {
+		return "id:" + id
+	}
+	return "title:" + title
+}
+
+func latLngs(points []geo.Point) []LatLng {
+	out := make([]LatLng, len(points))
+	for i, p := range points {
+		out[i] = LatLng{Lat: p.Latitude, Lng: p.Longitude}
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package hazards
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// roadGeoJSONMux mounts the handler beside a catch-all standing in for the
+// gateway's "/api/", as Prefab does
+func roadGeoJSONMux(s *Service) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(RoadGeoJSONPattern, s.ServeRoadGeoJSON)
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	return mux
+}
+
+func TestServeRoadGeoJSON(t *testing.T) {
+	gu := geo.NewGeoUtils()
+	route := []geo.Point{{Latitude: 38.0674, Longitude: -120.5402}, {Latitude: 38.1, Longitude: -120.5}, {Latitude: 38.1391, Longitude: -120.4561}}
+	closure := []geo.Point{{Latitude: 38.1, Longitude: -120.5}, {Latitude: 38.12, Longitude: -120.48}}
+
+	s := &Service{
+		cfg: &config.Config{},
+		roads: fakeRoads{
+			roads: []*api.Road{{
+				Id: "hwy4", Name: "Hwy 4", Section: "Angels Camp to Murphys",
+				Status: api.RoadStatus_OPEN,
+				Alerts: []*api.RoadAlert{
+					{Id: "260625SA0982", Title: "CHP Incident 260625SA0982", Type: api.AlertType_INCIDENT, Severity: api.AlertSeverity_CRITICAL},
+					{Title: "Lane closure", Type: api.AlertType_CLOSURE, Severity: api.AlertSeverity_WARNING},
+				},
+			}},
+			geometry: &api.GetRouteGeometryResponse{
+				RoadId:          "hwy4",
+				EncodedPolyline: gu.EncodePolyline(route),
+				Alerts: []*api.AlertGeometry{
+					{Id: "260625SA0982", Title: "CHP Incident 260625SA0982", Classification: api.AlertClassification_ON_ROUTE,
+						Location: &api.Coordinates{Latitude: 38.1, Longitude: -120.5}},
+					{Title: "Lane closure", Classification: api.AlertClassification_NEARBY, AffectedPolyline: gu.EncodePolyline(closure),
+						Location: &api.Coordinates{Latitude: 38.1, Longitude: -120.5}, DistanceToRouteMeters: 250},
+				},
+			},
+		},
+	}
+	mux := roadGeoJSONMux(s)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/roads/hwy4/geojson", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/geo+json" {
+		t.Errorf("content type = %q", ct)
+	}
+
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties Properties `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &fc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 3 {
+		t.Fatalf("got %s with %d features, want FeatureCollection with 3", fc.Type, len(fc.Features))
+	}
+
+	// The route decodes to a LineString in [lon, lat] order
+	routeFeature := fc.Features[0]
+	if routeFeature.Type != "Feature" || routeFeature.Geometry.Type != "LineString" {
+		t.Fatalf("route feature = %s/%s, want Feature/LineString", routeFeature.Type, routeFeature.Geometry.Type)
+	}
+	var line [][]float64
+	if err := json.Unmarshal(routeFeature.Geometry.Coordinates, &line); err != nil {
+		t.Fatalf("route coordinates: %v", err)
+	}
+	if len(line) != len(route) || line[0][0] != -120.5402 || line[0][1] != 38.0674 {
+		t.Errorf("route coordinates = %v", line)
+	}
+	if routeFeature.Properties.Road == nil || routeFeature.Properties.Road.RoadID != "hwy4" {
+		t.Errorf("route properties = %+v", routeFeature.Properties)
+	}
+
+	incident := fc.Features[1]
+	if incident.Geometry.Type != "Point" || incident.Properties.Severity != SevSevere ||
+		incident.Properties.Incident.Classification != "on_route" || incident.Properties.Category != "incident" {
+		t.Errorf("incident feature = %s %+v %+v", incident.Geometry.Type, incident.Properties, incident.Properties.Incident)
+	}
+
+	closureFeature := fc.Features[2]
+	if closureFeature.Geometry.Type != "LineString" || closureFeature.Properties.Severity != SevModerate ||
+		closureFeature.Properties.Incident.Classification != "nearby" || closureFeature.Properties.Category != "closure" {
+		t.Errorf("closure feature = %s %+v %+v", closureFeature.Geometry.Type, closureFeature.Properties, closureFeature.Properties.Incident)
+	}
+}
+
+func TestServeRoadGeoJSON_UnknownRoad(t *testing.T) {
+	mux := roadGeoJSONMux(&Service{cfg: &config.Config{}, roads: fakeRoads{}})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/roads/nope/geojson", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", w.Code)
+	}
+
+	// Other road paths still reach the gateway
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/roads/hwy4", nil))
+	if w.Code != http.StatusTeapot {
+		t.Errorf("gateway path status = %d, want it left to the gateway", w.Code)
+	}
+}
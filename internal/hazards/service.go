@@ -28,6 +28,10 @@ import (
 type roadsAPI interface {
 	ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error)
 	ListIncidents(context.Context, *api.ListIncidentsRequest) (*api.ListIncidentsResponse, error)
+	GetRoad(context.Context, *api.GetRoadRequest) (*api.GetRoadResponse, error)
+	GetRouteGeometry(context.Context, *api.GetRouteGeometryRequest) (*api.GetRouteGeometryResponse, error)
+	// MonitoredRoads is the current road set, which a config reload can change
+	MonitoredRoads() []config.MonitoredRoad
 }
 type weatherAPI interface {
 	ListWeather(context.Context, *api.ListWeatherRequest) (*api.ListWeatherResponse, error)
@@ -45,7 +49,7 @@ type Service struct {
 	calfire  *calfire.Client
 	wfigs    *wfigs.Client
 	caloes   *caloes.Client
-	cache    *cache.Cache
+	cache    cache.Store
 
 	// layerBuilders and layerOrder are derived once from layerRegistry() so the
 	// dispatch map and the situation iteration order share one source of truth.
@@ -57,7 +61,7 @@ type Service struct {
 // new-upstream clients (USGS, CAL FIRE, WFIGS, ...) are keyless and constructed
 // here. The shared cache is reused for stale-on-error resilience on the new
 // upstreams (see buildLayer); pass nil to disable hazard-layer caching.
-func NewService(cfg *config.Config, roads *services.RoadsService, weather *services.WeatherService, ct *caltrans.FeedParser, c *cache.Cache) *Service {
+func NewService(cfg *config.Config, roads *services.RoadsService, weather *services.WeatherService, ct *caltrans.FeedParser, c cache.Store) *Service {
 	s := &Service{
 		cfg:      cfg,
 		roads:    roads,
@@ -389,7 +393,7 @@ func (s *Service) roadSegments(ctx context.Context, area config.HazardArea) ([]F
 	}
 
 	var out []Feature
-	for _, mr := range s.cfg.Roads.MonitoredRoads {
+	for _, mr := range s.roads.MonitoredRoads() {
 		// Include the segment if either endpoint is in the area.
 		if !area.Bounds.Contains(mr.Origin.Latitude, mr.Origin.Longitude) &&
 			!area.Bounds.Contains(mr.Destination.Latitude, mr.Destination.Longitude) {
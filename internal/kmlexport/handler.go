@@ -0,0 +1,152 @@
+// Package kmlexport re-emits the monitored corridors as KML in the shape of
+// the Caltrans quickmap feeds: one Folder per road holding the route as a
+// LineString Placemark and a Placemark per classified alert. Mapping tools
+// that already read the quickmap feeds can load it unchanged.
+package kmlexport
+
+import (
+	"context"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// Pattern mounts the export. It sits outside the gateway's /api/v1/roads/
+// routes, so it can't shadow a road id.
+const Pattern = "GET /api/v1/roads.kml"
+
+// roadsAPI is the slice of the roads service the export reads. An interface
+// keeps the package testable.
+type roadsAPI interface {
+	ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error)
+	GetRouteGeometry(context.Context, *api.GetRouteGeometryRequest) (*api.GetRouteGeometryResponse, error)
+}
+
+// Handler serves GET /api/v1/roads.kml
+type Handler struct {
+	roads    roadsAPI
+	geoUtils geo.GeoUtils
+}
+
+// NewHandler creates a Handler exporting roads
+func NewHandler(roads roadsAPI) *Handler {
+	return &Handler{roads: roads, geoUtils: geo.NewGeoUtils()}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	doc, err := h.Document(ctx)
+	if err != nil {
+		logging.Errorw(ctx, "Failed to build corridor KML", "error", err)
+		http.Error(w, "road data unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	data, err := caltrans.EncodeKML(doc)
+	if err != nil {
+		logging.Errorw(ctx, "Failed to encode corridor KML", "error", err)
+		http.Error(w, "failed to encode KML", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.google-earth.kml+xml")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	_, _ = w.Write(data)
+}
+
+// Document builds the KML document: a Folder per monitored road. A road
+// whose route geometry can't be read still gets its alerts as points.
+func (h *Handler) Document(ctx context.Context) (caltrans.Document, error) {
+	resp, err := h.roads.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		return caltrans.Document{}, err
+	}
+
+	doc := caltrans.Document{Name: "ERSN monitored corridors"}
+	for _, road := range resp.GetRoads() {
+		geometry, err := h.roads.GetRouteGeometry(ctx, &api.GetRouteGeometryRequest{RoadId: road.GetId()})
+		if err != nil {
+			logging.Warnw(ctx, "No route geometry for KML export", "road_id", road.GetId(), "error", err)
+			geometry = nil
+		}
+		doc.Folders = append(doc.Folders, h.roadFolder(road, geometry))
+	}
+	return doc, nil
+}
+
+// roadFolder is a road's route Placemark followed by its alerts. geometry
+// may be nil.
+func (h *Handler) roadFolder(road *api.Road, geometry *api.GetRouteGeometryResponse) caltrans.Folder {
+	name := strings.TrimSpace(road.GetName() + " - " + road.GetSection())
+	folder := caltrans.Folder{Name: name}
+
+	if points := h.decode(geometry.GetEncodedPolyline()); len(points) >= 2 {
+		folder.Placemarks = append(folder.Placemarks, caltrans.Placemark{
+			Name:        name,
+			Description: describe(road.GetStatusExplanation(), "Status: "+road.GetStatus().String(), "Congestion: "+road.GetCongestionLevel().String()),
+			LineString:  caltrans.LineString{Coordinates: caltrans.FormatCoordinates(points...)},
+		})
+	}
+
+	// Closure extents, keyed as the service pairs AlertGeometry with RoadAlert
+	extents := make(map[string]string)
+	for _, ag := range geometry.GetAlerts() {
+		extents[alertKey(ag.GetId(), ag.GetTitle())] = ag.GetAffectedPolyline()
+	}
+
+	for _, alert := range road.GetAlerts() {
+		loc := alert.GetLocation()
+		if loc == nil {
+			continue
+		}
+		placemark := caltrans.Placemark{
+			Name: alert.GetTitle(),
+			Description: describe(alert.GetDescription(),
+				"Severity: "+alert.GetSeverity().String(),
+				"Classification: "+alert.GetClassification().String()),
+			Point: caltrans.Point{Coordinates: caltrans.FormatCoordinates(geo.Point{Latitude: loc.GetLatitude(), Longitude: loc.GetLongitude()})},
+		}
+		if points := h.decode(extents[alertKey(alert.GetId(), alert.GetTitle())]); len(points) >= 2 {
+			placemark.LineString = caltrans.LineString{Coordinates: caltrans.FormatCoordinates(points...)}
+		}
+		folder.Placemarks = append(folder.Placemarks, placemark)
+	}
+	return folder
+}
+
+func (h *Handler) decode(encoded string) []geo.Point {
+	if encoded == "" {
+		return nil
+	}
+	points, err := h.geoUtils.DecodePolyline(encoded)
+	if err != nil {
+		return nil
+	}
+	return points
+}
+
+// describe joins lines into the <br/>-separated HTML the quickmap feeds use
+func describe(lines ...string) string {
+	var parts []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			parts = append(parts, html.EscapeString(line))
+		}
+	}
+	return strings.Join(parts, "<br/>")
+}
+
+// alertKey pairs an AlertGeometry with its RoadAlert: by log number, or by
+// title for alerts without one
+func alertKey(id, title string) string {
+	if id != "" {
+		return "id:" + id
+	}
+	return "title:" + title
+}
@@ -0,0 +1,114 @@
+package kmlexport
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+type fakeRoads struct {
+	roads      []*api.Road
+	geometries map[string]*api.GetRouteGeometryResponse
+}
+
+func (f fakeRoads) ListRoads(context.Context, *api.ListRoadsRequest) (*api.ListRoadsResponse, error) {
+	return &api.ListRoadsResponse{Roads: f.roads}, nil
+}
+
+func (f fakeRoads) GetRouteGeometry(_ context.Context, req *api.GetRouteGeometryRequest) (*api.GetRouteGeometryResponse, error) {
+	if g, ok := f.geometries[req.GetRoadId()]; ok {
+		return g, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "no route geometry for road: %s", req.GetRoadId())
+}
+
+func testRoads() fakeRoads {
+	gu := geo.NewGeoUtils()
+	route := []geo.Point{{Latitude: 38.0674, Longitude: -120.5402}, {Latitude: 38.1, Longitude: -120.5}, {Latitude: 38.1391, Longitude: -120.4561}}
+	closure := []geo.Point{{Latitude: 38.1, Longitude: -120.5}, {Latitude: 38.12, Longitude: -120.48}}
+
+	return fakeRoads{
+		roads: []*api.Road{
+			{
+				Id: "hwy4", Name: "Hwy 4", Section: "Angels Camp to Murphys",
+				Alerts: []*api.RoadAlert{
+					{Id: "260625SA0982", Title: "CHP Incident 260625SA0982", Description: "Traffic hazard & debris",
+						Severity: api.AlertSeverity_WARNING, Classification: api.AlertClassification_ON_ROUTE,
+						Location: &api.Coordinates{Latitude: 38.1, Longitude: -120.5}},
+					{Title: "Route 4 One-way Traffic Operation", Severity: api.AlertSeverity_INFO, Classification: api.AlertClassification_NEARBY,
+						Location: &api.Coordinates{Latitude: 38.1, Longitude: -120.5}},
+				},
+			},
+			{
+				// No route geometry yet: alerts are still exported as points
+				Id: "hwy49", Name: "Hwy 49", Section: "Angels Camp to Sonora",
+				Alerts: []*api.RoadAlert{
+					{Title: "Paving", Location: &api.Coordinates{Latitude: 38.0, Longitude: -120.4}},
+				},
+			},
+		},
+		geometries: map[string]*api.GetRouteGeometryResponse{
+			"hwy4": {
+				RoadId:          "hwy4",
+				EncodedPolyline: gu.EncodePolyline(route),
+				Alerts: []*api.AlertGeometry{
+					{Id: "260625SA0982", Title: "CHP Incident 260625SA0982"},
+					{Title: "Route 4 One-way Traffic Operation", AffectedPolyline: gu.EncodePolyline(closure)},
+				},
+			},
+		},
+	}
+}
+
+func TestServeHTTP_RoundTripsThroughParser(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(Pattern, NewHandler(testRoads()))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/roads.kml", nil)
+	mux.ServeHTTP(w, req.WithContext(logging.EnsureLogger(req.Context())))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/vnd.google-earth.kml+xml", w.Header().Get("Content-Type"))
+
+	var kml caltrans.KML
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &kml))
+	require.Len(t, kml.Document.Folders, 2)
+	assert.Equal(t, "Hwy 4 - Angels Camp to Murphys", kml.Document.Folders[0].Name)
+	assert.Len(t, kml.Document.Folders[0].Placemarks, 3, "route + 2 alerts")
+	assert.Len(t, kml.Document.Folders[1].Placemarks, 1, "alert only, no route geometry")
+
+	incidents, err := caltrans.NewFeedParser().ParseKMLContent(w.Body.Bytes(), caltrans.LANE_CLOSURE)
+	require.NoError(t, err)
+	require.Len(t, incidents, 4)
+
+	route := incidents[0]
+	assert.Equal(t, "Hwy 4 - Angels Camp to Murphys", route.Name)
+	require.NotNil(t, route.AffectedArea)
+	assert.Len(t, route.AffectedArea.Points, 3)
+
+	chp := incidents[1]
+	assert.Equal(t, "CHP Incident 260625SA0982", chp.Name)
+	assert.InDelta(t, 38.1, chp.Coordinates.Latitude, 1e-6)
+	assert.InDelta(t, -120.5, chp.Coordinates.Longitude, 1e-6)
+	assert.Nil(t, chp.AffectedArea)
+	assert.Contains(t, chp.DescriptionText, "Traffic hazard & debris")
+	assert.Contains(t, chp.DescriptionText, "Classification: ON_ROUTE")
+
+	closure := incidents[2]
+	require.NotNil(t, closure.AffectedArea, "closure extent exported as a LineString")
+	assert.Len(t, closure.AffectedArea.Points, 2)
+	assert.InDelta(t, 38.1, closure.Coordinates.Latitude, 1e-6, "point geometry stays the primary coordinate")
+
+	assert.Equal(t, "Paving", incidents[3].Name)
+}
@@ -3,21 +3,114 @@ package alerts
 import (
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 // ContentHasher provides simple content-based deduplication for alerts
-type ContentHasher struct{}
+type ContentHasher struct {
+	locationDecimals int // Decimal places coordinates are rounded to; <0 leaves them as given
+}
+
+// ContentHasherOption configures a ContentHasher
+type ContentHasherOption func(*ContentHasher)
+
+// WithLocationPrecision rounds coordinates in the alert location to decimals
+// places before hashing, so incidents within roughly that grid hash together
+// (2 places is ~1.1km, 3 is ~110m, 4 is ~11m)
+func WithLocationPrecision(decimals int) ContentHasherOption {
+	return func(h *ContentHasher) {
+		h.locationDecimals = decimals
+	}
+}
 
 // NewContentHasher creates a new content hasher
-func NewContentHasher() *ContentHasher {
-	return &ContentHasher{}
+func NewContentHasher(opts ...ContentHasherOption) *ContentHasher {
+	h := &ContentHasher{locationDecimals: -1}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// coordinatePairRe matches a "lat, lon" decimal pair inside a location string
+var coordinatePairRe = regexp.MustCompile(`(-?\d+\.\d+),\s*(-?\d+\.\d+)`)
+
+// ValidateLocation reports coordinates in an alert location that can't be a
+// real incident position: latitude outside [-90, 90], longitude outside
+// [-180, 180], or 0, 0, which feeds report for a missing position. A
+// location without coordinates is valid.
+func ValidateLocation(location string) error {
+	for _, m := range coordinatePairRe.FindAllStringSubmatch(location, -1) {
+		lat, _ := strconv.ParseFloat(m[1], 64)
+		lon, _ := strconv.ParseFloat(m[2], 64)
+		switch {
+		case lat == 0 && lon == 0:
+			return fmt.Errorf("coordinates %s, %s are unset", m[1], m[2])
+		case lat < -90 || lat > 90:
+			return fmt.Errorf("latitude %s is outside [-90, 90]", m[1])
+		case lon < -180 || lon > 180:
+			return fmt.Errorf("longitude %s is outside [-180, 180]", m[2])
+		}
+	}
+	return nil
+}
+
+// locationKey rounds any coordinates in location to the configured precision
+func (h *ContentHasher) locationKey(location string) string {
+	if h.locationDecimals < 0 {
+		return location
+	}
+	return coordinatePairRe.ReplaceAllStringFunc(location, func(pair string) string {
+		m := coordinatePairRe.FindStringSubmatch(pair)
+		return h.roundCoordinates(m[1], m[2])
+	})
+}
+
+// roundCoordinates rounds a "lat, lon" pair to locationDecimals places so
+// that one spot always gets one key: -180 and 180 are the same meridian, every
+// longitude at a pole is the same point, and -0 is 0. Out-of-range pairs are
+// left as given rather than bucketed with real positions.
+func (h *ContentHasher) roundCoordinates(latText, lonText string) string {
+	pair := latText + ", " + lonText
+	if ValidateLocation(pair) != nil {
+		return pair
+	}
+	lat, _ := strconv.ParseFloat(latText, 64)
+	lon, _ := strconv.ParseFloat(lonText, 64)
+
+	scale := math.Pow(10, float64(h.locationDecimals))
+	lat = math.Round(lat*scale) / scale
+	lon = math.Round(lon*scale) / scale
+	if lon == -180 {
+		lon = 180
+	}
+	if math.Abs(lat) == 90 {
+		lon = 0
+	}
+	return h.formatCoordinate(lat) + ", " + h.formatCoordinate(lon)
+}
+
+// formatCoordinate formats a rounded coordinate, writing -0 as 0
+func (h *ContentHasher) formatCoordinate(v float64) string {
+	if v == 0 {
+		v = 0 // Drops the sign of -0
+	}
+	return strconv.FormatFloat(v, 'f', h.locationDecimals, 64)
 }
 
 // HashRawAlert creates a content hash for deduplication
-// Much simpler than the complex incident hashing system
+// Much simpler than the complex incident hashing system. Like EventKey, an
+// alert without a valid location (see ValidateLocation) gets an empty hash
+// rather than one its coordinates can't be rounded into.
 func (h *ContentHasher) HashRawAlert(raw RawAlert) string {
+	if ValidateLocation(raw.Location) != nil {
+		return ""
+	}
+
 	// Normalize the description text for consistent hashing
 	normalizedDesc := h.normalizeText(raw.Description)
 	normalizedTitle := h.normalizeText(raw.Title)
@@ -27,20 +120,73 @@ func (h *ContentHasher) HashRawAlert(raw RawAlert) string {
 	contentSignature := fmt.Sprintf("%s|%s|%s|%s",
 		normalizedTitle,
 		normalizedDesc,
-		h.normalizeText(raw.Location),
-		raw.StyleUrl, // Include StyleUrl as it indicates incident type
+		h.normalizeText(h.locationKey(raw.Location)),
+		normalizeStyleUrl(raw.StyleUrl), // Include StyleUrl as it indicates incident type
 	)
+	// An enhancement only has the translations it was asked for. English
+	// alone adds nothing, so those hashes match the untranslated ones.
+	if languages := translationLanguages(raw.Languages); len(languages) > 0 {
+		sort.Strings(languages)
+		contentSignature += "|" + strings.Join(languages, ",")
+	}
 	
 	// Generate SHA-256 hash
 	hash := sha256.Sum256([]byte(contentSignature))
 	return fmt.Sprintf("%x", hash)
 }
 
+// normalizedHighwayRe matches the canonical highway tokens normalizeText
+// leaves behind ("route 4")
+var normalizedHighwayRe = regexp.MustCompile(`\broute (\d+)\b`)
+
+// EventKey keys reports of one event from different feeds, whose titles and
+// wording differ: the location rounded to the hasher's precision plus the
+// highways named in the normalized title and description. Alerts without a
+// valid location (see ValidateLocation) get an empty key and shouldn't be
+// matched.
+func (h *ContentHasher) EventKey(raw RawAlert) string {
+	if raw.Location == "" || ValidateLocation(raw.Location) != nil {
+		return ""
+	}
+	var highways []string
+	seen := make(map[string]bool)
+	text := h.normalizeText(raw.Title + " " + raw.Description)
+	for _, m := range normalizedHighwayRe.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			highways = append(highways, m[1])
+		}
+	}
+	sort.Strings(highways)
+	return h.locationKey(raw.Location) + "|" + strings.Join(highways, ",")
+}
+
+// normalizeStyleUrl canonicalizes a KML style reference so "#LCS " and "#lcs"
+// hash the same. Unlike normalizeText it keeps punctuation and skips the
+// abbreviation rewrites, which could merge distinct style names.
+func normalizeStyleUrl(styleUrl string) string {
+	return strings.ToLower(strings.TrimSpace(styleUrl))
+}
+
+// highwayTokenRe matches highway designations: SR-4, SR 4, State Route 4,
+// Rte 4, Route 4, Hwy 4, Highway 4, US-50, I-80, Interstate 80
+var highwayTokenRe = regexp.MustCompile(`\b(?:sr|state route|rte|rt|route|hwy|highway|us|i|interstate)[\s-]*(\d+)\b`)
+
+// mileMarkerTokenRe matches mile-marker phrasings: MM 31, MP 31, mile marker
+// 31, milepost 31, mile post 31
+var mileMarkerTokenRe = regexp.MustCompile(`\b(?:mm|mp|mile marker|mile post|milepost)[\s.#-]*(\d+(?:\.\d+)?)\b`)
+
 // normalizeText cleans text for consistent hashing
 // Handles common variations in Caltrans incident descriptions
 func (h *ContentHasher) normalizeText(text string) string {
 	// Convert to lowercase
 	normalized := strings.ToLower(text)
+
+	// Canonicalize highway and mile-marker phrasings ("SR-4", "Rte 4" and
+	// "Hwy 4" are one road; "MM 31" and "mile marker 31" one spot) before
+	// punctuation stripping glues "sr-4" into "sr4"
+	normalized = highwayTokenRe.ReplaceAllString(normalized, "route $1")
+	normalized = mileMarkerTokenRe.ReplaceAllString(normalized, "mile $1")
 	
 	// Remove extra whitespace
 	normalized = regexp.MustCompile(`\s+`).ReplaceAllString(normalized, " ")
@@ -0,0 +1,178 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashRawAlert_StyleUrl(t *testing.T) {
+	h := NewContentHasher()
+	base := RawAlert{
+		Title:       "Lane Closure SR-4",
+		Description: "Eastbound lane closed for paving",
+		Location:    "SR-4 near Arnold",
+	}
+
+	laneClosure := base
+	laneClosure.StyleUrl = "#lcs_lane"
+	oneWay := base
+	oneWay.StyleUrl = "#lcs_oneway"
+	upperCase := base
+	upperCase.StyleUrl = " #LCS_Lane "
+
+	assert.NotEqual(t, h.HashRawAlert(laneClosure), h.HashRawAlert(oneWay), "different styles must not collide")
+	assert.NotEqual(t, h.HashRawAlert(base), h.HashRawAlert(laneClosure), "a style should change the hash")
+	assert.Equal(t, h.HashRawAlert(laneClosure), h.HashRawAlert(upperCase), "style case and whitespace should not matter")
+}
+
+func TestHashRawAlert_Languages(t *testing.T) {
+	h := NewContentHasher()
+	withLanguages := func(languages ...string) RawAlert {
+		return RawAlert{Title: "CHP Incident", Description: "Vehicle in ditch", Location: "SR-4", Languages: languages}
+	}
+
+	english := h.HashRawAlert(withLanguages())
+	assert.NotEqual(t, english, h.HashRawAlert(withLanguages("es")), "an English-only enhancement has no Spanish summary")
+	assert.Equal(t, english, h.HashRawAlert(withLanguages("en")), "English is always produced")
+	assert.Equal(t, h.HashRawAlert(withLanguages("es", "fr")), h.HashRawAlert(withLanguages(" FR", "es", "es")),
+		"language order, case and repeats should not matter")
+}
+
+func TestHashRawAlert_LocationPrecision(t *testing.T) {
+	alertAt := func(location string) RawAlert {
+		return RawAlert{Title: "CHP Incident", Description: "Vehicle in ditch", Location: location}
+	}
+	// About 400m apart
+	a := alertAt("CHP Incident (38.2512, -120.3501)")
+	b := alertAt("CHP Incident (38.2549, -120.3502)")
+
+	coarse := NewContentHasher(WithLocationPrecision(2))
+	assert.Equal(t, "CHP Incident (38.25, -120.35)", coarse.locationKey(a.Location))
+	assert.Equal(t, coarse.HashRawAlert(a), coarse.HashRawAlert(b), "nearby incidents should share a hash at ~1km precision")
+
+	fine := NewContentHasher(WithLocationPrecision(3))
+	assert.NotEqual(t, fine.HashRawAlert(a), fine.HashRawAlert(b), "nearby incidents should differ at ~100m precision")
+
+	// The default keeps coordinates exactly as given
+	assert.Equal(t, a.Location, NewContentHasher().locationKey(a.Location))
+	assert.NotEqual(t, NewContentHasher().HashRawAlert(a), NewContentHasher().HashRawAlert(b))
+}
+
+func TestNormalizeText_HighwayAndMileMarkerVariants(t *testing.T) {
+	h := NewContentHasher()
+
+	highways := []string{"SR-4", "SR 4", "sr4", "State Route 4", "Rte 4", "Route 4", "Hwy 4", "HWY-4", "Highway 4"}
+	for _, variant := range highways {
+		assert.Equal(t, h.normalizeText("Highway 4"), h.normalizeText(variant), variant)
+	}
+
+	markers := []string{"MM 31", "mm31", "MP 31", "Mile Marker 31", "milepost 31", "mile post 31"}
+	for _, variant := range markers {
+		assert.Equal(t, h.normalizeText("mile marker 31"), h.normalizeText(variant), variant)
+	}
+
+	assert.Equal(t, "route 4 near mile 31", h.normalizeText("SR-4 near MM 31"))
+	assert.NotEqual(t, h.normalizeText("SR-4"), h.normalizeText("SR-49"), "different highways must stay distinct")
+	assert.NotEqual(t, h.normalizeText("MM 31"), h.normalizeText("MM 31.5"))
+
+	alertWith := func(description string) RawAlert {
+		return RawAlert{Title: "Lane Closure", Description: description, Location: "Arnold"}
+	}
+	assert.Equal(t,
+		h.HashRawAlert(alertWith("Lane closed on SR-4 at MM 31")),
+		h.HashRawAlert(alertWith("Lane closed on Hwy 4 at mile marker 31")))
+}
+
+func TestEventKey(t *testing.T) {
+	h := NewContentHasher(WithLocationPrecision(3))
+	closure := RawAlert{
+		Title:       "Lane Closure",
+		Description: "SR-4 eastbound, one lane closed for collision cleanup",
+		Location:    "38.13601, -120.45702",
+	}
+	chp := RawAlert{
+		Title:       "CHP Incident 251016ST0001",
+		Description: "1183-Trfc Collision-Unkn Inj, Hwy 4 at Murphys Grade Rd",
+		Location:    "38.13582, -120.45688",
+	}
+	assert.Equal(t, "38.136, -120.457|4", h.EventKey(closure))
+	assert.Equal(t, h.EventKey(closure), h.EventKey(chp), "one event in two feeds should share a key")
+
+	otherHighway := chp
+	otherHighway.Description = "1183-Trfc Collision-Unkn Inj, SR-49 at Main St"
+	assert.NotEqual(t, h.EventKey(closure), h.EventKey(otherHighway), "a different highway is a different event")
+
+	assert.Empty(t, h.EventKey(RawAlert{Title: "Lane Closure"}), "no location, no key")
+}
+
+func TestValidateLocation(t *testing.T) {
+	for _, valid := range []string{
+		"",
+		"Hwy 4 at Murphys Grade Rd",
+		"CHP Incident (38.13601, -120.45702)",
+		"89.99999, 12.5",
+		"-33.9, 179.99",
+		"0.0, -120.5",
+	} {
+		assert.NoError(t, ValidateLocation(valid), valid)
+	}
+
+	for location, want := range map[string]string{
+		"CHP Incident (0.0, 0.0)": "unset",
+		"91.5, -120.4":            "latitude 91.5 is outside [-90, 90]",
+		"38.1, -181.0":            "longitude -181.0 is outside [-180, 180]",
+	} {
+		err := ValidateLocation(location)
+		if assert.Error(t, err, location) {
+			assert.Contains(t, err.Error(), want)
+		}
+	}
+}
+
+func TestLocationKey_EdgeCases(t *testing.T) {
+	h := NewContentHasher(WithLocationPrecision(3))
+
+	// Either side of the equator and prime meridian shares a key; -0 is 0
+	assert.Equal(t, "0.000, 0.000", h.locationKey("-0.0001, 0.0002"))
+	assert.Equal(t, h.locationKey("0.0001, -0.0002"), h.locationKey("-0.0001, 0.0002"))
+
+	// Near the poles every longitude is one point
+	assert.Equal(t, "90.000, 0.000", h.locationKey("89.99995, -120.4"))
+	assert.Equal(t, h.locationKey("-89.99999, 45.0"), h.locationKey("-89.99996, -170.0"))
+	assert.NotEqual(t, h.locationKey("89.9, -120.4"), h.locationKey("89.9, 60.0"), "off the pole longitude still counts")
+
+	// Points straddling the antimeridian share a key rather than landing at
+	// opposite ends of the range
+	assert.Equal(t, "51.200, 180.000", h.locationKey("51.2, -179.9999"))
+	assert.Equal(t, h.locationKey("51.2, 179.9999"), h.locationKey("51.2, -179.9999"))
+	assert.Equal(t, "51.200, 179.990", h.locationKey("51.2, 179.99"))
+	assert.Equal(t, "51.200, -179.990", h.locationKey("51.2, -179.99"))
+
+	// Invalid and unset coordinates aren't rounded into real positions
+	assert.Equal(t, "0.0, 0.0", h.locationKey("0.0, 0.0"))
+	assert.Equal(t, "95.12345, 10.0", h.locationKey("95.12345, 10.0"))
+}
+
+func TestEventKey_InvalidLocation(t *testing.T) {
+	h := NewContentHasher(WithLocationPrecision(3))
+	// Feeds report a missing position as 0, 0; two such incidents on one
+	// highway are not the same event
+	assert.Empty(t, h.EventKey(RawAlert{Title: "Lane Closure", Description: "SR-4 lane closed", Location: "0.0, 0.0"}))
+	assert.Empty(t, h.EventKey(RawAlert{Title: "Lane Closure", Description: "SR-4 lane closed", Location: "38.1, -200.0"}))
+}
+
+func TestHashRawAlert_InvalidLocation(t *testing.T) {
+	h := NewContentHasher(WithLocationPrecision(3))
+	alertAt := func(location string) RawAlert {
+		return RawAlert{Title: "CHP Incident", Description: "Vehicle in ditch", Location: location}
+	}
+
+	// Unrounded, these would hash apart from everything else and from each other
+	assert.Empty(t, h.HashRawAlert(alertAt("CHP Incident (0.0000, 0.0000)")), "unset coordinates")
+	assert.Empty(t, h.HashRawAlert(alertAt("CHP Incident (95.12345, -120.4)")), "latitude out of range")
+	assert.Empty(t, NewContentHasher().HashRawAlert(alertAt("38.1, -200.0")), "rounding or not, the location is invalid")
+
+	assert.NotEmpty(t, h.HashRawAlert(alertAt("CHP Incident (38.2512, -120.3501)")))
+	assert.NotEmpty(t, h.HashRawAlert(alertAt("Hwy 4 at Murphys Grade Rd")), "a location without coordinates is valid")
+}
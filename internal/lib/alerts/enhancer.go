@@ -7,31 +7,50 @@ import (
 	"fmt"
 	"time"
 
-	openai "github.com/sashabaranov/go-openai"
+	"github.com/dpup/prefab/logging"
 )
 
-// alertEnhancer implements the AlertEnhancer interface using OpenAI
+// alertEnhancer implements the AlertEnhancer interface on top of an EnhancerProvider
 type alertEnhancer struct {
-	client *openai.Client
-	model  string
+	provider EnhancerProvider
+	usage    *usageTracker
 }
 
-// NewAlertEnhancer creates a new AlertEnhancer implementation
-func NewAlertEnhancer(apiKey, model string) AlertEnhancer {
+// NewAlertEnhancer creates a new AlertEnhancer backed by OpenAI
+func NewAlertEnhancer(apiKey, model string, opts ...EnhancerOption) AlertEnhancer {
 	if apiKey == "" {
-		return &alertEnhancer{client: nil, model: model} // Will cause errors - for testing
+		return &alertEnhancer{provider: nil, usage: newUsageTracker(resolveEnhancerOptions(opts))} // Will cause errors - for testing
 	}
 
-	client := openai.NewClient(apiKey)
-	return &alertEnhancer{
-		client: client,
-		model:  model,
-	}
+	return NewAlertEnhancerWithProvider(NewOpenAIProvider(apiKey, model), opts...)
+}
+
+// NewAlertEnhancerWithProvider creates an AlertEnhancer backed by any LLM
+// provider. Options add retries, a fallback model and pricing for cost
+// estimates. The returned enhancer also implements UsageReporter.
+func NewAlertEnhancerWithProvider(provider EnhancerProvider, opts ...EnhancerOption) AlertEnhancer {
+	o := resolveEnhancerOptions(opts)
+	return &alertEnhancer{provider: wrapProvider(provider, o), usage: newUsageTracker(o)}
+}
+
+// Usage returns cumulative token usage, estimated cost and call counts
+func (a *alertEnhancer) Usage() UsageStats {
+	return a.usage.snapshot()
 }
 
-// EnhanceAlert enhances a raw alert using OpenAI GPT with structured output
+// EnhanceAlert enhances a raw alert using the configured LLM with structured
+// output, recording token usage and timing
 func (a *alertEnhancer) EnhanceAlert(ctx context.Context, raw RawAlert) (EnhancedAlert, error) {
-	if a.client == nil {
+	start := time.Now()
+	var usage TokenUsage
+	enhanced, err := a.enhance(ctx, raw, &usage)
+	a.usage.record(usage, time.Since(start), err)
+	return enhanced, err
+}
+
+// enhance performs the enhancement, reporting token usage through usage
+func (a *alertEnhancer) enhance(ctx context.Context, raw RawAlert, usage *TokenUsage) (EnhancedAlert, error) {
+	if a.provider == nil {
 		return EnhancedAlert{}, errors.New("OpenAI client not initialized - invalid API key")
 	}
 
@@ -47,52 +66,38 @@ If a style_url is provided, incorporate the relevant traffic flow context from t
 For the condensed summary, follow the examples provided - do NOT include location, keep it under 120 characters.`,
 		string(rawAlertJSON))
 
-	// Determine response format based on model capability
-	var responseFormat *openai.ChatCompletionResponseFormat
-	if a.model == "gpt-4o" || a.model == "gpt-4o-mini" {
-		// Use JSON Schema for models that support it
-		responseFormat = &openai.ChatCompletionResponseFormat{
-			Type:       openai.ChatCompletionResponseFormatTypeJSONSchema,
-			JSONSchema: &AlertEnhancementSchema,
-		}
-	} else {
-		// Fall back to JSON object for older models
-		responseFormat = &openai.ChatCompletionResponseFormat{
-			Type: openai.ChatCompletionResponseFormatTypeJSONObject,
-		}
+	// Translations are only requested (and required by the schema) when asked for
+	languages := translationLanguages(raw.Languages)
+	if len(languages) > 0 {
+		userPrompt += translationPrompt(languages)
 	}
-
-	// Make OpenAI API call with structured output request
-	resp, err := a.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: a.model,
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    openai.ChatMessageRoleSystem,
-				Content: SystemPrompt,
-			},
-			{
-				Role:    openai.ChatMessageRoleUser,
-				Content: userPrompt,
-			},
-		},
-		ResponseFormat: responseFormat,
-		Temperature:    0.3, // Lower temperature for more consistent structured output
-		MaxTokens:      1000,
-	})
-
+	schema, err := alertEnhancementSchemaFor(languages)
 	if err != nil {
-		return EnhancedAlert{}, fmt.Errorf("OpenAI API error: %w", err)
+		return EnhancedAlert{}, err
 	}
 
-	if len(resp.Choices) == 0 {
-		return EnhancedAlert{}, errors.New("no response from OpenAI API")
+	resp, err := a.provider.Complete(ctx, CompletionRequest{
+		SystemPrompt: SystemPrompt,
+		UserPrompt:   userPrompt,
+		Schema:       schema,
+		Temperature:  0.3, // Lower temperature for more consistent structured output
+		MaxTokens:    1000,
+	})
+	if err != nil {
+		return EnhancedAlert{}, err
 	}
+	*usage = resp.Usage
 
-	// Parse the JSON response
+	// Parse the JSON response, repairing fences/prose around it if needed
 	var structured StructuredDescription
-	jsonResponse := resp.Choices[0].Message.Content
-	if err := json.Unmarshal([]byte(jsonResponse), &structured); err != nil {
-		return EnhancedAlert{}, fmt.Errorf("failed to parse OpenAI JSON response: %w", err)
+	repaired, err := parseModelJSON(resp.Content, &structured)
+	if err != nil {
+		return EnhancedAlert{}, fmt.Errorf("failed to parse %s JSON response: %w", a.provider.Name(), err)
+	}
+	if repaired {
+		a.usage.recordRepair()
+		logging.Warnw(logging.EnsureLogger(ctx), "Repaired malformed JSON from alert enhancer",
+			"provider", a.provider.Name(), "alert_id", raw.ID)
 	}
 
 	// Validate required fields
@@ -121,38 +126,42 @@ For the condensed summary, follow the examples provided - do NOT include locatio
 		}
 	}
 
+	// English always comes from the condensed summary; translations the model
+	// skipped are left out rather than filled with English text
+	summaries := map[string]string{DefaultSummaryLanguage: structured.CondensedSummary}
+	for _, lang := range languages {
+		if summary := structured.Summaries[lang]; summary != "" {
+			summaries[lang] = summary
+		}
+	}
+
 	// Create enhanced alert
 	enhanced := EnhancedAlert{
 		ID:                    raw.ID,
 		OriginalDescription:   raw.Description,
 		StructuredDescription: structured,
 		CondensedSummary:      structured.CondensedSummary,
+		Summaries:             summaries,
 		ProcessedAt:           time.Now(),
 	}
 
 	return enhanced, nil
 }
 
-// HealthCheck verifies OpenAI API connectivity and rate limits
+// HealthCheck verifies LLM provider connectivity and rate limits
 func (a *alertEnhancer) HealthCheck(ctx context.Context) error {
-	if a.client == nil {
+	if a.provider == nil {
 		return errors.New("OpenAI client not initialized")
 	}
 
 	// Make a minimal API call to test connectivity
-	_, err := a.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: a.model,
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    openai.ChatMessageRoleUser,
-				Content: "Test",
-			},
-		},
-		MaxTokens: 1,
+	_, err := a.provider.Complete(ctx, CompletionRequest{
+		UserPrompt: "Test",
+		MaxTokens:  1,
 	})
 
 	if err != nil {
-		return fmt.Errorf("OpenAI API health check failed: %w", err)
+		return fmt.Errorf("%s health check failed: %w", a.provider.Name(), err)
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package alerts
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownFence matches a ```json ... ``` (or bare ```) block
+	markdownFence = regexp.MustCompile("(?s)```[a-zA-Z]*\\s*(.*?)```")
+	// trailingComma matches a comma directly before a closing brace/bracket
+	trailingComma = regexp.MustCompile(`,(\s*[}\]])`)
+)
+
+// parseModelJSON unmarshals a model response into v. Models asked for JSON
+// still sometimes wrap it in markdown fences, add prose before or after it, or
+// leave trailing commas; when the strict parse fails those are stripped and
+// parsing retried. repaired reports whether any repair was needed.
+func parseModelJSON(content string, v any) (repaired bool, err error) {
+	strictErr := json.Unmarshal([]byte(content), v)
+	if strictErr == nil {
+		return false, nil
+	}
+
+	candidate := content
+	if m := markdownFence.FindStringSubmatch(candidate); m != nil {
+		candidate = m[1]
+	}
+
+	// Keep only the outermost object, dropping leading/trailing commentary
+	start := strings.Index(candidate, "{")
+	end := strings.LastIndex(candidate, "}")
+	if start < 0 || end <= start {
+		return false, strictErr
+	}
+	candidate = candidate[start : end+1]
+
+	if err := json.Unmarshal([]byte(candidate), v); err == nil {
+		return true, nil
+	}
+
+	// Lenient pass: drop trailing commas
+	candidate = trailingComma.ReplaceAllString(candidate, "$1")
+	if err := json.Unmarshal([]byte(candidate), v); err != nil {
+		return false, strictErr
+	}
+	return true, nil
+}
@@ -0,0 +1,63 @@
+package alerts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModelJSON(t *testing.T) {
+	cases := map[string]struct {
+		content  string
+		repaired bool
+	}{
+		"clean":            {enhancedAlertJSON, false},
+		"json fence":       {"```json\n" + enhancedAlertJSON + "\n```", true},
+		"bare fence":       {"```\n" + enhancedAlertJSON + "\n```", true},
+		"leading prose":    {"Here is the structured report you asked for:\n\n" + enhancedAlertJSON, true},
+		"trailing comment": {enhancedAlertJSON + "\n\nNote: the location was inferred from the mile marker.", true},
+		"prose and fence":  {"Sure! Here you go:\n```json\n" + enhancedAlertJSON + "\n```\nLet me know if you need anything else.", true},
+		"trailing commas":  {`{"details": "Tree down blocking one lane.", "condensed_summary": "Tree blocking one lane", "location": {"description": "Highway 4 near Arnold", "latitude": 38.25, "longitude": -120.35,}, "impact": "moderate", "road_status": "restricted", "restriction_details": "One lane blocked", "chain_status": "none",}`, true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var structured StructuredDescription
+			repaired, err := parseModelJSON(tc.content, &structured)
+			require.NoError(t, err)
+			assert.Equal(t, tc.repaired, repaired)
+			assert.Equal(t, "Tree blocking one lane", structured.CondensedSummary)
+			assert.Equal(t, "restricted", structured.RoadStatus)
+			assert.Equal(t, "Highway 4 near Arnold", structured.Location.Description)
+			assert.InDelta(t, 38.25, structured.Location.Latitude, 1e-9)
+		})
+	}
+}
+
+func TestParseModelJSON_Unrecoverable(t *testing.T) {
+	var structured StructuredDescription
+	_, err := parseModelJSON("I'm sorry, I can't help with that.", &structured)
+	assert.Error(t, err)
+
+	_, err = parseModelJSON(`{"details": "unterminated`, &structured)
+	assert.Error(t, err)
+}
+
+func TestAlertEnhancer_RepairsFencedResponse(t *testing.T) {
+	fenced := "Here is the JSON:\n```json\n" + enhancedAlertJSON + "\n```"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": ` + quoteJSON(fenced) + `}}]}`))
+	}))
+	defer srv.Close()
+
+	enhancer := NewAlertEnhancerWithProvider(NewCompatibleProvider(srv.URL, "", "llama3.1", nil))
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a", Description: "TREE DOWN"})
+	require.NoError(t, err)
+	assert.Equal(t, "Tree blocking one lane", enhanced.CondensedSummary)
+	assert.Equal(t, int64(1), enhancer.(UsageReporter).Usage().JSONRepairs)
+}
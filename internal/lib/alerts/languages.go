@@ -0,0 +1,85 @@
+package alerts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// DefaultSummaryLanguage is the language of CondensedSummary
+const DefaultSummaryLanguage = "en"
+
+// translationLanguages normalizes requested language codes, dropping blanks,
+// duplicates and English (which CondensedSummary already covers)
+func translationLanguages(languages []string) []string {
+	var result []string
+	seen := map[string]bool{DefaultSummaryLanguage: true}
+	for _, lang := range languages {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		result = append(result, lang)
+	}
+	return result
+}
+
+// translationPrompt asks the model for summaries in languages
+func translationPrompt(languages []string) string {
+	return fmt.Sprintf(`
+Also translate the condensed summary into these languages: %s.
+Return the translations in the "summaries" object keyed by language code, following the same rules as the condensed summary.`,
+		strings.Join(languages, ", "))
+}
+
+// alertEnhancementSchemaFor returns AlertEnhancementSchema extended with a
+// required "summaries" object holding one string per language. Strict mode
+// rejects optional properties, so the schema is built per request.
+func alertEnhancementSchemaFor(languages []string) (*openai.ChatCompletionResponseFormatJSONSchema, error) {
+	if len(languages) == 0 {
+		return &AlertEnhancementSchema, nil
+	}
+
+	base, err := AlertEnhancementSchema.Schema.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode alert enhancement schema: %w", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(base, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse alert enhancement schema: %w", err)
+	}
+
+	summaryProps := make(map[string]any, len(languages))
+	for _, lang := range languages {
+		summaryProps[lang] = map[string]any{
+			"type":        "string",
+			"maxLength":   120,
+			"description": fmt.Sprintf("Condensed summary translated to %q, no location, max 120 chars", lang),
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if properties == nil {
+		return nil, fmt.Errorf("alert enhancement schema has no properties")
+	}
+	properties["summaries"] = map[string]any{
+		"type":                 "object",
+		"properties":           summaryProps,
+		"required":             languages,
+		"additionalProperties": false,
+	}
+	required, _ := schema["required"].([]any)
+	schema["required"] = append(required, "summaries")
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode alert enhancement schema: %w", err)
+	}
+
+	extended := AlertEnhancementSchema
+	extended.Schema = json.RawMessage(raw)
+	return &extended, nil
+}
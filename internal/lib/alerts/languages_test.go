@@ -0,0 +1,68 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider returns a canned completion and records the last request
+type mockProvider struct {
+	content string
+	last    CompletionRequest
+}
+
+func (m *mockProvider) Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error) {
+	m.last = req
+	return CompletionResponse{Content: m.content}, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) WithModel(model string) EnhancerProvider { return m }
+
+func TestEnhanceAlert_TranslatedSummaries(t *testing.T) {
+	provider := &mockProvider{content: `{"details": "Tree down blocking one lane.", "condensed_summary": "Tree blocking one lane",
+		"location": {"description": "Highway 4 near Arnold", "latitude": 38.25, "longitude": -120.35},
+		"impact": "moderate", "road_status": "restricted", "restriction_details": "One lane blocked", "chain_status": "none",
+		"summaries": {"es": "Árbol bloqueando un carril"}}`}
+	enhancer := NewAlertEnhancerWithProvider(provider)
+
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{
+		ID: "a", Description: "TREE DOWN", Languages: []string{"ES", "en", "es"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"en": "Tree blocking one lane",
+		"es": "Árbol bloqueando un carril",
+	}, enhanced.Summaries)
+	assert.Contains(t, provider.last.UserPrompt, "translate the condensed summary into these languages: es.")
+
+	var schema struct {
+		Properties map[string]struct {
+			Required []string `json:"required"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	raw, err := provider.last.Schema.Schema.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &schema))
+	assert.Contains(t, schema.Required, "summaries")
+	assert.Equal(t, []string{"es"}, schema.Properties["summaries"].Required)
+}
+
+func TestEnhanceAlert_DefaultsToEnglishOnly(t *testing.T) {
+	provider := &mockProvider{content: enhancedAlertJSON}
+	enhancer := NewAlertEnhancerWithProvider(provider)
+
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a", Description: "TREE DOWN"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"en": "Tree blocking one lane"}, enhanced.Summaries)
+	assert.Same(t, &AlertEnhancementSchema, provider.last.Schema, "untranslated requests use the shared schema")
+	assert.NotContains(t, provider.last.UserPrompt, "translate")
+}
@@ -0,0 +1,324 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// Provider names accepted in openai.provider configuration
+const (
+	ProviderOpenAI           = "openai"            // api.openai.com via the go-openai SDK
+	ProviderOpenAICompatible = "openai-compatible" // Any /chat/completions endpoint (Ollama, vLLM, LM Studio...)
+)
+
+// CompletionRequest is a single system+user prompt exchange that must return JSON
+type CompletionRequest struct {
+	SystemPrompt string
+	UserPrompt   string
+	// Schema is the JSON schema for the response. Providers that support
+	// structured output enforce it; others fall back to plain JSON mode.
+	Schema      *openai.ChatCompletionResponseFormatJSONSchema
+	Temperature float32
+	MaxTokens   int
+}
+
+// CompletionResponse is the model's reply to a CompletionRequest
+type CompletionResponse struct {
+	Content string // Raw message content (expected to be JSON)
+	Usage   TokenUsage
+}
+
+// TokenUsage is the token accounting reported for one completion
+type TokenUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// EnhancerProvider is the LLM backend behind AlertEnhancer. Prompt building
+// and response validation live in the enhancer; providers only move prompts
+// to a model and text back.
+type EnhancerProvider interface {
+	// Complete runs one chat completion
+	Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error)
+
+	// Name identifies the provider (and model) in errors and logs
+	Name() string
+
+	// WithModel returns a copy of the provider that uses a different model
+	// (used for fallback models)
+	WithModel(model string) EnhancerProvider
+}
+
+// ProviderError is returned by providers for failed completions, carrying
+// what the retry logic needs to decide whether and when to try again
+type ProviderError struct {
+	StatusCode int           // HTTP status, 0 when the request never got a response
+	RetryAfter time.Duration // Server-requested delay from Retry-After, 0 if absent
+	Err        error
+}
+
+func (e *ProviderError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the failure is transient: rate limiting, a
+// server error, or a request that never got a response
+func (e *ProviderError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500 || e.StatusCode == 0
+}
+
+// parseRetryAfter reads a Retry-After header in either delay-seconds or
+// HTTP-date form
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// openAIProvider implements EnhancerProvider using the OpenAI API
+type openAIProvider struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAIProvider creates a provider backed by api.openai.com
+func NewOpenAIProvider(apiKey, model string) EnhancerProvider {
+	return newOpenAIProviderWithConfig(openai.DefaultConfig(apiKey), model)
+}
+
+// newOpenAIProviderWithConfig creates an OpenAI provider from an SDK config
+// (tests point BaseURL at a local server)
+func newOpenAIProviderWithConfig(cfg openai.ClientConfig, model string) *openAIProvider {
+	// The SDK's errors don't carry response headers, so capture Retry-After
+	// at the transport
+	cfg.HTTPClient = &http.Client{Transport: retryAfterTransport{base: http.DefaultTransport}}
+	return &openAIProvider{
+		client: openai.NewClientWithConfig(cfg),
+		model:  model,
+	}
+}
+
+// retryAfterKey is the context key for the per-call Retry-After slot
+type retryAfterKey struct{}
+
+// retryAfterTransport records the Retry-After header of each response into
+// the *time.Duration stored in the request context, if any
+type retryAfterTransport struct {
+	base http.RoundTripper
+}
+
+func (t retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		if slot, ok := req.Context().Value(retryAfterKey{}).(*time.Duration); ok {
+			*slot = parseRetryAfter(resp.Header.Get("Retry-After"))
+		}
+	}
+	return resp, err
+}
+
+// Complete sends the prompts to OpenAI, using JSON Schema structured output
+// for models that support it
+func (p *openAIProvider) Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error) {
+	var responseFormat *openai.ChatCompletionResponseFormat
+	if req.Schema != nil && (p.model == "gpt-4o" || p.model == "gpt-4o-mini") {
+		// Use JSON Schema for models that support it
+		responseFormat = &openai.ChatCompletionResponseFormat{
+			Type:       openai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: req.Schema,
+		}
+	} else if req.Schema != nil {
+		// Fall back to JSON object for older models
+		responseFormat = &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONObject,
+		}
+	}
+
+	var messages []openai.ChatCompletionMessage
+	if req.SystemPrompt != "" {
+		messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleSystem, Content: req.SystemPrompt})
+	}
+	messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleUser, Content: req.UserPrompt})
+
+	var retryAfter time.Duration
+	resp, err := p.client.CreateChatCompletion(context.WithValue(ctx, retryAfterKey{}, &retryAfter), openai.ChatCompletionRequest{
+		Model:          p.model,
+		Messages:       messages,
+		ResponseFormat: responseFormat,
+		Temperature:    req.Temperature,
+		MaxTokens:      req.MaxTokens,
+	})
+	if err != nil {
+		providerErr := &ProviderError{RetryAfter: retryAfter, Err: fmt.Errorf("OpenAI API error: %w", err)}
+		var apiErr *openai.APIError
+		var reqErr *openai.RequestError
+		switch {
+		case errors.As(err, &apiErr):
+			providerErr.StatusCode = apiErr.HTTPStatusCode
+		case errors.As(err, &reqErr):
+			providerErr.StatusCode = reqErr.HTTPStatusCode
+		}
+		return CompletionResponse{}, providerErr
+	}
+
+	if len(resp.Choices) == 0 {
+		return CompletionResponse{}, errors.New("no response from OpenAI API")
+	}
+
+	return CompletionResponse{
+		Content: resp.Choices[0].Message.Content,
+		Usage: TokenUsage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+// Name identifies the provider
+func (p *openAIProvider) Name() string {
+	return ProviderOpenAI + ":" + p.model
+}
+
+// WithModel returns a copy of the provider using model
+func (p *openAIProvider) WithModel(model string) EnhancerProvider {
+	return &openAIProvider{client: p.client, model: model}
+}
+
+// compatibleProvider implements EnhancerProvider over plain HTTP against an
+// OpenAI-compatible /chat/completions endpoint, typically a local model
+type compatibleProvider struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewCompatibleProvider creates a provider for an OpenAI-compatible endpoint.
+// baseURL is the API root (e.g. "http://localhost:11434/v1"); apiKey may be
+// empty for local servers. A nil httpClient uses a 60s-timeout default.
+func NewCompatibleProvider(baseURL, apiKey, model string, httpClient *http.Client) EnhancerProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &compatibleProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		model:      model,
+		httpClient: httpClient,
+	}
+}
+
+// compatibleRequest is the subset of the chat completions request we send
+type compatibleRequest struct {
+	Model          string                         `json:"model"`
+	Messages       []openai.ChatCompletionMessage `json:"messages"`
+	ResponseFormat *compatibleResponseFormat      `json:"response_format,omitempty"`
+	Temperature    float32                        `json:"temperature,omitempty"`
+	MaxTokens      int                            `json:"max_tokens,omitempty"`
+}
+
+type compatibleResponseFormat struct {
+	Type string `json:"type"`
+}
+
+// compatibleResponse is the subset of the chat completions response we read
+type compatibleResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage TokenUsage `json:"usage"`
+}
+
+// Complete posts the prompts to {baseURL}/chat/completions. Local servers
+// rarely support JSON Schema, so structured requests use JSON mode and rely
+// on the schema being described in the prompt.
+func (p *compatibleProvider) Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error) {
+	body := compatibleRequest{
+		Model:       p.model,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+	}
+	if req.SystemPrompt != "" {
+		body.Messages = append(body.Messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleSystem, Content: req.SystemPrompt})
+	}
+	body.Messages = append(body.Messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleUser, Content: req.UserPrompt})
+	if req.Schema != nil {
+		body.ResponseFormat = &compatibleResponseFormat{Type: "json_object"}
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return CompletionResponse{}, fmt.Errorf("failed to encode completion request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return CompletionResponse{}, fmt.Errorf("failed to create completion request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return CompletionResponse{}, &ProviderError{Err: fmt.Errorf("failed to execute completion request: %w", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return CompletionResponse{}, &ProviderError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Err:        fmt.Errorf("completion API error %d: %s", resp.StatusCode, string(errBody)),
+		}
+	}
+
+	var decoded compatibleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return CompletionResponse{}, fmt.Errorf("failed to decode completion response: %w", err)
+	}
+	if len(decoded.Choices) == 0 {
+		return CompletionResponse{}, fmt.Errorf("no response from %s", p.Name())
+	}
+
+	return CompletionResponse{Content: decoded.Choices[0].Message.Content, Usage: decoded.Usage}, nil
+}
+
+// Name identifies the provider
+func (p *compatibleProvider) Name() string {
+	return ProviderOpenAICompatible + ":" + p.model
+}
+
+// WithModel returns a copy of the provider using model
+func (p *compatibleProvider) WithModel(model string) EnhancerProvider {
+	clone := *p
+	clone.model = model
+	return &clone
+}
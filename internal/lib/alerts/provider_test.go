@@ -0,0 +1,110 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCompletionServer emulates an OpenAI-compatible /chat/completions endpoint
+// replying with content, and captures the decoded request.
+func newCompletionServer(t *testing.T, content string, captured *map[string]any) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		if captured != nil {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(captured))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":     "chatcmpl-local",
+			"object": "chat.completion",
+			"model":  "llama3.1",
+			"choices": []map[string]any{{
+				"index":         0,
+				"message":       map[string]any{"role": "assistant", "content": content},
+				"finish_reason": "stop",
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCompatibleProvider_EnhanceAlert(t *testing.T) {
+	content := `{
+		"time_reported": "2025-09-11T09:58:00-07:00",
+		"details": "Vehicle in a ditch on eastbound Highway 4. Emergency services are en route.",
+		"condensed_summary": "Vehicle in ditch, EMS en route",
+		"location": {"description": "Highway 4 eastbound near mile marker 31", "latitude": 38.2, "longitude": -120.3},
+		"last_update": null,
+		"impact": "light",
+		"road_status": "open",
+		"restriction_details": null,
+		"chain_status": "none"
+	}`
+	var captured map[string]any
+	srv := newCompletionServer(t, content, &captured)
+
+	enhancer := NewAlertEnhancerWithProvider(NewCompatibleProvider(srv.URL+"/v1/", "", "llama3.1", nil))
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{
+		ID:          "test-001",
+		Description: "Rte 4 EB of MM 31 - VEHICLE IN DITCH, EMS ENRT",
+		Location:    "Highway 4",
+		Timestamp:   time.Now(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "test-001", enhanced.ID)
+	assert.Equal(t, "Vehicle in ditch, EMS en route", enhanced.CondensedSummary)
+	assert.Equal(t, "light", enhanced.StructuredDescription.Impact)
+	assert.Equal(t, "open", enhanced.StructuredDescription.RoadStatus)
+	assert.Equal(t, "none", enhanced.StructuredDescription.ChainStatus)
+	assert.Equal(t, "Highway 4 eastbound near mile marker 31", enhanced.StructuredDescription.Location.Description)
+
+	// Request shape: configured model, system + user prompts, JSON mode
+	assert.Equal(t, "llama3.1", captured["model"])
+	messages, ok := captured["messages"].([]any)
+	require.True(t, ok)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "system", messages[0].(map[string]any)["role"])
+	assert.Equal(t, "user", messages[1].(map[string]any)["role"])
+	assert.Contains(t, messages[1].(map[string]any)["content"], "VEHICLE IN DITCH")
+	assert.Equal(t, map[string]any{"type": "json_object"}, captured["response_format"])
+}
+
+func TestCompatibleProvider_SendsAPIKey(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "ok"}}]}`))
+	}))
+	defer srv.Close()
+
+	provider := NewCompatibleProvider(srv.URL, "local-key", "llama3.1", nil)
+	resp, err := provider.Complete(context.Background(), CompletionRequest{UserPrompt: "Test", MaxTokens: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp.Content)
+	assert.Equal(t, "Bearer local-key", auth)
+	assert.Equal(t, "openai-compatible:llama3.1", provider.Name())
+}
+
+func TestCompatibleProvider_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not loaded", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	enhancer := NewAlertEnhancerWithProvider(NewCompatibleProvider(srv.URL, "", "llama3.1", nil))
+	_, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "x", Description: "test"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+	assert.Contains(t, err.Error(), "model not loaded")
+}
@@ -0,0 +1,144 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxRetryDelay caps both exponential backoff and server-requested Retry-After
+const maxRetryDelay = 60 * time.Second
+
+// EnhancerOption configures an alert enhancer
+type EnhancerOption func(*enhancerOptions)
+
+type enhancerOptions struct {
+	maxRetries           int
+	baseDelay            time.Duration
+	fallbackModel        string
+	promptPricePer1K     float64
+	completionPricePer1K float64
+	observe              func(elapsed time.Duration, err error)           // From WithCallObserver
+	sleep                func(ctx context.Context, d time.Duration) error // Overridable in tests
+}
+
+// WithRetry retries transient failures (429, 5xx, network errors) up to
+// maxRetries times, backing off exponentially from baseDelay or waiting as
+// long as the server's Retry-After header asks
+func WithRetry(maxRetries int, baseDelay time.Duration) EnhancerOption {
+	return func(o *enhancerOptions) {
+		o.maxRetries = maxRetries
+		o.baseDelay = baseDelay
+	}
+}
+
+// WithFallbackModel tries model (on the same provider) once the primary
+// model has failed, including after its retries are exhausted
+func WithFallbackModel(model string) EnhancerOption {
+	return func(o *enhancerOptions) {
+		o.fallbackModel = model
+	}
+}
+
+// resolveEnhancerOptions applies opts over the defaults
+func resolveEnhancerOptions(opts []EnhancerOption) enhancerOptions {
+	o := enhancerOptions{sleep: sleepContext}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// wrapProvider adds call observation and retry/fallback behavior to provider
+// when any is configured
+func wrapProvider(provider EnhancerProvider, o enhancerOptions) EnhancerProvider {
+	if provider != nil && o.observe != nil {
+		provider = &observedProvider{EnhancerProvider: provider, observe: o.observe}
+	}
+	if provider == nil || (o.maxRetries <= 0 && o.fallbackModel == "") {
+		return provider
+	}
+
+	r := &retryingProvider{primary: provider, opts: o}
+	if o.fallbackModel != "" {
+		r.fallback = provider.WithModel(o.fallbackModel)
+	}
+	return r
+}
+
+// retryingProvider adds retries and a fallback model to another provider
+type retryingProvider struct {
+	primary  EnhancerProvider
+	fallback EnhancerProvider // nil when no fallback model is configured
+	opts     enhancerOptions
+}
+
+// Complete runs the request on the primary model with retries, then on the
+// fallback model (also with retries) if the primary never succeeded
+func (r *retryingProvider) Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error) {
+	resp, err := r.completeWithRetry(ctx, r.primary, req)
+	if err == nil || r.fallback == nil || ctx.Err() != nil {
+		return resp, err
+	}
+
+	resp, fallbackErr := r.completeWithRetry(ctx, r.fallback, req)
+	if fallbackErr != nil {
+		return CompletionResponse{}, fmt.Errorf("%w (fallback %s also failed: %v)", err, r.fallback.Name(), fallbackErr)
+	}
+	return resp, nil
+}
+
+// completeWithRetry calls provider, retrying retryable failures
+func (r *retryingProvider) completeWithRetry(ctx context.Context, provider EnhancerProvider, req CompletionRequest) (CompletionResponse, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := provider.Complete(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		var providerErr *ProviderError
+		if attempt >= r.opts.maxRetries || !errors.As(err, &providerErr) || !providerErr.Retryable() || ctx.Err() != nil {
+			return CompletionResponse{}, err
+		}
+
+		if sleepErr := r.opts.sleep(ctx, r.retryDelay(attempt, providerErr.RetryAfter)); sleepErr != nil {
+			return CompletionResponse{}, err
+		}
+	}
+}
+
+// retryDelay is the server's Retry-After when given, otherwise exponential
+// backoff from baseDelay; both capped at maxRetryDelay
+func (r *retryingProvider) retryDelay(attempt int, retryAfter time.Duration) time.Duration {
+	delay := retryAfter
+	if delay <= 0 {
+		delay = r.opts.baseDelay << attempt
+	}
+	if delay > maxRetryDelay || delay < 0 {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// Name identifies the primary provider
+func (r *retryingProvider) Name() string {
+	return r.primary.Name()
+}
+
+// WithModel returns a copy retrying against model (without a fallback)
+func (r *retryingProvider) WithModel(model string) EnhancerProvider {
+	return &retryingProvider{primary: r.primary.WithModel(model), opts: r.opts}
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,174 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const enhancedAlertJSON = `{"details": "Tree down blocking one lane.", "condensed_summary": "Tree blocking one lane",
+	"location": {"description": "Highway 4 near Arnold", "latitude": 38.25, "longitude": -120.35},
+	"impact": "moderate", "road_status": "restricted", "restriction_details": "One lane blocked", "chain_status": "none"}`
+
+// withSleep records backoff delays instead of sleeping
+func withSleep(delays *[]time.Duration) EnhancerOption {
+	return func(o *enhancerOptions) {
+		o.sleep = func(ctx context.Context, d time.Duration) error {
+			*delays = append(*delays, d)
+			return nil
+		}
+	}
+}
+
+// fakeOpenAI serves /v1/chat/completions, delegating status decisions to respond
+type fakeOpenAI struct {
+	mu      sync.Mutex
+	models  []string // model of each request, in order
+	respond func(call int, model string, w http.ResponseWriter) bool
+}
+
+func (f *fakeOpenAI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Model string `json:"model"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	f.mu.Lock()
+	f.models = append(f.models, req.Model)
+	call := len(f.models)
+	f.mu.Unlock()
+
+	if !f.respond(call, req.Model, w) {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"id":      "chatcmpl-test",
+		"object":  "chat.completion",
+		"model":   req.Model,
+		"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": enhancedAlertJSON}}},
+	})
+}
+
+func apiError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"error": {"message": "` + message + `", "type": "test"}}`))
+}
+
+func newTestOpenAIProvider(t *testing.T, handler http.Handler, model string) EnhancerProvider {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	cfg := openai.DefaultConfig("test-key")
+	cfg.BaseURL = srv.URL + "/v1"
+	return newOpenAIProviderWithConfig(cfg, model)
+}
+
+func TestAlertEnhancer_RetriesRateLimitHonoringRetryAfter(t *testing.T) {
+	fake := &fakeOpenAI{respond: func(call int, model string, w http.ResponseWriter) bool {
+		if call == 1 {
+			w.Header().Set("Retry-After", "7")
+			apiError(w, http.StatusTooManyRequests, "Rate limit reached")
+			return false
+		}
+		return true
+	}}
+
+	var delays []time.Duration
+	enhancer := NewAlertEnhancerWithProvider(newTestOpenAIProvider(t, fake, "gpt-4o-mini"),
+		WithRetry(3, time.Second), withSleep(&delays))
+
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a1", Description: "TREE DOWN BLKG 1 LN"})
+	require.NoError(t, err)
+	assert.Equal(t, "restricted", enhanced.StructuredDescription.RoadStatus)
+
+	assert.Equal(t, []string{"gpt-4o-mini", "gpt-4o-mini"}, fake.models)
+	assert.Equal(t, []time.Duration{7 * time.Second}, delays, "should wait as long as Retry-After asks")
+}
+
+func TestAlertEnhancer_FallbackModelAfterRetriesExhausted(t *testing.T) {
+	fake := &fakeOpenAI{respond: func(call int, model string, w http.ResponseWriter) bool {
+		if model == "gpt-4o-mini" {
+			apiError(w, http.StatusServiceUnavailable, "The server is overloaded")
+			return false
+		}
+		return true
+	}}
+
+	var delays []time.Duration
+	enhancer := NewAlertEnhancerWithProvider(newTestOpenAIProvider(t, fake, "gpt-4o-mini"),
+		WithRetry(2, time.Second), WithFallbackModel("gpt-3.5-turbo"), withSleep(&delays))
+
+	enhanced, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a2", Description: "TREE DOWN BLKG 1 LN"})
+	require.NoError(t, err)
+	assert.Equal(t, "Tree blocking one lane", enhanced.CondensedSummary)
+
+	// Primary tried 1 + 2 retries, then the fallback succeeds first time
+	assert.Equal(t, []string{"gpt-4o-mini", "gpt-4o-mini", "gpt-4o-mini", "gpt-3.5-turbo"}, fake.models)
+	// No Retry-After: exponential backoff from the base delay
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, delays)
+}
+
+func TestAlertEnhancer_NoRetryOnClientError(t *testing.T) {
+	fake := &fakeOpenAI{respond: func(call int, model string, w http.ResponseWriter) bool {
+		apiError(w, http.StatusUnauthorized, "Incorrect API key provided")
+		return false
+	}}
+
+	var delays []time.Duration
+	enhancer := NewAlertEnhancerWithProvider(newTestOpenAIProvider(t, fake, "gpt-4o-mini"),
+		WithRetry(3, time.Second), withSleep(&delays))
+
+	_, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a3", Description: "x"})
+	require.Error(t, err)
+	assert.Len(t, fake.models, 1, "401 is not transient and should not be retried")
+	assert.Empty(t, delays)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon"))
+
+	at := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+	assert.InDelta(t, float64(90*time.Second), float64(parseRetryAfter(at)), float64(2*time.Second))
+}
+
+func TestAlertEnhancer_ObservesEveryAttempt(t *testing.T) {
+	fake := &fakeOpenAI{respond: func(call int, model string, w http.ResponseWriter) bool {
+		if model == "gpt-4o-mini" {
+			apiError(w, http.StatusServiceUnavailable, "The server is overloaded")
+			return false
+		}
+		return true
+	}}
+
+	var failed, succeeded int
+	observe := func(elapsed time.Duration, err error) {
+		assert.Greater(t, elapsed, time.Duration(0))
+		if err != nil {
+			failed++
+		} else {
+			succeeded++
+		}
+	}
+	var delays []time.Duration
+	enhancer := NewAlertEnhancerWithProvider(newTestOpenAIProvider(t, fake, "gpt-4o-mini"),
+		WithRetry(1, time.Second), WithFallbackModel("gpt-3.5-turbo"), withSleep(&delays), WithCallObserver(observe))
+
+	_, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a3", Description: "TREE DOWN BLKG 1 LN"})
+	require.NoError(t, err)
+
+	// Primary tried 1 + 1 retry, then the fallback
+	assert.Equal(t, 2, failed)
+	assert.Equal(t, 1, succeeded)
+}
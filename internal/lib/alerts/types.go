@@ -13,6 +13,9 @@ type RawAlert struct {
 	Location    string    `json:"location"`
 	StyleUrl    string    `json:"style_url,omitempty"` // KML style indicating closure type
 	Timestamp   time.Time `json:"timestamp"`
+	// Languages lists extra language codes (e.g. "es") to translate the
+	// condensed summary into. English is always produced.
+	Languages []string `json:"-"`
 }
 
 // StructuredLocation represents both descriptive and coordinate location data
@@ -34,6 +37,7 @@ type StructuredDescription struct {
 	ChainStatus        string             `json:"chain_status"`        // enum: none, r1, r2, active_unspecified
 	AdditionalInfo     map[string]string  `json:"additional_info,omitempty"`
 	CondensedSummary   string             `json:"condensed_summary,omitempty"`
+	Summaries          map[string]string  `json:"summaries,omitempty"` // Translated condensed summaries, only when requested
 }
 
 // EnhancedAlert represents a fully processed alert with AI enhancement
@@ -42,6 +46,7 @@ type EnhancedAlert struct {
 	OriginalDescription   string                `json:"original_description"`
 	StructuredDescription StructuredDescription `json:"structured_description"`
 	CondensedSummary      string                `json:"condensed_summary"`
+	Summaries             map[string]string     `json:"summaries,omitempty"` // Condensed summary by language code, always includes "en"
 	ProcessedAt           time.Time             `json:"processed_at"`
 }
 
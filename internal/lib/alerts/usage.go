@@ -0,0 +1,144 @@
+package alerts
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// durationWindowSize is how many recent enhancement calls P95Duration covers
+const durationWindowSize = 200
+
+// WithPricing sets the USD price per 1,000 prompt and completion tokens used
+// to estimate spend. Without it the estimated cost stays 0.
+func WithPricing(promptPer1K, completionPer1K float64) EnhancerOption {
+	return func(o *enhancerOptions) {
+		o.promptPricePer1K = promptPer1K
+		o.completionPricePer1K = completionPer1K
+	}
+}
+
+// WithCallObserver calls observe after every completion request sent to the
+// provider, retries and fallback-model attempts included, with the request's
+// latency and error. Use it to feed latency metrics.
+func WithCallObserver(observe func(elapsed time.Duration, err error)) EnhancerOption {
+	return func(o *enhancerOptions) {
+		o.observe = observe
+	}
+}
+
+// observedProvider reports each Complete call to observe
+type observedProvider struct {
+	EnhancerProvider
+	observe func(elapsed time.Duration, err error)
+}
+
+func (p *observedProvider) Complete(ctx context.Context, req CompletionRequest) (CompletionResponse, error) {
+	start := time.Now()
+	resp, err := p.EnhancerProvider.Complete(ctx, req)
+	p.observe(time.Since(start), err)
+	return resp, err
+}
+
+// WithModel returns a copy using model that is observed the same way
+func (p *observedProvider) WithModel(model string) EnhancerProvider {
+	return &observedProvider{EnhancerProvider: p.EnhancerProvider.WithModel(model), observe: p.observe}
+}
+
+// UsageStats is the cumulative LLM usage of an enhancer since startup
+type UsageStats struct {
+	Enhanced         int64         // Successful enhancements
+	Failures         int64         // Failed enhancements
+	TotalDuration    time.Duration // Time spent in enhancement calls (success and failure)
+	PromptTokens     int64
+	CompletionTokens int64
+	EstimatedCostUSD float64       // From WithPricing; 0 when no pricing is configured
+	JSONRepairs      int64         // Responses that needed fences/prose stripped to parse
+	P95Duration      time.Duration // 95th percentile over the last durationWindowSize calls
+}
+
+// AvgDuration is the mean time per enhancement call
+func (u UsageStats) AvgDuration() time.Duration {
+	calls := u.Enhanced + u.Failures
+	if calls == 0 {
+		return 0
+	}
+	return u.TotalDuration / time.Duration(calls)
+}
+
+// UsageReporter is implemented by enhancers that track token usage and cost
+type UsageReporter interface {
+	Usage() UsageStats
+}
+
+// usageTracker accumulates UsageStats; safe for concurrent use
+type usageTracker struct {
+	mu                   sync.Mutex
+	stats                UsageStats
+	promptPricePer1K     float64
+	completionPricePer1K float64
+
+	// Ring buffer of recent call durations for P95Duration
+	recent     [durationWindowSize]time.Duration
+	recentNext int // Next slot to overwrite
+	recentLen  int // Filled slots, up to durationWindowSize
+}
+
+func newUsageTracker(o enhancerOptions) *usageTracker {
+	return &usageTracker{
+		promptPricePer1K:     o.promptPricePer1K,
+		completionPricePer1K: o.completionPricePer1K,
+	}
+}
+
+// record adds one enhancement call. Tokens are counted even when the call
+// later fails (e.g. unparseable output) because they were still billed.
+func (t *usageTracker) record(usage TokenUsage, elapsed time.Duration, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err != nil {
+		t.stats.Failures++
+	} else {
+		t.stats.Enhanced++
+	}
+	t.stats.TotalDuration += elapsed
+	t.recent[t.recentNext] = elapsed
+	t.recentNext = (t.recentNext + 1) % durationWindowSize
+	t.recentLen = min(t.recentLen+1, durationWindowSize)
+	t.stats.PromptTokens += int64(usage.PromptTokens)
+	t.stats.CompletionTokens += int64(usage.CompletionTokens)
+	t.stats.EstimatedCostUSD += float64(usage.PromptTokens)/1000*t.promptPricePer1K +
+		float64(usage.CompletionTokens)/1000*t.completionPricePer1K
+}
+
+// recordRepair counts a response that only parsed after JSON repair
+func (t *usageTracker) recordRepair() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.JSONRepairs++
+}
+
+// snapshot returns the current totals
+func (t *usageTracker) snapshot() UsageStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := t.stats
+	stats.P95Duration = percentile(t.recent[:t.recentLen], 0.95)
+	return stats
+}
+
+// percentile returns the nearest-rank p-th percentile (0 < p <= 1) of
+// durations, or 0 when there are none
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,89 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertEnhancer_AccumulatesTokenUsage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"choices": [{"message": {"role": "assistant", "content": ` + quoteJSON(enhancedAlertJSON) + `}}],
+			"usage": {"prompt_tokens": 1200, "completion_tokens": 150, "total_tokens": 1350}
+		}`))
+	}))
+	defer srv.Close()
+
+	enhancer := NewAlertEnhancerWithProvider(NewCompatibleProvider(srv.URL, "", "gpt-4o-mini", nil),
+		WithPricing(0.00015, 0.0006))
+	reporter, ok := enhancer.(UsageReporter)
+	require.True(t, ok, "enhancer should report usage")
+
+	for i := 0; i < 2; i++ {
+		_, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a", Description: "TREE DOWN"})
+		require.NoError(t, err)
+	}
+
+	usage := reporter.Usage()
+	assert.Equal(t, int64(2), usage.Enhanced)
+	assert.Equal(t, int64(0), usage.Failures)
+	assert.Equal(t, int64(2400), usage.PromptTokens)
+	assert.Equal(t, int64(300), usage.CompletionTokens)
+	// 2.4k * $0.00015 + 0.3k * $0.0006
+	assert.InDelta(t, 0.00036+0.00018, usage.EstimatedCostUSD, 1e-9)
+	assert.Greater(t, usage.AvgDuration(), time.Duration(0))
+}
+
+func TestAlertEnhancer_CountsFailures(t *testing.T) {
+	enhancer := NewAlertEnhancer("", "gpt-4o-mini")
+	_, err := enhancer.EnhanceAlert(context.Background(), RawAlert{ID: "a"})
+	require.Error(t, err)
+
+	usage := enhancer.(UsageReporter).Usage()
+	assert.Equal(t, int64(0), usage.Enhanced)
+	assert.Equal(t, int64(1), usage.Failures)
+	assert.Equal(t, int64(0), usage.PromptTokens)
+	assert.Equal(t, 0.0, usage.EstimatedCostUSD)
+}
+
+// quoteJSON encodes s as a JSON string literal
+func quoteJSON(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func TestUsageTracker_P95OverSlidingWindow(t *testing.T) {
+	tracker := newUsageTracker(enhancerOptions{})
+	assert.Equal(t, time.Duration(0), tracker.snapshot().P95Duration, "no calls yet")
+
+	// 1ms..100ms in shuffled order: nearest-rank P95 is 95ms
+	for i := 0; i < 100; i++ {
+		ms := (i*37)%100 + 1
+		tracker.record(TokenUsage{}, time.Duration(ms)*time.Millisecond, nil)
+	}
+	assert.Equal(t, 95*time.Millisecond, tracker.snapshot().P95Duration)
+
+	// A full window of fast calls pushes the slow ones out
+	for i := 0; i < durationWindowSize; i++ {
+		tracker.record(TokenUsage{}, 10*time.Millisecond, nil)
+	}
+	stats := tracker.snapshot()
+	assert.Equal(t, 10*time.Millisecond, stats.P95Duration)
+	assert.Equal(t, int64(100+durationWindowSize), stats.Enhanced, "totals are not windowed")
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{5, 1, 4, 2, 3}
+	assert.Equal(t, time.Duration(5), percentile(durations, 0.95))
+	assert.Equal(t, time.Duration(3), percentile(durations, 0.5))
+	assert.Equal(t, time.Duration(1), percentile(durations, 0.01))
+	assert.Equal(t, []time.Duration{5, 1, 4, 2, 3}, durations, "input must not be reordered")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dpup/prefab/logging"
 	openai "github.com/sashabaranov/go-openai"
 )
 
@@ -180,28 +181,29 @@ type WeatherAlertEnhancer interface {
 	EnhanceWeatherAlert(ctx context.Context, raw RawWeatherAlert) (EnhancedWeatherAlert, error)
 }
 
-// weatherAlertEnhancer implements the WeatherAlertEnhancer interface using OpenAI
+// weatherAlertEnhancer implements the WeatherAlertEnhancer interface on top of an EnhancerProvider
 type weatherAlertEnhancer struct {
-	client *openai.Client
-	model  string
+	provider EnhancerProvider
 }
 
-// NewWeatherAlertEnhancer creates a new WeatherAlertEnhancer implementation
-func NewWeatherAlertEnhancer(apiKey, model string) WeatherAlertEnhancer {
+// NewWeatherAlertEnhancer creates a new WeatherAlertEnhancer backed by OpenAI
+func NewWeatherAlertEnhancer(apiKey, model string, opts ...EnhancerOption) WeatherAlertEnhancer {
 	if apiKey == "" {
-		return &weatherAlertEnhancer{client: nil, model: model}
+		return &weatherAlertEnhancer{provider: nil}
 	}
 
-	client := openai.NewClient(apiKey)
-	return &weatherAlertEnhancer{
-		client: client,
-		model:  model,
-	}
+	return NewWeatherAlertEnhancerWithProvider(NewOpenAIProvider(apiKey, model), opts...)
+}
+
+// NewWeatherAlertEnhancerWithProvider creates a WeatherAlertEnhancer backed by
+// any LLM provider. Options add retries and a fallback model.
+func NewWeatherAlertEnhancerWithProvider(provider EnhancerProvider, opts ...EnhancerOption) WeatherAlertEnhancer {
+	return &weatherAlertEnhancer{provider: wrapProvider(provider, resolveEnhancerOptions(opts))}
 }
 
-// EnhanceWeatherAlert enhances a raw weather alert using OpenAI
+// EnhanceWeatherAlert enhances a raw weather alert using the configured LLM
 func (w *weatherAlertEnhancer) EnhanceWeatherAlert(ctx context.Context, raw RawWeatherAlert) (EnhancedWeatherAlert, error) {
-	if w.client == nil {
+	if w.provider == nil {
 		return EnhancedWeatherAlert{}, errors.New("OpenAI client not initialized - invalid API key")
 	}
 
@@ -221,43 +223,15 @@ Transform this into a readable format with headline, summary, and details.`,
 		raw.Tags,
 		raw.Description)
 
-	// Determine response format based on model capability
-	var responseFormat *openai.ChatCompletionResponseFormat
-	if w.model == "gpt-4o" || w.model == "gpt-4o-mini" {
-		responseFormat = &openai.ChatCompletionResponseFormat{
-			Type:       openai.ChatCompletionResponseFormatTypeJSONSchema,
-			JSONSchema: &WeatherAlertEnhancementSchema,
-		}
-	} else {
-		responseFormat = &openai.ChatCompletionResponseFormat{
-			Type: openai.ChatCompletionResponseFormatTypeJSONObject,
-		}
-	}
-
-	// Make OpenAI API call
-	resp, err := w.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: w.model,
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    openai.ChatMessageRoleSystem,
-				Content: WeatherAlertSystemPrompt,
-			},
-			{
-				Role:    openai.ChatMessageRoleUser,
-				Content: userPrompt,
-			},
-		},
-		ResponseFormat: responseFormat,
-		Temperature:    0.3,
-		MaxTokens:      1000,
+	resp, err := w.provider.Complete(ctx, CompletionRequest{
+		SystemPrompt: WeatherAlertSystemPrompt,
+		UserPrompt:   userPrompt,
+		Schema:       &WeatherAlertEnhancementSchema,
+		Temperature:  0.3,
+		MaxTokens:    1000,
 	})
-
 	if err != nil {
-		return EnhancedWeatherAlert{}, fmt.Errorf("OpenAI API error: %w", err)
-	}
-
-	if len(resp.Choices) == 0 {
-		return EnhancedWeatherAlert{}, errors.New("no response from OpenAI API")
+		return EnhancedWeatherAlert{}, err
 	}
 
 	// Parse the JSON response
@@ -267,9 +241,13 @@ Transform this into a readable format with headline, summary, and details.`,
 		Details  string `json:"details"`
 	}
 
-	jsonResponse := resp.Choices[0].Message.Content
-	if err := json.Unmarshal([]byte(jsonResponse), &result); err != nil {
-		return EnhancedWeatherAlert{}, fmt.Errorf("failed to parse OpenAI JSON response: %w", err)
+	repaired, err := parseModelJSON(resp.Content, &result)
+	if err != nil {
+		return EnhancedWeatherAlert{}, fmt.Errorf("failed to parse %s JSON response: %w", w.provider.Name(), err)
+	}
+	if repaired {
+		logging.Warnw(logging.EnsureLogger(ctx), "Repaired malformed JSON from weather alert enhancer",
+			"provider", w.provider.Name(), "alert_id", raw.ID)
 	}
 
 	// Validate and provide fallbacks
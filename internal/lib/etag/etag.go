@@ -0,0 +1,127 @@
+// Package etag adds ETag validation to HTTP read endpoints, so polling
+// clients that send back the ETag they were given get a 304 Not Modified
+// instead of re-downloading identical JSON.
+package etag
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Middleware tags successful GET responses with an ETag, a hash of the
+// response body, and answers a request whose If-None-Match matches it with
+// 304 Not Modified and no body.
+//
+// Responses are buffered to be hashed. A handler that flushes (a streaming
+// endpoint) is passed through untagged from its first flush. Responses that
+// already carry an ETag are left alone.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bw := &bufferedWriter{ResponseWriter: w}
+		next.ServeHTTP(bw, r)
+		if bw.streaming {
+			return
+		}
+		if bw.status == 0 {
+			bw.status = http.StatusOK
+		}
+		if bw.status != http.StatusOK || w.Header().Get("ETag") != "" {
+			bw.writeBuffered()
+			return
+		}
+
+		tag := Compute(bw.body.Bytes())
+		w.Header().Set("ETag", tag)
+		if Matches(r.Header.Get("If-None-Match"), tag) {
+			w.Header().Del("Content-Length")
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		bw.writeBuffered()
+	})
+}
+
+// Compute returns the strong ETag for body: a quoted, truncated SHA-256
+func Compute(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Matches reports whether an If-None-Match header value matches tag. Weak
+// validators (W/"...") match their strong counterpart, as RFC 9110 requires
+// for If-None-Match.
+func Matches(ifNoneMatch, tag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter holds a response back until it can be hashed, switching to
+// writing straight through once the handler flushes
+type bufferedWriter struct {
+	http.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	streaming bool
+}
+
+func (bw *bufferedWriter) WriteHeader(status int) {
+	if bw.streaming {
+		bw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if bw.status == 0 {
+		bw.status = status
+	}
+}
+
+func (bw *bufferedWriter) Write(b []byte) (int, error) {
+	if bw.streaming {
+		return bw.ResponseWriter.Write(b)
+	}
+	if bw.status == 0 {
+		bw.status = http.StatusOK
+	}
+	return bw.body.Write(b)
+}
+
+// Flush gives up on tagging the response and streams it from here on
+func (bw *bufferedWriter) Flush() {
+	if !bw.streaming {
+		if bw.status == 0 {
+			bw.status = http.StatusOK
+		}
+		bw.writeBuffered()
+		bw.streaming = true
+	}
+	if f, ok := bw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (bw *bufferedWriter) Unwrap() http.ResponseWriter {
+	return bw.ResponseWriter
+}
+
+// writeBuffered sends the held-back status and body
+func (bw *bufferedWriter) writeBuffered() {
+	bw.ResponseWriter.WriteHeader(bw.status)
+	if bw.body.Len() > 0 {
+		_, _ = bw.ResponseWriter.Write(bw.body.Bytes())
+	}
+	bw.body.Reset()
+}
@@ -0,0 +1,72 @@
+package etag
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, h http.Handler, ifNoneMatch string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/roads", nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_NotModified(t *testing.T) {
+	body := `{"roads":[]}`
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, body)
+	}))
+
+	first := get(t, h, "")
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, body, first.Body.String())
+	tag := first.Header().Get("ETag")
+	require.NotEmpty(t, tag)
+
+	second := get(t, h, tag)
+	assert.Equal(t, http.StatusNotModified, second.Code)
+	assert.Empty(t, second.Body.String())
+	assert.Equal(t, tag, second.Header().Get("ETag"))
+
+	// A stale ETag gets the full response
+	stale := get(t, h, `"0123"`)
+	assert.Equal(t, http.StatusOK, stale.Code)
+	assert.Equal(t, body, stale.Body.String())
+
+	// Weak and listed validators match too
+	assert.Equal(t, http.StatusNotModified, get(t, h, `"0123", W/`+tag).Code)
+}
+
+func TestMiddleware_Untagged(t *testing.T) {
+	// Errors aren't tagged
+	notFound := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no such road", http.StatusNotFound)
+	}))
+	rec := get(t, notFound, "*")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Contains(t, rec.Body.String(), "no such road")
+
+	// Streams pass through from their first flush
+	stream := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "one\n")
+		w.(http.Flusher).Flush()
+		_, _ = fmt.Fprint(w, "two\n")
+	}))
+	rec = get(t, stream, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, rec.Flushed)
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Equal(t, "one\ntwo\n", rec.Body.String())
+}
@@ -0,0 +1,70 @@
+package geo
+
+import "math"
+
+// metersPerDegreeLatitude slightly understates a degree of latitude
+// (110.6-111.7km), so expanded boxes err on the large side
+const metersPerDegreeLatitude = 110000.0
+
+// BoundingBox is a latitude/longitude rectangle for cheap proximity
+// prefilters. Boxes crossing the antimeridian are not supported.
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// BoundsOf returns the smallest box containing points. With no points the box
+// is empty and intersects nothing.
+func BoundsOf(points []Point) BoundingBox {
+	box := BoundingBox{
+		MinLatitude:  math.Inf(1),
+		MinLongitude: math.Inf(1),
+		MaxLatitude:  math.Inf(-1),
+		MaxLongitude: math.Inf(-1),
+	}
+	for _, point := range points {
+		box.MinLatitude = math.Min(box.MinLatitude, point.Latitude)
+		box.MinLongitude = math.Min(box.MinLongitude, point.Longitude)
+		box.MaxLatitude = math.Max(box.MaxLatitude, point.Latitude)
+		box.MaxLongitude = math.Max(box.MaxLongitude, point.Longitude)
+	}
+	return box
+}
+
+// IsEmpty reports whether the box contains no points
+func (b BoundingBox) IsEmpty() bool {
+	return b.MinLatitude > b.MaxLatitude || b.MinLongitude > b.MaxLongitude
+}
+
+// Expand grows the box by at least meters on every side, so any point within
+// meters of a point inside the box lies inside the expanded box
+func (b BoundingBox) Expand(meters float64) BoundingBox {
+	if b.IsEmpty() || meters <= 0 {
+		return b
+	}
+
+	latDelta := meters / metersPerDegreeLatitude
+	expanded := BoundingBox{
+		MinLatitude:  math.Max(b.MinLatitude-latDelta, -90),
+		MaxLatitude:  math.Min(b.MaxLatitude+latDelta, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	// Degrees of longitude are shortest at the latitude furthest from the equator
+	maxAbsLatitude := math.Max(math.Abs(expanded.MinLatitude), math.Abs(expanded.MaxLatitude))
+	if cos := math.Cos(maxAbsLatitude * math.Pi / 180); cos > 0.01 {
+		lonDelta := meters / (metersPerDegreeLatitude * cos)
+		expanded.MinLongitude = math.Max(b.MinLongitude-lonDelta, -180)
+		expanded.MaxLongitude = math.Min(b.MaxLongitude+lonDelta, 180)
+	}
+	return expanded
+}
+
+// Intersects reports whether the boxes overlap (touching counts)
+func (b BoundingBox) Intersects(other BoundingBox) bool {
+	return b.MinLatitude <= other.MaxLatitude && other.MinLatitude <= b.MaxLatitude &&
+		b.MinLongitude <= other.MaxLongitude && other.MinLongitude <= b.MaxLongitude
+}
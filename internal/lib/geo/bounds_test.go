@@ -0,0 +1,43 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundingBox_Expand(t *testing.T) {
+	geoUtils := NewGeoUtils()
+	arnold := Point{Latitude: 38.2550, Longitude: -120.3510}
+	box := BoundsOf([]Point{arnold})
+
+	// Points just inside 10km in each direction stay within the expanded box
+	expanded := box.Expand(10000)
+	for _, p := range []Point{
+		{Latitude: arnold.Latitude + 0.0899, Longitude: arnold.Longitude},
+		{Latitude: arnold.Latitude - 0.0899, Longitude: arnold.Longitude},
+		{Latitude: arnold.Latitude, Longitude: arnold.Longitude + 0.114},
+		{Latitude: arnold.Latitude, Longitude: arnold.Longitude - 0.114},
+	} {
+		distance, err := geoUtils.PointToPoint(arnold, p)
+		assert.NoError(t, err)
+		assert.Less(t, distance, 10000.0)
+		assert.True(t, expanded.Intersects(BoundsOf([]Point{p})), "%+v (%.0fm away) outside expanded box", p, distance)
+	}
+
+	// Far points fall outside
+	murphys := Point{Latitude: 38.1391, Longitude: -120.4561}
+	assert.False(t, expanded.Intersects(BoundsOf([]Point{murphys})))
+	assert.True(t, box.Expand(20000).Intersects(BoundsOf([]Point{murphys})))
+}
+
+func TestBoundingBox_Empty(t *testing.T) {
+	empty := BoundsOf(nil)
+	assert.True(t, empty.IsEmpty())
+	assert.True(t, empty.Expand(1000).IsEmpty())
+
+	box := BoundsOf([]Point{{Latitude: 38, Longitude: -120}}).Expand(1000)
+	assert.False(t, box.IsEmpty())
+	assert.False(t, box.Intersects(empty))
+	assert.False(t, empty.Intersects(box))
+}
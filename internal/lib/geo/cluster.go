@@ -0,0 +1,58 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// ClusterPoints groups points greedily: in order, each point joins the
+// cluster with the nearest seed (the cluster's first point) within
+// radiusMeters, or seeds a new cluster. Every member therefore lies within
+// radiusMeters of its seed, so clusters span at most twice the radius.
+// Clusters are returned in the order they were seeded, their points in input
+// order.
+//
+// Seeds are bucketed into latitude bands one radius tall, so each point is
+// only compared with the seeds of its own and the neighbouring bands.
+func (g *geoUtils) ClusterPoints(points []Point, radiusMeters float64) ([][]Point, error) {
+	if radiusMeters <= 0 || math.IsNaN(radiusMeters) || math.IsInf(radiusMeters, 0) {
+		return nil, errors.New("cluster radius must be a positive number of meters")
+	}
+	for _, point := range points {
+		if !isValidCoordinate(point) {
+			return nil, errors.New("invalid coordinates: latitude must be [-90, 90], longitude must be [-180, 180]")
+		}
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	// metersPerDegreeLatitude understates a degree, so bands are at least a
+	// radius tall and a seed within the radius is never more than a band away
+	bandHeight := radiusMeters / metersPerDegreeLatitude
+	seedsByBand := make(map[int][]int) // Band to indexes into clusters
+
+	var clusters [][]Point
+	for _, point := range points {
+		band := int(math.Floor(point.Latitude / bandHeight))
+		nearest, nearestDistance := -1, math.Inf(1)
+		for b := band - 1; b <= band+1; b++ {
+			for _, c := range seedsByBand[b] {
+				distance, err := g.PointToPoint(clusters[c][0], point)
+				if err != nil {
+					return nil, err
+				}
+				if distance <= radiusMeters && distance < nearestDistance {
+					nearest, nearestDistance = c, distance
+				}
+			}
+		}
+		if nearest >= 0 {
+			clusters[nearest] = append(clusters[nearest], point)
+			continue
+		}
+		seedsByBand[band] = append(seedsByBand[band], len(clusters))
+		clusters = append(clusters, []Point{point})
+	}
+	return clusters, nil
+}
@@ -0,0 +1,68 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoUtils_ClusterPoints(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	// Two pileups ~20km apart on Highway 4, each reported a few times within
+	// ~100m, interleaved the way feeds list them
+	angelsCamp := []Point{
+		{Latitude: 38.0675, Longitude: -120.5436},
+		{Latitude: 38.0680, Longitude: -120.5440},
+		{Latitude: 38.0671, Longitude: -120.5431},
+	}
+	arnold := []Point{
+		{Latitude: 38.2553, Longitude: -120.3517},
+		{Latitude: 38.2558, Longitude: -120.3512},
+	}
+	points := []Point{angelsCamp[0], arnold[0], angelsCamp[1], arnold[1], angelsCamp[2]}
+
+	clusters, err := geoUtils.ClusterPoints(points, 500)
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	assert.Equal(t, angelsCamp, clusters[0], "clusters in seed order, members in input order")
+	assert.Equal(t, arnold, clusters[1])
+
+	// A radius tighter than the reports' spread leaves them apart
+	clusters, err = geoUtils.ClusterPoints(points, 10)
+	require.NoError(t, err)
+	assert.Len(t, clusters, len(points))
+
+	// A radius wider than the whole set makes one cluster
+	clusters, err = geoUtils.ClusterPoints(points, 50000)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, points, clusters[0])
+
+	// Identical points, as duplicated reports often are, are one cluster
+	same := []Point{angelsCamp[0], angelsCamp[0], angelsCamp[0]}
+	clusters, err = geoUtils.ClusterPoints(same, 1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]Point{same}, clusters)
+
+	// Points either side of a latitude band boundary still cluster
+	band := 100 / metersPerDegreeLatitude
+	straddling := []Point{{Latitude: 380 * band, Longitude: -120}, {Latitude: 380*band - 1e-6, Longitude: -120}}
+	clusters, err = geoUtils.ClusterPoints(straddling, 100)
+	require.NoError(t, err)
+	assert.Len(t, clusters, 1)
+}
+
+func TestGeoUtils_ClusterPoints_Invalid(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	clusters, err := geoUtils.ClusterPoints(nil, 100)
+	require.NoError(t, err)
+	assert.Empty(t, clusters)
+
+	_, err = geoUtils.ClusterPoints([]Point{{Latitude: 38, Longitude: -120}}, 0)
+	assert.Error(t, err, "radius must be positive")
+	_, err = geoUtils.ClusterPoints([]Point{{Latitude: 95, Longitude: -120}}, 100)
+	assert.Error(t, err, "invalid coordinates")
+}
@@ -3,6 +3,7 @@ package geo
 import (
 	"errors"
 	"math"
+	"sort"
 
 	"github.com/twpayne/go-polyline"
 )
@@ -247,7 +248,10 @@ func (g *geoUtils) findCloserPoint(point, segStart, segEnd Point) Point {
 	return segEnd
 }
 
-// PolylineOverlapPercentage calculates percentage of polyline1 that overlaps with polyline2 using detailed sampling
+// PolylineOverlapPercentage calculates percentage of polyline1 that overlaps with polyline2 using detailed sampling.
+// Overlap is measured along polyline2, so a polyline1 that doubles back (a
+// switchback whose legs both lie within the threshold) counts a covered stretch
+// of polyline2 once rather than once per pass.
 func (g *geoUtils) PolylineOverlapPercentage(polyline1, polyline2 Polyline, thresholdMeters float64) (float64, error) {
 	if len(polyline1.Points) < 2 || len(polyline2.Points) < 2 {
 		return 0, errors.New("both polylines must have at least 2 points")
@@ -259,42 +263,91 @@ func (g *geoUtils) PolylineOverlapPercentage(polyline1, polyline2 Polyline, thre
 		segmentLength, _ := g.PointToPoint(polyline1.Points[i], polyline1.Points[i+1])
 		totalLength += segmentLength
 	}
-	
+
 	if totalLength == 0 {
 		return 0, nil
 	}
 
-	// Calculate overlapping length using fine-grained sampling
-	overlappingLength := 0.0
+	// Distance along polyline2 to the start of each of its segments
+	offsets := g.cumulativeDistances(polyline2)
+
+	// Sample polyline1 finely; each sample within the threshold covers its
+	// share of polyline1's length, placed where it projects onto polyline2
+	var covered []interval
 	sampleDistance := 25.0 // Sample every 25 meters for accuracy
-	
+
 	for i := 0; i < len(polyline1.Points)-1; i++ {
 		seg1Start := polyline1.Points[i]
 		seg1End := polyline1.Points[i+1]
 		segmentLength, _ := g.PointToPoint(seg1Start, seg1End)
-		
-		// Sample this segment to determine what portion overlaps
+
 		numSamples := int(math.Max(2, math.Ceil(segmentLength/sampleDistance)))
-		overlappingSamples := 0
-		
+		share := segmentLength / float64(numSamples)
+
 		for s := 0; s < numSamples; s++ {
 			t := float64(s) / float64(numSamples-1)
 			samplePoint := g.interpolatePoint(seg1Start, seg1End, t)
-			
-			// Check if this sample point is close to polyline2
-			distance, _ := g.PointToPolyline(samplePoint, polyline2)
+
+			distance, along := g.projectOntoPolyline(samplePoint, polyline2, offsets)
 			if distance <= thresholdMeters {
-				overlappingSamples++
+				covered = append(covered, interval{along - share/2, along + share/2})
 			}
 		}
-		
-		// Calculate the proportion of this segment that overlaps
-		overlapProportion := float64(overlappingSamples) / float64(numSamples)
-		overlappingLength += segmentLength * overlapProportion
 	}
-	
-	percentage := (overlappingLength / totalLength) * 100
-	return percentage, nil
+
+	percentage := (unionLength(covered) / totalLength) * 100
+	return math.Min(percentage, 100), nil
+}
+
+// interval is a stretch of a polyline, in meters from its start
+type interval struct {
+	start, end float64
+}
+
+// unionLength returns the total length covered by intervals, counting
+// stretches covered more than once a single time
+func unionLength(intervals []interval) float64 {
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+
+	total := 0.0
+	var current interval
+	for i, iv := range intervals {
+		if i == 0 || iv.start > current.end {
+			total += current.end - current.start
+			current = iv
+			continue
+		}
+		current.end = math.Max(current.end, iv.end)
+	}
+	return total + current.end - current.start
+}
+
+// projectOntoPolyline returns the distance from point to the nearest point on
+// polyline and how far along polyline (from its first point) that nearest
+// point lies. offsets holds the distance along polyline to each of its points.
+func (g *geoUtils) projectOntoPolyline(point Point, polyline Polyline, offsets []float64) (distance, along float64) {
+	distance = math.Inf(1)
+	for i := 0; i < len(polyline.Points)-1; i++ {
+		start, end := polyline.Points[i], polyline.Points[i+1]
+
+		// Project in a local flat plane around the segment start, which is
+		// accurate at road-segment scale
+		cosLat := math.Cos(start.Latitude * math.Pi / 180)
+		dx, dy := (end.Longitude-start.Longitude)*cosLat, end.Latitude-start.Latitude
+		px, py := (point.Longitude-start.Longitude)*cosLat, point.Latitude-start.Latitude
+		t := 0.0
+		if lengthSquared := dx*dx + dy*dy; lengthSquared > 0 {
+			t = math.Max(0, math.Min(1, (px*dx+py*dy)/lengthSquared))
+		}
+
+		projected := g.interpolatePoint(start, end, t)
+		d, _ := g.PointToPoint(point, projected)
+		if d < distance {
+			distance = d
+			along = offsets[i] + t*(offsets[i+1]-offsets[i])
+		}
+	}
+	return distance, along
 }
 
 // DecodePolyline decodes Google polyline string to point sequence
@@ -325,6 +378,16 @@ func (g *geoUtils) DecodePolyline(encoded string) ([]Point, error) {
 	return points, nil
 }
 
+// EncodePolyline encodes a point sequence as a Google polyline string (5
+// decimal places); the inverse of DecodePolyline
+func (g *geoUtils) EncodePolyline(points []Point) string {
+	coords := make([][]float64, len(points))
+	for i, point := range points {
+		coords[i] = []float64{point.Latitude, point.Longitude}
+	}
+	return string(polyline.EncodeCoords(coords))
+}
+
 // ClosestPointOnPolyline finds closest point on polyline to given point
 func (g *geoUtils) ClosestPointOnPolyline(point Point, polyline Polyline) (Point, error) {
 	if !isValidCoordinate(point) {
@@ -359,6 +422,68 @@ func (g *geoUtils) ClosestPointOnPolyline(point Point, polyline Polyline) (Point
 	return closestPoint, nil
 }
 
+// DistanceAlongPolyline returns how far along polyline, in meters from its
+// first point, the nearest point to point lies
+func (g *geoUtils) DistanceAlongPolyline(point Point, polyline Polyline) (float64, error) {
+	if !isValidCoordinate(point) {
+		return 0, errors.New("invalid point coordinates")
+	}
+	if len(polyline.Points) < 2 {
+		return 0, errors.New("polyline must have at least 2 points")
+	}
+	_, along := g.projectOntoPolyline(point, polyline, g.cumulativeDistances(polyline))
+	return along, nil
+}
+
+// PointAlongPolyline returns the point meters along polyline from its first
+// point, walking its segments by cumulative distance. Distances past either
+// end give that end.
+func (g *geoUtils) PointAlongPolyline(polyline Polyline, meters float64) (Point, error) {
+	if len(polyline.Points) == 0 {
+		return Point{}, errors.New("polyline has no points")
+	}
+	if meters <= 0 {
+		return polyline.Points[0], nil
+	}
+	offsets := g.cumulativeDistances(polyline)
+	for i := 1; i < len(offsets); i++ {
+		if meters <= offsets[i] {
+			segmentLength := offsets[i] - offsets[i-1]
+			if segmentLength == 0 {
+				return polyline.Points[i], nil
+			}
+			t := (meters - offsets[i-1]) / segmentLength
+			return g.interpolatePoint(polyline.Points[i-1], polyline.Points[i], t), nil
+		}
+	}
+	return polyline.Points[len(polyline.Points)-1], nil
+}
+
+// PolylineLength returns the length of polyline in meters, the sum of the
+// distances between its consecutive points
+func (g *geoUtils) PolylineLength(polyline Polyline) (float64, error) {
+	if len(polyline.Points) < 2 {
+		return 0, errors.New("polyline must have at least 2 points")
+	}
+	for _, point := range polyline.Points {
+		if !isValidCoordinate(point) {
+			return 0, errors.New("invalid coordinates: latitude must be [-90, 90], longitude must be [-180, 180]")
+		}
+	}
+	offsets := g.cumulativeDistances(polyline)
+	return offsets[len(offsets)-1], nil
+}
+
+// cumulativeDistances returns the distance along polyline to each of its points
+func (g *geoUtils) cumulativeDistances(polyline Polyline) []float64 {
+	offsets := make([]float64, len(polyline.Points))
+	for i := 1; i < len(polyline.Points); i++ {
+		segmentLength, _ := g.PointToPoint(polyline.Points[i-1], polyline.Points[i])
+		offsets[i] = offsets[i-1] + segmentLength
+	}
+	return offsets
+}
+
 // closestPointOnSegment finds the closest point on a line segment to a given point
 func (g *geoUtils) closestPointOnSegment(point, segmentStart, segmentEnd Point) Point {
 	// If segment is just a point
@@ -137,6 +137,16 @@ func TestGeoUtils_DecodePolyline(t *testing.T) {
 	assert.Error(t, err, "Should return error for invalid polyline")
 }
 
+func TestGeoUtils_EncodePolyline(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	encodedPolyline := "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+	points, err := geoUtils.DecodePolyline(encodedPolyline)
+	require.NoError(t, err)
+
+	assert.Equal(t, encodedPolyline, geoUtils.EncodePolyline(points), "Encoding decoded points should round-trip")
+}
+
 func TestGeoUtils_ClosestPointOnPolyline(t *testing.T) {
 	geoUtils := NewGeoUtils()
 	
@@ -158,6 +168,69 @@ func TestGeoUtils_ClosestPointOnPolyline(t *testing.T) {
 	assert.LessOrEqual(t, closestPoint.Longitude, 180.0)
 }
 
+func TestGeoUtils_PositionAlongPolyline(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	// An L: ~1.1km east, then ~1.1km north
+	corner := Point{Latitude: 38.0, Longitude: -120.0}
+	route := Polyline{Points: []Point{
+		{Latitude: 38.0, Longitude: -120.0127},
+		corner,
+		{Latitude: 38.01, Longitude: -120.0},
+	}}
+	leg, _ := geoUtils.PointToPoint(route.Points[0], corner)
+	second, _ := geoUtils.PointToPoint(corner, route.Points[2])
+	length, err := geoUtils.PolylineLength(route)
+	require.NoError(t, err)
+	assert.InDelta(t, leg+second, length, 1e-6)
+
+	point, err := geoUtils.PointAlongPolyline(route, leg+500)
+	require.NoError(t, err)
+	assert.InDelta(t, -120.0, point.Longitude, 1e-6, "500m past the corner is on the northbound leg")
+	assert.InDelta(t, 38.0045, point.Latitude, 1e-4)
+
+	along, err := geoUtils.DistanceAlongPolyline(Point{Latitude: 38.0045, Longitude: -119.999}, route)
+	require.NoError(t, err)
+	assert.InDelta(t, leg+500, along, 5, "a point beside the route projects to its distance along it")
+
+	// Past either end clamps to the end
+	start, _ := geoUtils.PointAlongPolyline(route, -10)
+	assert.Equal(t, route.Points[0], start)
+	end, _ := geoUtils.PointAlongPolyline(route, 1e6)
+	assert.Equal(t, route.Points[2], end)
+
+	_, err = geoUtils.DistanceAlongPolyline(corner, Polyline{Points: []Point{corner}})
+	assert.Error(t, err)
+}
+
+func TestGeoUtils_PolylineLength(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	points := []Point{
+		{Latitude: 38.0, Longitude: -120.0},
+		{Latitude: 38.01, Longitude: -120.0},
+		{Latitude: 38.01, Longitude: -120.01},
+		{Latitude: 38.02, Longitude: -120.01},
+	}
+	var want float64
+	for i := 1; i < len(points); i++ {
+		d, err := geoUtils.PointToPoint(points[i-1], points[i])
+		require.NoError(t, err)
+		want += d
+	}
+	length, err := geoUtils.PolylineLength(Polyline{Points: points})
+	require.NoError(t, err)
+	assert.InDelta(t, want, length, 1e-6)
+	assert.InDelta(t, 3100, length, 100, "three ~1km legs")
+
+	_, err = geoUtils.PolylineLength(Polyline{Points: points[:1]})
+	assert.Error(t, err, "a single point has no length")
+	_, err = geoUtils.PolylineLength(Polyline{})
+	assert.Error(t, err, "an empty polyline has no length")
+	_, err = geoUtils.PolylineLength(Polyline{Points: []Point{points[0], {Latitude: 95, Longitude: -120}}})
+	assert.Error(t, err, "invalid coordinates")
+}
+
 // Test edge cases and validation
 func TestGeoUtils_EdgeCases(t *testing.T) {
 	geoUtils := NewGeoUtils()
@@ -254,4 +327,32 @@ func TestGeoUtils_PolylineOverlapPercentageAccuracy(t *testing.T) {
 	percentage, err = geoUtils.PolylineOverlapPercentage(mainRoute, noOverlapRoute, 50.0)
 	require.NoError(t, err)
 	assert.Equal(t, 0.0, percentage, "No overlap should return 0%")
-}
\ No newline at end of file
+}
+// A switchback's legs lie within the threshold of each other, so a closure on
+// one leg is near both; only the closed stretch should count
+func TestGeoUtils_PolylineOverlapPercentageHairpin(t *testing.T) {
+	geoUtils := NewGeoUtils()
+
+	// Two ~870 m legs 30 m apart, joined by the hairpin
+	hairpinRoute := Polyline{Points: []Point{
+		{Latitude: 38.40000, Longitude: -120.1000},
+		{Latitude: 38.40000, Longitude: -120.0900},
+		{Latitude: 38.40027, Longitude: -120.0900},
+		{Latitude: 38.40027, Longitude: -120.1000},
+	}}
+
+	// Closure covering the first pass only
+	firstPass := Polyline{Points: []Point{
+		{Latitude: 38.40000, Longitude: -120.1000},
+		{Latitude: 38.40000, Longitude: -120.0900},
+	}}
+
+	percentage, err := geoUtils.PolylineOverlapPercentage(hairpinRoute, firstPass, 50.0)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, percentage, 5.0, "Closure on one pass should cover about half the route")
+
+	// The closure itself still lies entirely on the route
+	percentage, err = geoUtils.PolylineOverlapPercentage(firstPass, hairpinRoute, 50.0)
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, percentage, 5.0)
+}
@@ -36,8 +36,25 @@ type GeoUtils interface {
 	// Decode Google polyline string to point sequence
 	DecodePolyline(encoded string) ([]Point, error)
 
+	// Encode point sequence as a Google polyline string
+	EncodePolyline(points []Point) string
+
 	// Find closest point on polyline to given point
 	ClosestPointOnPolyline(point Point, polyline Polyline) (Point, error)
+
+	// Distance in meters along polyline, from its first point, to where point
+	// projects onto it
+	DistanceAlongPolyline(point Point, polyline Polyline) (float64, error)
+
+	// Point meters along polyline from its first point, clamped to its ends
+	PointAlongPolyline(polyline Polyline, meters float64) (Point, error)
+
+	// Total length of polyline in meters
+	PolylineLength(polyline Polyline) (float64, error)
+
+	// Group points into clusters whose members lie within radiusMeters of the
+	// cluster's first point
+	ClusterPoints(points []Point, radiusMeters float64) ([][]Point, error)
 	
 	// Filter points to those within specified distance of center point
 	FilterPointsByDistance(points []Point, center Point, maxDistanceMeters float64) ([]Point, error)
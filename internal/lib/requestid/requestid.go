@@ -0,0 +1,93 @@
+// Package requestid carries a correlation ID through a request's context and
+// its log lines, so one API call can be followed from ListRoads through the
+// refresh it triggers and into alert enhancement.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"unicode"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/dpup/prefab/serverutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header is the HTTP header (and gRPC metadata key) that carries the ID. A
+// caller-supplied value is reused; otherwise one is generated. The ID is
+// echoed back in the response headers.
+const Header = "X-Request-Id"
+
+// Field is the log field holding the ID
+const Field = "request_id"
+
+// maxLength bounds caller-supplied IDs so they can't bloat every log line
+const maxLength = 128
+
+type ctxKey struct{}
+
+// New returns a random 16-character hex ID
+func New() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// With returns a context carrying id, with a child logger that adds it to
+// every log line. Use it to start a new scope, e.g. one periodic refresh.
+func With(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, ctxKey{}, id)
+	if logger := logging.FromContext(ctx); logger != nil {
+		ctx = logging.With(ctx, logger.With(Field, id))
+	}
+	return ctx
+}
+
+// FromContext returns the ID carried by ctx, or "" when there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// UnaryServerInterceptor tags each gRPC request with an ID: the caller's
+// X-Request-Id (forwarded by the gateway, or sent as gRPC metadata) when it is
+// usable, else a new one. The ID is tracked on Prefab's per-request logger so
+// the access log line carries it too.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	id := incomingID(ctx)
+	if id == "" {
+		id = New()
+	}
+
+	logging.Track(ctx, Field, id)
+	ctx = context.WithValue(ctx, ctxKey{}, id)
+
+	// Echoed as a response header by the gateway. Not fatal: the ID is
+	// still logged.
+	_ = serverutil.SendHeader(ctx, "x-request-id", id)
+
+	return handler(ctx, req)
+}
+
+// incomingID returns a caller-supplied ID, or "" when none was sent or it
+// isn't a short printable token
+func incomingID(ctx context.Context) string {
+	id := serverutil.HTTPHeader(ctx, Header)
+	if id == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get(Header); len(v) > 0 {
+			id = v[0]
+		}
+	}
+	if id == "" || len(id) > maxLength {
+		return ""
+	}
+	for _, r := range id {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) || r == ' ' {
+			return ""
+		}
+	}
+	return id
+}
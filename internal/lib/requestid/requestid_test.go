@@ -0,0 +1,58 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/dpup/prefab/serverutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// handledID runs the interceptor with incoming metadata md and returns the ID
+// the handler saw
+func handledID(t *testing.T, md metadata.MD) string {
+	t.Helper()
+	ctx := metadata.NewIncomingContext(logging.EnsureLogger(context.Background()), md)
+	var seen string
+	_, err := UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		seen = FromContext(ctx)
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	return seen
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	// Forwarded by the gateway from the HTTP header
+	assert.Equal(t, "abc-123", handledID(t, metadata.Pairs(serverutil.MetadataHeaderPrefix+"x-request-id", "abc-123")))
+
+	// Sent directly as gRPC metadata
+	assert.Equal(t, "abc-456", handledID(t, metadata.Pairs("x-request-id", "abc-456")))
+
+	// Missing or unusable IDs are replaced with a generated one
+	for _, md := range []metadata.MD{
+		{},
+		metadata.Pairs("x-request-id", "has spaces"),
+		metadata.Pairs("x-request-id", "line\nbreak"),
+		metadata.Pairs("x-request-id", strings.Repeat("a", maxLength+1)),
+	} {
+		id := handledID(t, md)
+		assert.Len(t, id, 16, "metadata %v", md)
+	}
+}
+
+func TestWith(t *testing.T) {
+	assert.Equal(t, "", FromContext(context.Background()))
+
+	ctx := With(context.Background(), "no-logger")
+	assert.Equal(t, "no-logger", FromContext(ctx))
+
+	parent := logging.EnsureLogger(context.Background())
+	ctx = With(parent, "scoped")
+	assert.Equal(t, "scoped", FromContext(ctx))
+	assert.NotSame(t, logging.FromContext(parent), logging.FromContext(ctx), "With starts a new logging scope")
+}
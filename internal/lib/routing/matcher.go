@@ -9,21 +9,73 @@ import (
 	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
 )
 
+// DefaultOnRouteThresholdMeters is the distance within which an alert is
+// ON_ROUTE unless a matcher is built with NewRouteMatcherWithThreshold
+const DefaultOnRouteThresholdMeters = 100.0
+
+// DefaultOnRouteOverlapPercent is the share of a multi-point closure that must
+// run along a route for it to be ON_ROUTE regardless of its nearest point
+const DefaultOnRouteOverlapPercent = 10.0
+
+// overlapThresholdFactor scales the ON_ROUTE threshold to the distance within
+// which a closure counts as running along the route, unless a matcher is built
+// WithOverlapThresholdMeters. Caltrans closure LineStrings are digitized
+// independently of Google's route geometry, so a closure on the route itself
+// can sit slightly beyond the ON_ROUTE threshold.
+const overlapThresholdFactor = 2.0
+
 // routeMatcher implements the RouteMatcher interface
 type routeMatcher struct {
-	geoUtils         geo.GeoUtils
-	routeCache       map[string]Route
-	cacheMutex       sync.RWMutex
-	onRouteThreshold float64 // Distance in meters for ON_ROUTE classification
+	geoUtils              geo.GeoUtils
+	routeCache            map[string]Route
+	cacheMutex            sync.RWMutex
+	onRouteThreshold      float64 // Distance in meters for ON_ROUTE classification
+	onRouteOverlapPercent float64 // Closure overlap percentage for ON_ROUTE classification
+	overlapThreshold      float64 // Distance in meters a closure runs along the route within; 0 derives it from onRouteThreshold
+}
+
+// RouteMatcherOption configures a RouteMatcher
+type RouteMatcherOption func(*routeMatcher)
+
+// WithOnRouteOverlapPercent classifies a multi-point closure ON_ROUTE when
+// more than percent of its length runs along the route (100 disables)
+func WithOnRouteOverlapPercent(percent float64) RouteMatcherOption {
+	return func(r *routeMatcher) {
+		r.onRouteOverlapPercent = percent
+	}
+}
+
+// WithOverlapThresholdMeters sets the distance within which a closure counts
+// as running along the route for WithOnRouteOverlapPercent. Defaults to twice
+// the ON_ROUTE threshold.
+func WithOverlapThresholdMeters(meters float64) RouteMatcherOption {
+	return func(r *routeMatcher) {
+		r.overlapThreshold = meters
+	}
 }
 
 // NewRouteMatcher creates a new RouteMatcher implementation
-func NewRouteMatcher() RouteMatcher {
-	return &routeMatcher{
-		geoUtils:         geo.NewGeoUtils(),
-		routeCache:       make(map[string]Route),
-		onRouteThreshold: 100.0, // 100 meters default threshold for ON_ROUTE
+func NewRouteMatcher(opts ...RouteMatcherOption) RouteMatcher {
+	return newRouteMatcher(DefaultOnRouteThresholdMeters, opts...)
+}
+
+// NewRouteMatcherWithThreshold creates a RouteMatcher that classifies alerts
+// within thresholdMeters of a route as ON_ROUTE
+func NewRouteMatcherWithThreshold(thresholdMeters float64, opts ...RouteMatcherOption) RouteMatcher {
+	return newRouteMatcher(thresholdMeters, opts...)
+}
+
+func newRouteMatcher(thresholdMeters float64, opts ...RouteMatcherOption) *routeMatcher {
+	r := &routeMatcher{
+		geoUtils:              geo.NewGeoUtils(),
+		routeCache:            make(map[string]Route),
+		onRouteThreshold:      thresholdMeters,
+		onRouteOverlapPercent: DefaultOnRouteOverlapPercent,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // ClassifyAlert classifies a single alert against all provided routes
@@ -38,36 +90,46 @@ func (r *routeMatcher) ClassifyAlert(ctx context.Context, alert UnclassifiedAler
 		}, nil
 	}
 
+	// Distance to the closest matching route; to the closest route at all
+	// when none match (DISTANT)
 	minDistance := float64(999999)
+	minMatchingDistance := float64(999999)
 	var matchingRouteIDs []string
 	classification := Distant
 
-	// Check alert against each route
+	// Classify against each route independently and keep the strongest, so
+	// the result doesn't depend on route order
 	for _, route := range routes {
 		distance, matches, err := r.classifyAlertAgainstRoute(alert, route)
 		if err != nil {
 			return ClassifiedAlert{}, err
 		}
 
-		if matches {
-			matchingRouteIDs = append(matchingRouteIDs, route.ID)
-		}
-
 		if distance < minDistance {
 			minDistance = distance
 		}
+		if !matches {
+			continue
+		}
+
+		matchingRouteIDs = append(matchingRouteIDs, route.ID)
+		if distance < minMatchingDistance {
+			minMatchingDistance = distance
+		}
 
-		// Determine classification based on distance and threshold
-		if distance <= r.onRouteThreshold {
-			classification = OnRoute
-		} else if distance <= route.MaxDistance && classification != OnRoute {
-			classification = Nearby
+		// ON_ROUTE by distance, or for closures that miss the threshold, by
+		// how much of them runs along the route
+		routeClassification := Nearby
+		if distance <= r.onRouteThreshold || r.overlapsRoute(alert, route) {
+			routeClassification = OnRoute
+		}
+		if classificationRank(routeClassification) > classificationRank(classification) {
+			classification = routeClassification
 		}
 	}
 
-	// If no routes matched, it's distant
-	if len(matchingRouteIDs) == 0 {
-		classification = Distant
+	if len(matchingRouteIDs) > 0 {
+		minDistance = minMatchingDistance
 	}
 
 	return ClassifiedAlert{
@@ -78,6 +140,18 @@ func (r *routeMatcher) ClassifyAlert(ctx context.Context, alert UnclassifiedAler
 	}, nil
 }
 
+// classificationRank orders classifications by strength: ON_ROUTE > NEARBY > DISTANT
+func classificationRank(classification AlertClassification) int {
+	switch classification {
+	case OnRoute:
+		return 2
+	case Nearby:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // classifyAlertAgainstRoute determines if an alert matches a specific route
 func (r *routeMatcher) classifyAlertAgainstRoute(alert UnclassifiedAlert, route Route) (distance float64, matches bool, err error) {
 	// Validate route has valid geometry
@@ -144,8 +218,49 @@ func (r *routeMatcher) classifyPolylineBasedAlertSimple(alert UnclassifiedAlert,
 	return minDistance, matches, nil
 }
 
-// GetRouteAlerts returns alerts for a specific route, prioritizing ON_ROUTE alerts
-func (r *routeMatcher) GetRouteAlerts(ctx context.Context, routeID string, alerts []ClassifiedAlert) ([]ClassifiedAlert, error) {
+// overlapsRoute reports whether more than onRouteOverlapPercent of a
+// multi-point closure lies within the overlap threshold of the route
+func (r *routeMatcher) overlapsRoute(alert UnclassifiedAlert, route Route) bool {
+	if alert.AffectedPolyline == nil || len(alert.AffectedPolyline.Points) < 2 {
+		return false
+	}
+
+	threshold := r.overlapThreshold
+	if threshold <= 0 {
+		threshold = r.onRouteThreshold * overlapThresholdFactor
+	}
+	percentage, err := r.geoUtils.PolylineOverlapPercentage(*alert.AffectedPolyline, route.Polyline, threshold)
+	if err != nil {
+		return false
+	}
+	return percentage > r.onRouteOverlapPercent
+}
+
+// RouteAlertsOption configures GetRouteAlerts
+type RouteAlertsOption func(*routeAlertsOptions)
+
+type routeAlertsOptions struct {
+	progressRoute *Route // Sort along this route's geometry; nil sorts by priority
+}
+
+// SortByRouteProgress orders GetRouteAlerts in the direction of travel, from
+// route's origin to its destination, by each alert's RoutePositionMeters, or
+// where its location projects onto the route's polyline when that is unset.
+// Alerts at the same point keep priority order.
+func SortByRouteProgress(route Route) RouteAlertsOption {
+	return func(o *routeAlertsOptions) {
+		o.progressRoute = &route
+	}
+}
+
+// GetRouteAlerts returns alerts for a specific route, prioritizing ON_ROUTE
+// alerts unless SortByRouteProgress orders them along the route
+func (r *routeMatcher) GetRouteAlerts(ctx context.Context, routeID string, alerts []ClassifiedAlert, opts ...RouteAlertsOption) ([]ClassifiedAlert, error) {
+	var options routeAlertsOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	var routeAlerts []ClassifiedAlert
 
 	// Filter alerts that affect the specified route
@@ -158,47 +273,87 @@ func (r *routeMatcher) GetRouteAlerts(ctx context.Context, routeID string, alert
 		}
 	}
 
-	// Sort alerts: ON_ROUTE first, then NEARBY, by severity and distance
 	sort.Slice(routeAlerts, func(i, j int) bool {
-		alertI := routeAlerts[i]
-		alertJ := routeAlerts[j]
+		return alertPriorityLess(routeAlerts[i], routeAlerts[j])
+	})
+	if options.progressRoute != nil {
+		if err := r.sortByRouteProgress(routeAlerts, *options.progressRoute); err != nil {
+			return nil, err
+		}
+	}
 
-		// First priority: ON_ROUTE alerts come first
-		if alertI.Classification != alertJ.Classification {
-			if alertI.Classification == OnRoute {
-				return true
+	return routeAlerts, nil
+}
+
+// sortByRouteProgress stably sorts alerts by how far along route each lies:
+// its RoutePositionMeters when set (e.g. from a mile marker), so the order
+// agrees with the positions reported on the alerts, otherwise where its
+// location projects onto the route
+func (r *routeMatcher) sortByRouteProgress(alerts []ClassifiedAlert, route Route) error {
+	type placedAlert struct {
+		alert ClassifiedAlert
+		along float64
+	}
+	placed := make([]placedAlert, len(alerts))
+	for i, alert := range alerts {
+		along := alert.RoutePositionMeters
+		if along == 0 {
+			if len(route.Polyline.Points) < 2 {
+				return errors.New("route must have at least 2 points")
 			}
-			if alertJ.Classification == OnRoute {
-				return false
+			var err error
+			along, err = r.geoUtils.DistanceAlongPolyline(alert.Location, route.Polyline)
+			if err != nil {
+				return err
 			}
 		}
+		placed[i] = placedAlert{alert, along}
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		return placed[i].along < placed[j].along
+	})
+	for i := range placed {
+		alerts[i] = placed[i].alert
+	}
+	return nil
+}
 
-		// Second priority: Sort by distance (closer first)
-		if alertI.DistanceToRoute != alertJ.DistanceToRoute {
-			return alertI.DistanceToRoute < alertJ.DistanceToRoute
+// alertPriorityLess orders alerts ON_ROUTE first, then by distance to the
+// route, then closures before construction, incidents and weather
+func alertPriorityLess(alertI, alertJ ClassifiedAlert) bool {
+	// First priority: ON_ROUTE alerts come first
+	if alertI.Classification != alertJ.Classification {
+		if alertI.Classification == OnRoute {
+			return true
 		}
-
-		// Third priority: Sort by alert type (closures first, then incidents)
-		typeOrder := map[string]int{
-			"closure":      1,
-			"construction": 2,
-			"incident":     3,
-			"weather":      4,
+		if alertJ.Classification == OnRoute {
+			return false
 		}
+	}
 
-		orderI := typeOrder[alertI.Type]
-		orderJ := typeOrder[alertJ.Type]
-		if orderI == 0 {
-			orderI = 5 // Unknown type
-		}
-		if orderJ == 0 {
-			orderJ = 5 // Unknown type
-		}
+	// Second priority: Sort by distance (closer first)
+	if alertI.DistanceToRoute != alertJ.DistanceToRoute {
+		return alertI.DistanceToRoute < alertJ.DistanceToRoute
+	}
 
-		return orderI < orderJ
-	})
+	// Third priority: Sort by alert type (closures first, then incidents)
+	typeOrder := map[string]int{
+		"closure":      1,
+		"construction": 2,
+		"incident":     3,
+		"weather":      4,
+	}
 
-	return routeAlerts, nil
+	orderI := typeOrder[alertI.Type]
+	orderJ := typeOrder[alertJ.Type]
+	if orderI == 0 {
+		orderI = 5 // Unknown type
+	}
+	if orderJ == 0 {
+		orderJ = 5 // Unknown type
+	}
+
+	return orderI < orderJ
 }
 
 // UpdateRouteGeometry updates the geometry of a cached route
@@ -208,6 +208,64 @@ func TestRouteMatcher_GetRouteAlerts(t *testing.T) {
 	assert.Equal(t, "alert-001", routeAlerts[0].ID)
 }
 
+func TestRouteMatcher_GetRouteAlerts_RouteProgress(t *testing.T) {
+	matcher := NewRouteMatcher()
+	ctx := context.Background()
+
+	// Westbound, then north around a bend
+	route := Route{
+		ID: "hwy4-murphys-arnold",
+		Polyline: geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.1391, Longitude: -120.4561},
+			{Latitude: 38.1400, Longitude: -120.4800},
+			{Latitude: 38.2000, Longitude: -120.4800},
+		}},
+	}
+	alertAt := func(id string, classification AlertClassification, distance float64, location geo.Point) ClassifiedAlert {
+		return ClassifiedAlert{
+			UnclassifiedAlert: UnclassifiedAlert{ID: id, Type: "incident", Location: location},
+			Classification:    classification,
+			RouteIDs:          []string{route.ID},
+			DistanceToRoute:   distance,
+		}
+	}
+	alerts := []ClassifiedAlert{
+		alertAt("after-bend", OnRoute, 5, geo.Point{Latitude: 38.1800, Longitude: -120.4801}),
+		alertAt("near-origin", Nearby, 800, geo.Point{Latitude: 38.1460, Longitude: -120.4600}),
+		alertAt("before-bend", OnRoute, 20, geo.Point{Latitude: 38.1398, Longitude: -120.4750}),
+		alertAt("other-road", OnRoute, 0, geo.Point{Latitude: 38.1395, Longitude: -120.4700}),
+	}
+	alerts[3].RouteIDs = []string{"hwy49-angels-camp"}
+
+	ids := func(alerts []ClassifiedAlert) []string {
+		var ids []string
+		for _, alert := range alerts {
+			ids = append(ids, alert.ID)
+		}
+		return ids
+	}
+
+	byProgress, err := matcher.GetRouteAlerts(ctx, route.ID, alerts, SortByRouteProgress(route))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"near-origin", "before-bend", "after-bend"}, ids(byProgress), "alerts should follow the route from origin to destination")
+
+	// The default is still priority order
+	byPriority, err := matcher.GetRouteAlerts(ctx, route.ID, alerts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"after-bend", "before-bend", "near-origin"}, ids(byPriority))
+
+	// A position already placed on the route (e.g. by mile marker) wins over
+	// where the alert's coordinates project
+	placed := append([]ClassifiedAlert(nil), alerts...)
+	placed[0].RoutePositionMeters = 1 // after-bend, by its mile marker at the origin
+	byPosition, err := matcher.GetRouteAlerts(ctx, route.ID, placed, SortByRouteProgress(route))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"after-bend", "near-origin", "before-bend"}, ids(byPosition))
+
+	_, err = matcher.GetRouteAlerts(ctx, route.ID, alerts, SortByRouteProgress(Route{ID: route.ID}))
+	assert.Error(t, err, "a route without geometry can't order alerts")
+}
+
 func TestRouteMatcher_UpdateRouteGeometry(t *testing.T) {
 	matcher := NewRouteMatcher()
 	ctx := context.Background()
@@ -319,4 +377,130 @@ func BenchmarkRouteMatcher_ClassifyAlert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = matcher.ClassifyAlert(ctx, alert, routes)
 	}
-}
\ No newline at end of file
+}
+func TestRouteMatcher_ClosureOverlapOnRoute(t *testing.T) {
+	ctx := context.Background()
+
+	// ~4.4km east-west route segment
+	route := Route{
+		ID:   "test-route",
+		Name: "Test Route",
+		Polyline: geo.Polyline{
+			Points: []geo.Point{
+				{Latitude: 38.0000, Longitude: -120.0000},
+				{Latitude: 38.0000, Longitude: -120.0500},
+			},
+		},
+		MaxDistance: 16093.4,
+	}
+
+	// Closure digitized ~150m north of the route, parallel over most of it:
+	// past the 100m ON_ROUTE distance but within the 200m overlap distance
+	closure := UnclassifiedAlert{
+		ID:       "test-closure",
+		Location: geo.Point{Latitude: 38.00135, Longitude: -120.0050},
+		Type:     "closure",
+		AffectedPolyline: &geo.Polyline{
+			Points: []geo.Point{
+				{Latitude: 38.00135, Longitude: -120.0050},
+				{Latitude: 38.00135, Longitude: -120.0450},
+			},
+		},
+	}
+
+	classified, err := NewRouteMatcher().ClassifyAlert(ctx, closure, []Route{route})
+	require.NoError(t, err)
+	assert.Greater(t, classified.DistanceToRoute, 100.0, "Nearest point should be past the ON_ROUTE distance")
+	assert.Equal(t, OnRoute, classified.Classification, "Closure running along the route should be ON_ROUTE")
+
+	// Disabling the overlap rule falls back to distance alone
+	classified, err = NewRouteMatcher(WithOnRouteOverlapPercent(100)).ClassifyAlert(ctx, closure, []Route{route})
+	require.NoError(t, err)
+	assert.Equal(t, Nearby, classified.Classification)
+
+	// A point incident at the same offset stays NEARBY
+	incident := UnclassifiedAlert{ID: "test-incident", Location: closure.Location, Type: "incident"}
+	classified, err = NewRouteMatcher().ClassifyAlert(ctx, incident, []Route{route})
+	require.NoError(t, err)
+	assert.Equal(t, Nearby, classified.Classification)
+}
+
+func TestRouteMatcher_ClassifyAlertOrderIndependent(t *testing.T) {
+	matcher := NewRouteMatcher()
+	ctx := context.Background()
+
+	// Alert ~2.2km from route A, beyond A's 1km radius, and ~5.5km from route B
+	routeA := Route{
+		ID: "route-a",
+		Polyline: geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.0000, Longitude: -120.0000},
+			{Latitude: 38.0000, Longitude: -120.0500},
+		}},
+		MaxDistance: 1000,
+	}
+	routeB := Route{
+		ID: "route-b",
+		Polyline: geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.0700, Longitude: -120.0000},
+			{Latitude: 38.0700, Longitude: -120.0500},
+		}},
+		MaxDistance: 16093.4,
+	}
+	alert := UnclassifiedAlert{
+		ID:       "test-order",
+		Location: geo.Point{Latitude: 38.0200, Longitude: -120.0250},
+		Type:     "incident",
+	}
+
+	forward, err := matcher.ClassifyAlert(ctx, alert, []Route{routeA, routeB})
+	require.NoError(t, err)
+	reversed, err := matcher.ClassifyAlert(ctx, alert, []Route{routeB, routeA})
+	require.NoError(t, err)
+
+	for _, classified := range []ClassifiedAlert{forward, reversed} {
+		assert.Equal(t, Nearby, classified.Classification)
+		assert.Equal(t, []string{"route-b"}, classified.RouteIDs)
+		assert.InDelta(t, 5560, classified.DistanceToRoute, 100, "Distance should be to the matching route, not the closer DISTANT one")
+	}
+	assert.Equal(t, forward.DistanceToRoute, reversed.DistanceToRoute)
+
+	// With no matching route the distance is to the closest route
+	classified, err := matcher.ClassifyAlert(ctx, alert, []Route{routeA})
+	require.NoError(t, err)
+	assert.Equal(t, Distant, classified.Classification)
+	assert.InDelta(t, 2220, classified.DistanceToRoute, 100)
+}
+
+func TestRouteMatcher_ClassifyAlerts(t *testing.T) {
+	matcher := NewRouteMatcher()
+	ctx := context.Background()
+
+	routes := []Route{{
+		ID: "test-route",
+		Polyline: geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.0000, Longitude: -120.0000},
+			{Latitude: 38.0000, Longitude: -120.0500},
+		}},
+		MaxDistance: 8046.7,
+	}}
+	alerts := []UnclassifiedAlert{
+		{ID: "on-route", Location: geo.Point{Latitude: 38.0003, Longitude: -120.0200}, Type: "incident"}, // ~35m
+		{ID: "nearby", Location: geo.Point{Latitude: 38.0300, Longitude: -120.0200}, Type: "incident"},   // ~3.3km
+		{ID: "distant", Location: geo.Point{Latitude: 38.2000, Longitude: -120.0200}, Type: "incident"},  // ~22km
+	}
+
+	classified, err := matcher.ClassifyAlerts(ctx, alerts, routes)
+	require.NoError(t, err)
+	require.Len(t, classified, 3)
+
+	// Results come back in input order
+	want := []AlertClassification{OnRoute, Nearby, Distant}
+	for i, alert := range classified {
+		assert.Equal(t, alerts[i].ID, alert.ID)
+		assert.Equal(t, want[i], alert.Classification, alert.ID)
+
+		single, err := matcher.ClassifyAlert(ctx, alerts[i], routes)
+		require.NoError(t, err)
+		assert.Equal(t, single, alert, "Batch result should match single classification")
+	}
+}
@@ -3,6 +3,7 @@ package routing
 import (
 	"context"
 
+	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
 )
 
@@ -24,17 +25,28 @@ type Route struct {
 	Destination geo.Point    `json:"destination"`
 	Polyline    geo.Polyline `json:"polyline"`
 	MaxDistance float64      `json:"max_distance"` // Distance threshold for "nearby" classification (meters)
+
+	// Mile markers at Origin and Destination; equal when unknown
+	StartMileMarker float64 `json:"start_mile_marker,omitempty"`
+	EndMileMarker   float64 `json:"end_mile_marker,omitempty"`
+}
+
+// HasMileMarkers reports whether the route's mile markers are known
+func (r Route) HasMileMarkers() bool {
+	return r.StartMileMarker != r.EndMileMarker
 }
 
 // UnclassifiedAlert represents an alert before route classification
 type UnclassifiedAlert struct {
-	ID               string         `json:"id"`
-	Title            string         `json:"title"`                        // Original Caltrans title (e.g., "CHP Incident 250911GG0206")
-	Location         geo.Point      `json:"location"`
-	Description      string         `json:"description"`
-	Type             string         `json:"type"`
-	StyleUrl         string         `json:"style_url,omitempty"`          // KML style indicating closure type
-	AffectedPolyline *geo.Polyline  `json:"affected_polyline,omitempty"` // For closures/construction
+	ID               string            `json:"id"`
+	Title            string            `json:"title"` // Original Caltrans title (e.g., "CHP Incident 250911GG0206")
+	Location         geo.Point         `json:"location"`
+	Description      string            `json:"description"`
+	Type             string            `json:"type"`
+	StyleUrl         string            `json:"style_url,omitempty"`         // KML style indicating closure type
+	AffectedPolyline *geo.Polyline     `json:"affected_polyline,omitempty"` // For closures/construction
+	SourceFeeds      []string          `json:"source_feeds,omitempty"`      // Feeds a cross-feed duplicate was merged from
+	FeedSeverity     api.AlertSeverity `json:"feed_severity,omitempty"`     // From the feed text's keywords; UNSPECIFIED without a signal
 }
 
 // ClassifiedAlert represents an alert after route classification
@@ -43,6 +55,9 @@ type ClassifiedAlert struct {
 	Classification  AlertClassification `json:"classification"`
 	RouteIDs        []string            `json:"route_ids"`
 	DistanceToRoute float64             `json:"distance_to_route"`
+	// Meters along the route from its origin, for ordering alerts by where
+	// they are on the drive
+	RoutePositionMeters float64 `json:"route_position_meters"`
 }
 
 // RouteMatcher interface defines alert classification against route geometry
@@ -50,8 +65,11 @@ type RouteMatcher interface {
 	// Classify single alert against all routes
 	ClassifyAlert(ctx context.Context, alert UnclassifiedAlert, routes []Route) (ClassifiedAlert, error)
 
-	// Get alerts for specific route
-	GetRouteAlerts(ctx context.Context, routeID string, alerts []ClassifiedAlert) ([]ClassifiedAlert, error)
+	// Classify each alert against all routes, in input order
+	ClassifyAlerts(ctx context.Context, alerts []UnclassifiedAlert, routes []Route) ([]ClassifiedAlert, error)
+
+	// Get alerts for specific route, by priority or (SortByRouteProgress) along it
+	GetRouteAlerts(ctx context.Context, routeID string, alerts []ClassifiedAlert, opts ...RouteAlertsOption) ([]ClassifiedAlert, error)
 
 	// Update route geometry when Google Routes data refreshes
 	UpdateRouteGeometry(ctx context.Context, routeID string, newPolyline geo.Polyline) error
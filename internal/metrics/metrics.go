@@ -0,0 +1,159 @@
+// Package metrics publishes server internals in the Prometheus exposition
+// format: cache occupancy, upstream fetch outcomes, LLM call latency, refresh
+// duration and dropped alert enhancements. Gauges that mirror existing state (cache stats) are read
+// at scrape time; everything else is observed as it happens.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+)
+
+// Pattern mounts the scrape endpoint
+const Pattern = "GET /metrics"
+
+const namespace = "ersn"
+
+// Metrics owns the registry the server publishes. A nil *Metrics is valid and
+// records nothing, so services built without metrics (e.g. in tests) work
+// unchanged.
+type Metrics struct {
+	registry    *prometheus.Registry
+	fetches     *prometheus.CounterVec
+	llmCalls    *prometheus.HistogramVec
+	refreshTime *prometheus.HistogramVec
+	dropped     prometheus.Counter
+}
+
+// New creates Metrics reporting store's occupancy alongside the Go runtime
+// and process collectors. store may be nil.
+func New(store cache.Store) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		fetches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_fetches_total",
+			Help:      "Fetches from upstream data sources by outcome.",
+		}, []string{"source", "result"}),
+		llmCalls: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "openai_request_duration_seconds",
+			Help:      "Latency of LLM completion calls, one observation per attempt.",
+			Buckets:   []float64{0.25, 0.5, 1, 2, 4, 8, 15, 30, 60},
+		}, []string{"result"}),
+		refreshTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "refresh_duration_seconds",
+			Help:      "Time to refresh a data set from its upstream sources.",
+			Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+		}, []string{"data", "result"}),
+		dropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "enhancements_dropped_total",
+			Help:      "Alerts not queued for background enhancement because the queue was full.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		m.fetches,
+		m.llmCalls,
+		m.refreshTime,
+		m.dropped,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	if store != nil {
+		m.registry.MustRegister(newCacheCollector(store))
+	}
+	return m
+}
+
+// Handler serves the registry for scraping
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// RecordFetch counts a fetch from source, failed when err is non-nil
+func (m *Metrics) RecordFetch(source string, err error) {
+	if m == nil {
+		return
+	}
+	m.fetches.WithLabelValues(source, result(err)).Inc()
+}
+
+// ObserveLLMCall records one completion call's latency
+func (m *Metrics) ObserveLLMCall(elapsed time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.llmCalls.WithLabelValues(result(err)).Observe(elapsed.Seconds())
+}
+
+// ObserveRefresh records how long refreshing data (e.g. "roads") took
+func (m *Metrics) ObserveRefresh(data string, elapsed time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.refreshTime.WithLabelValues(data, result(err)).Observe(elapsed.Seconds())
+}
+
+// RecordEnhancementDropped counts an alert dropped from a full enhancement
+// queue
+func (m *Metrics) RecordEnhancementDropped() {
+	if m == nil {
+		return
+	}
+	m.dropped.Inc()
+}
+
+func result(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// cacheCollector reports cache.Store statistics at scrape time
+type cacheCollector struct {
+	store     cache.Store
+	entries   *prometheus.Desc
+	sizeBytes *prometheus.Desc
+	evictions *prometheus.Desc
+	reaped    *prometheus.Desc
+}
+
+func newCacheCollector(store cache.Store) *cacheCollector {
+	return &cacheCollector{
+		store: store,
+		entries: prometheus.NewDesc(namespace+"_cache_entries",
+			"Cache entries by freshness.", []string{"state"}, nil),
+		sizeBytes: prometheus.NewDesc(namespace+"_cache_size_bytes",
+			"Approximate size of cached data.", nil, nil),
+		evictions: prometheus.NewDesc(namespace+"_cache_evictions_total",
+			"Entries evicted to stay under the cache size limit.", nil, nil),
+		reaped: prometheus.NewDesc(namespace+"_cache_reaped_total",
+			"Very stale entries removed by the cache janitor.", nil, nil),
+	}
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.entries
+	ch <- c.sizeBytes
+	ch <- c.evictions
+	ch <- c.reaped
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.store.Stats()
+	ch <- prometheus.MustNewConstMetric(c.entries, prometheus.GaugeValue, float64(stats.FreshEntries), "fresh")
+	ch <- prometheus.MustNewConstMetric(c.entries, prometheus.GaugeValue, float64(stats.StaleEntries), "stale")
+	ch <- prometheus.MustNewConstMetric(c.sizeBytes, prometheus.GaugeValue, float64(stats.SizeBytes))
+	ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(stats.Evictions))
+	ch <- prometheus.MustNewConstMetric(c.reaped, prometheus.CounterValue, float64(stats.Reaped))
+}
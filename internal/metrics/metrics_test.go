@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+func TestMetrics_Scrape(t *testing.T) {
+	c := cache.NewCache()
+	require.NoError(t, c.Set("roads:all", []string{"hwy4"}, time.Hour, "roads"))
+	require.NoError(t, c.Set("weather:all", []string{"murphys"}, -time.Second, "weather"))
+
+	m := New(c)
+	m.RecordFetch("caltrans", nil)
+	m.RecordFetch("openweather", errors.New("503"))
+	m.ObserveLLMCall(1500*time.Millisecond, nil)
+	m.ObserveLLMCall(3*time.Second, errors.New("rate limited"))
+	m.ObserveRefresh("weather", 2*time.Second, nil)
+	m.RecordEnhancementDropped()
+
+	body := scrape(t, m)
+	for _, want := range []string{
+		`ersn_cache_entries{state="fresh"} 1`,
+		`ersn_cache_entries{state="stale"} 1`,
+		`ersn_upstream_fetches_total{result="success",source="caltrans"} 1`,
+		`ersn_upstream_fetches_total{result="failure",source="openweather"} 1`,
+		`ersn_openai_request_duration_seconds_bucket{result="success",le="2"} 1`,
+		`ersn_openai_request_duration_seconds_count{result="failure"} 1`,
+		`ersn_refresh_duration_seconds_sum{data="weather",result="success"} 2`,
+		`ersn_enhancements_dropped_total 1`,
+		`go_goroutines `,
+	} {
+		assert.Contains(t, body, want)
+	}
+}
+
+func TestMetrics_NilRecordsNothing(t *testing.T) {
+	var m *Metrics
+	assert.NotPanics(t, func() {
+		m.RecordFetch("caltrans", nil)
+		m.ObserveLLMCall(time.Second, nil)
+		m.ObserveRefresh("roads", time.Second, nil)
+		m.RecordEnhancementDropped()
+	})
+}
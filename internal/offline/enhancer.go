@@ -0,0 +1,80 @@
+package offline
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+)
+
+// summaryLimit matches the length the LLM enhancer truncates fallback
+// summaries to
+const summaryLimit = 147
+
+// AlertEnhancer is a deterministic stand-in for the LLM alert enhancer: the
+// raw description becomes the details and summary, and the alert reports no
+// road, chain or impact change of its own. Road status still comes from the
+// feeds' closure and chain-control data.
+type AlertEnhancer struct{}
+
+// EnhanceAlert builds an enhanced alert from raw without calling an LLM
+func (AlertEnhancer) EnhanceAlert(_ context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	details := strings.TrimSpace(raw.Description)
+	if details == "" {
+		details = raw.Title
+	}
+	summary := truncate(details)
+
+	return alerts.EnhancedAlert{
+		ID:                  raw.ID,
+		OriginalDescription: raw.Description,
+		StructuredDescription: alerts.StructuredDescription{
+			Details:          details,
+			Location:         alerts.StructuredLocation{Description: raw.Location},
+			Impact:           "unknown",
+			RoadStatus:       "open",
+			ChainStatus:      "none",
+			CondensedSummary: summary,
+		},
+		CondensedSummary: summary,
+		Summaries:        map[string]string{alerts.DefaultSummaryLanguage: summary},
+		ProcessedAt:      time.Now(),
+	}, nil
+}
+
+// HealthCheck always succeeds
+func (AlertEnhancer) HealthCheck(context.Context) error {
+	return nil
+}
+
+// WeatherAlertEnhancer is a deterministic stand-in for the LLM weather alert
+// enhancer: the event name becomes the headline and the NWS text is passed
+// through
+type WeatherAlertEnhancer struct{}
+
+// EnhanceWeatherAlert builds an enhanced weather alert from raw without
+// calling an LLM
+func (WeatherAlertEnhancer) EnhanceWeatherAlert(_ context.Context, raw alerts.RawWeatherAlert) (alerts.EnhancedWeatherAlert, error) {
+	description := strings.TrimSpace(raw.Description)
+	return alerts.EnhancedWeatherAlert{
+		ID:       raw.ID,
+		Headline: raw.Event,
+		Summary:  truncate(description),
+		Details:  description,
+	}, nil
+}
+
+// truncate cuts s to at most summaryLimit bytes, never inside a UTF-8
+// sequence
+func truncate(s string) string {
+	if len(s) <= summaryLimit {
+		return s
+	}
+	cut := summaryLimit
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + "..."
+}
@@ -0,0 +1,199 @@
+// Package offline stands in for the server's upstream APIs so it can run
+// without network access or API keys: Caltrans feeds and OpenWeatherMap are
+// answered from the fixtures in tests/testdata, Google Routes with a
+// straight-line route between the requested endpoints, NWS with no alerts, and
+// alert enhancement with a deterministic stub instead of an LLM.
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// Base URLs the clients already use; HTTPDoer answers requests to these hosts
+const (
+	GoogleRoutesURL = "https://routes.googleapis.com"
+	OpenWeatherURL  = "https://api.openweathermap.org"
+	NWSURL          = "https://api.weather.gov"
+)
+
+// routeSpeed is the average speed (m/s, about 45 mph) synthetic routes are
+// timed at
+const routeSpeed = 20.0
+
+// caltransFeeds maps Caltrans KML feed files to their fixtures. Matching on
+// the file name keeps a configured feed URL working as long as it points at
+// the usual QuickMap file.
+var caltransFeeds = map[string]string{
+	"lcs2way.kml":  "lane_closures.kml",
+	"chp-only.kml": "chp_incidents.kml",
+	"cc.kml":       "chain_controls.kml",
+}
+
+// alertFixtures are OpenWeatherMap One Call alert snapshots for the corridor's
+// weather locations. A request within alertMatchDegrees of one gets its
+// alerts; anywhere else has none.
+var alertFixtures = []struct {
+	file     string
+	lat, lon float64
+}{
+	{"murphys_alerts_20251224.json", 38.1391, -120.4561},
+	{"arnold_alerts_20251224.json", 38.265, -120.3337},
+	{"bearvalley_alerts_20251224.json", 38.461, -120.0424},
+}
+
+const alertMatchDegrees = 0.01
+
+// HTTPDoer answers upstream requests locally. It satisfies the HTTPDoer
+// interface of every client package. Requests it has no fixture for get a 404
+// rather than going to the network.
+type HTTPDoer struct {
+	testDataDir string
+	geoUtils    geo.GeoUtils
+}
+
+// NewHTTPDoer creates an HTTPDoer serving fixtures from testDataDir, the
+// repository's tests/testdata directory
+func NewHTTPDoer(testDataDir string) *HTTPDoer {
+	return &HTTPDoer{testDataDir: testDataDir, geoUtils: geo.NewGeoUtils()}
+}
+
+// Do answers req from local data
+func (d *HTTPDoer) Do(req *http.Request) (*http.Response, error) {
+	switch req.URL.Host {
+	case "quickmap.dot.ca.gov":
+		if fixture, ok := caltransFeeds[path.Base(req.URL.Path)]; ok {
+			return d.file(filepath.Join("caltrans", fixture))
+		}
+	case "roads.dot.ca.gov":
+		// A page without a conditions block: no highway conditions reported
+		return respond(http.StatusOK, "<html><body></body></html>"), nil
+	case "routes.googleapis.com":
+		if req.URL.Path == "/directions/v2:computeRoutes" {
+			return d.computeRoutes(req)
+		}
+	case "api.openweathermap.org":
+		return d.openWeather(req)
+	case "api.weather.gov":
+		if req.URL.Path == "/alerts/active" {
+			return respond(http.StatusOK, `{"type":"FeatureCollection","features":[]}`), nil
+		}
+	}
+	return notFound(req), nil
+}
+
+// computeRoutes returns a straight line between the requested endpoints,
+// timed at routeSpeed with no traffic delay
+func (d *HTTPDoer) computeRoutes(req *http.Request) (*http.Response, error) {
+	type latLng struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+	type waypoint struct {
+		Location struct {
+			LatLng latLng `json:"latLng"`
+		} `json:"location"`
+	}
+	var body struct {
+		Origin      waypoint `json:"origin"`
+		Destination waypoint `json:"destination"`
+	}
+	if req.Body == nil {
+		return respond(http.StatusBadRequest, "missing request body"), nil
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return respond(http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err)), nil
+	}
+
+	origin := geo.Point{Latitude: body.Origin.Location.LatLng.Latitude, Longitude: body.Origin.Location.LatLng.Longitude}
+	destination := geo.Point{Latitude: body.Destination.Location.LatLng.Latitude, Longitude: body.Destination.Location.LatLng.Longitude}
+	meters, err := d.geoUtils.PointToPoint(origin, destination)
+	if err != nil {
+		return respond(http.StatusBadRequest, err.Error()), nil
+	}
+	duration := fmt.Sprintf("%ds", int(math.Round(meters/routeSpeed)))
+
+	route := map[string]any{
+		"duration":       duration,
+		"staticDuration": duration,
+		"distanceMeters": int(math.Round(meters)),
+		"polyline": map[string]string{
+			"encodedPolyline": d.geoUtils.EncodePolyline([]geo.Point{origin, destination}),
+		},
+	}
+	data, err := json.Marshal(map[string]any{"routes": []any{route}})
+	if err != nil {
+		return nil, err
+	}
+	return respond(http.StatusOK, string(data)), nil
+}
+
+// openWeather serves the Murphys fixtures for current weather, forecast and
+// air quality at any location, and alerts by location
+func (d *HTTPDoer) openWeather(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	switch req.URL.Path {
+	case "/data/2.5/weather":
+		switch query.Get("units") {
+		case "metric":
+			return d.file(filepath.Join("weather", "murphys_current.json"))
+		case "imperial":
+			return d.file(filepath.Join("weather", "murphys_current_imperial.json"))
+		}
+	case "/data/2.5/air_pollution":
+		return d.file(filepath.Join("weather", "murphys_air_pollution.json"))
+	case "/data/3.0/onecall":
+		// Forecast requests exclude alerts; alert requests exclude the forecast
+		if strings.Contains(query.Get("exclude"), "alerts") {
+			return d.file(filepath.Join("weather", "murphys_forecast.json"))
+		}
+		lat, latErr := strconv.ParseFloat(query.Get("lat"), 64)
+		lon, lonErr := strconv.ParseFloat(query.Get("lon"), 64)
+		if latErr != nil || lonErr != nil {
+			return respond(http.StatusBadRequest, "invalid coordinates"), nil
+		}
+		for _, fixture := range alertFixtures {
+			if math.Abs(lat-fixture.lat) < alertMatchDegrees && math.Abs(lon-fixture.lon) < alertMatchDegrees {
+				return d.file(filepath.Join("weather", fixture.file))
+			}
+		}
+		return respond(http.StatusOK, fmt.Sprintf(`{"lat":%f,"lon":%f,"timezone":"America/Los_Angeles"}`, lat, lon)), nil
+	}
+	return notFound(req), nil
+}
+
+// file responds with a fixture from the test data directory
+func (d *HTTPDoer) file(name string) (*http.Response, error) {
+	data, err := os.ReadFile(filepath.Join(d.testDataDir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline fixture: %w", err)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(data)),
+	}, nil
+}
+
+func notFound(req *http.Request) *http.Response {
+	return respond(http.StatusNotFound, fmt.Sprintf("no offline fixture for %s %s", req.Method, req.URL.Redacted()))
+}
+
+func respond(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
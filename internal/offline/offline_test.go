@@ -0,0 +1,119 @@
+package offline
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+const testDataDir = "../../tests/testdata"
+
+var (
+	angelsCamp = &api.Coordinates{Latitude: 38.0674, Longitude: -120.5402}
+	murphys    = &api.Coordinates{Latitude: 38.139117, Longitude: -120.456111}
+)
+
+func TestHTTPDoer_GoogleRoutes(t *testing.T) {
+	client := google.NewClientWithHTTPDoer("offline", GoogleRoutesURL, NewHTTPDoer(testDataDir))
+
+	route, err := client.ComputeRoutes(context.Background(), angelsCamp, murphys)
+	require.NoError(t, err)
+	assert.InDelta(t, 10900, route.DistanceMeters, 200, "straight-line distance")
+	assert.Equal(t, route.StaticDurationSeconds, route.DurationSeconds, "no traffic delay")
+	assert.InDelta(t, float64(route.DistanceMeters)/routeSpeed, float64(route.DurationSeconds), 1)
+
+	points, err := geo.NewGeoUtils().DecodePolyline(route.Polyline)
+	require.NoError(t, err)
+	require.Len(t, points, 2)
+	assert.InDelta(t, angelsCamp.Latitude, points[0].Latitude, 1e-5)
+	assert.InDelta(t, murphys.Longitude, points[1].Longitude, 1e-5)
+}
+
+func TestHTTPDoer_Caltrans(t *testing.T) {
+	parser := caltrans.NewFeedParser()
+	parser.HTTPClient = NewHTTPDoer(testDataDir)
+	ctx := context.Background()
+
+	chainControls, err := parser.ParseChainControls(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, chainControls)
+
+	conditions, err := parser.ParseRoadConditions(ctx, "4")
+	require.NoError(t, err)
+	assert.Empty(t, conditions)
+}
+
+func TestHTTPDoer_Weather(t *testing.T) {
+	client := weather.NewClientWithHTTPDoer("offline", OpenWeatherURL, NewHTTPDoer(testDataDir))
+	ctx := context.Background()
+
+	current, err := client.GetCurrentWeather(ctx, murphys)
+	require.NoError(t, err)
+	assert.Equal(t, int32(25), current.TemperatureCelsius)
+
+	imperial, err := client.GetCurrentWeatherInUnits(ctx, murphys, weather.UnitsImperial)
+	require.NoError(t, err)
+	assert.InDelta(t, 77.4, imperial.Temperature, 0.5)
+
+	_, err = client.GetForecast(ctx, murphys, weather.ForecastOptions{})
+	require.NoError(t, err)
+
+	// Alerts come from the snapshot nearest the location, if any
+	murphysAlerts, err := client.GetWeatherAlerts(ctx, murphys)
+	require.NoError(t, err)
+	assert.NotEmpty(t, murphysAlerts)
+
+	elsewhere, err := client.GetWeatherAlerts(ctx, angelsCamp)
+	require.NoError(t, err)
+	assert.Empty(t, elsewhere)
+}
+
+func TestHTTPDoer_NWS(t *testing.T) {
+	client := nws.NewClientWithHTTPDoer("", NWSURL, NewHTTPDoer(testDataDir))
+	zoneAlerts, err := client.GetActiveZoneAlerts(context.Background(), []string{"CAZ064"})
+	require.NoError(t, err)
+	assert.Empty(t, zoneAlerts)
+}
+
+func TestHTTPDoer_UnknownRequestIsNotFound(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/data.json", nil)
+	require.NoError(t, err)
+
+	resp, err := NewHTTPDoer(testDataDir).Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAlertEnhancer(t *testing.T) {
+	raw := alerts.RawAlert{ID: "lc-1", Title: "Route 4 One-way Traffic Operation", Description: "One-way traffic control for paving", Location: "Route 4 near Arnold"}
+
+	first, err := AlertEnhancer{}.EnhanceAlert(context.Background(), raw)
+	require.NoError(t, err)
+	second, err := AlertEnhancer{}.EnhanceAlert(context.Background(), raw)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.StructuredDescription, second.StructuredDescription, "deterministic")
+	assert.Equal(t, "One-way traffic control for paving", first.CondensedSummary)
+	assert.Equal(t, "open", first.StructuredDescription.RoadStatus)
+	assert.Equal(t, "Route 4 near Arnold", first.StructuredDescription.Location.Description)
+
+	// Long summaries are cut on a rune boundary
+	raw.Description = strings.Repeat("a", summaryLimit-1) + "—closed"
+	long, err := AlertEnhancer{}.EnhanceAlert(context.Background(), raw)
+	require.NoError(t, err)
+	assert.True(t, utf8.ValidString(long.CondensedSummary), "summary %q isn't valid UTF-8", long.CondensedSummary)
+	assert.Equal(t, strings.Repeat("a", summaryLimit-1)+"...", long.CondensedSummary)
+}
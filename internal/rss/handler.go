@@ -0,0 +1,203 @@
+// Package rss serves a road's alerts as an RSS 2.0 feed, so riders can follow
+// a corridor from a feed reader instead of polling the JSON API.
+package rss
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// Pattern mounts the feed. It is more specific than the gateway's "/api/",
+// so the gateway keeps serving GET /api/v1/roads/{road_id}.
+const Pattern = "GET /api/v1/roads/{road_id}/feed.xml"
+
+// geoNamespace is the W3C Basic Geo vocabulary used for item coordinates
+const geoNamespace = "http://www.w3.org/2003/01/geo/wgs84_pos#"
+
+// roadsAPI is the slice of the roads service the feed reads. An interface
+// keeps the package testable.
+type roadsAPI interface {
+	GetRoad(context.Context, *api.GetRoadRequest) (*api.GetRoadResponse, error)
+}
+
+// Handler serves GET /api/v1/roads/{road_id}/feed.xml
+type Handler struct {
+	roads roadsAPI
+}
+
+// NewHandler creates a Handler for roads
+func NewHandler(roads roadsAPI) *Handler {
+	return &Handler{roads: roads}
+}
+
+type feed struct {
+	XMLName xml.Name `xml:"rss"`
+	Version string   `xml:"version,attr"`
+	GeoNS   string   `xml:"xmlns:geo,attr"`
+	Channel channel  `xml:"channel"`
+}
+
+type channel struct {
+	Title         string `xml:"title"`
+	Link          string `xml:"link"`
+	Description   string `xml:"description"`
+	LastBuildDate string `xml:"lastBuildDate"`
+	TTL           int    `xml:"ttl"`
+	Items         []item `xml:"item"`
+}
+
+type item struct {
+	Title       string   `xml:"title"`
+	Description string   `xml:"description,omitempty"`
+	GUID        guid     `xml:"guid"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+	Categories  []string `xml:"category"`
+	Lat         string   `xml:"geo:lat,omitempty"`
+	Long        string   `xml:"geo:long,omitempty"`
+}
+
+type guid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	roadID := r.PathValue("road_id")
+
+	resp, err := h.roads.GetRoad(ctx, &api.GetRoadRequest{RoadId: roadID})
+	if err != nil {
+		code := runtime.HTTPStatusFromCode(status.Code(err))
+		if code == http.StatusInternalServerError {
+			logging.Errorw(ctx, "Failed to build road feed", "road_id", roadID, "error", err)
+			code = http.StatusServiceUnavailable
+		}
+		http.Error(w, "road data unavailable", code)
+		return
+	}
+	road := resp.GetRoad()
+
+	out := feed{
+		Version: "2.0",
+		GeoNS:   geoNamespace,
+		Channel: channel{
+			Title:         fmt.Sprintf("%s %s alerts", road.GetName(), road.GetSection()),
+			Link:          roadURL(r, roadID),
+			Description:   fmt.Sprintf("Caltrans and CHP alerts on or near %s, %s", road.GetName(), road.GetSection()),
+			LastBuildDate: time.Now().Format(time.RFC1123Z),
+			TTL:           5, // Minutes readers should wait between polls
+			Items:         roadItems(road),
+		},
+	}
+
+	data, err := xml.MarshalIndent(out, "", "  ")
+	if err != nil {
+		logging.Errorw(ctx, "Failed to encode road feed", "error", err)
+		http.Error(w, "failed to encode feed", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+// roadItems converts a road's ON_ROUTE and NEARBY alerts to feed items,
+// most recent first. Alerts without a timestamp sort last, in the road's
+// order.
+func roadItems(road *api.Road) []item {
+	type dated struct {
+		item
+		at time.Time
+	}
+	var all []dated
+	for _, alert := range road.GetAlerts() {
+		switch alert.GetClassification() {
+		case api.AlertClassification_ON_ROUTE, api.AlertClassification_NEARBY:
+		default:
+			continue
+		}
+
+		at := alertTime(alert)
+		it := item{
+			Title:       alert.GetTitle(),
+			Description: alert.GetDescription(),
+			GUID:        guid{Value: alertGUID(alert)},
+			Categories: categories(
+				alert.GetType().String(),
+				alert.GetSeverity().String(),
+				alert.GetClassification().String(),
+			),
+		}
+		if !at.IsZero() {
+			it.PubDate = at.Format(time.RFC1123Z)
+		}
+		if loc := alert.GetLocation(); loc != nil {
+			it.Lat = strconv.FormatFloat(loc.GetLatitude(), 'f', 6, 64)
+			it.Long = strconv.FormatFloat(loc.GetLongitude(), 'f', 6, 64)
+		}
+		all = append(all, dated{item: it, at: at})
+	}
+
+	sort.SliceStable(all, func(i, j int) bool { return all[i].at.After(all[j].at) })
+	items := make([]item, len(all))
+	for i, d := range all {
+		items[i] = d.item
+	}
+	return items
+}
+
+// alertTime is when an alert last changed: its last update, else when it
+// was reported or started. Zero when the feed and enhancement gave no time.
+func alertTime(alert *api.RoadAlert) time.Time {
+	for _, ts := range []interface{ AsTime() time.Time }{alert.GetLastUpdated(), alert.GetTimeReported(), alert.GetStartTime()} {
+		if t := ts.AsTime(); t.Unix() > 0 {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// alertGUID is stable across refreshes so readers don't repeat items: the
+// alert's log number, or a hash of its title and location
+func alertGUID(alert *api.RoadAlert) string {
+	if id := alert.GetId(); id != "" {
+		return "info.ersn.net:alert:" + id
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%.4f|%.4f", alert.GetTitle(), alert.GetLocation().GetLatitude(), alert.GetLocation().GetLongitude())
+	return fmt.Sprintf("info.ersn.net:alert:%x", h.Sum64())
+}
+
+// roadURL is the road's JSON endpoint on the host that served the request
+func roadURL(r *http.Request, roadID string) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1/roads/%s", scheme, r.Host, roadID)
+}
+
+// categories lower-cases enum names for <category>, skipping UNSPECIFIED
+func categories(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if !strings.HasSuffix(v, "_UNSPECIFIED") {
+			out = append(out, strings.ToLower(v))
+		}
+	}
+	return out
+}
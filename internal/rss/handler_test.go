@@ -0,0 +1,101 @@
+package rss
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+type fakeRoads struct {
+	road *api.Road
+}
+
+func (f fakeRoads) GetRoad(_ context.Context, req *api.GetRoadRequest) (*api.GetRoadResponse, error) {
+	if f.road == nil || f.road.GetId() != req.GetRoadId() {
+		return nil, status.Errorf(codes.NotFound, "road not found: %s", req.GetRoadId())
+	}
+	return &api.GetRoadResponse{Road: f.road}, nil
+}
+
+// parsedFeed is what a feed reader sees
+type parsedFeed struct {
+	Version string `xml:"version,attr"`
+	Channel struct {
+		Title string `xml:"title"`
+		Link  string `xml:"link"`
+		Items []struct {
+			Title      string   `xml:"title"`
+			GUID       string   `xml:"guid"`
+			PubDate    string   `xml:"pubDate"`
+			Categories []string `xml:"category"`
+			Lat        string   `xml:"http://www.w3.org/2003/01/geo/wgs84_pos# lat"`
+			Long       string   `xml:"http://www.w3.org/2003/01/geo/wgs84_pos# long"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func TestServeHTTP_Feed(t *testing.T) {
+	base := time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC)
+	road := &api.Road{
+		Id: "hwy4", Name: "Hwy 4", Section: "Angels Camp to Murphys",
+		Alerts: []*api.RoadAlert{
+			{Id: "A", Title: "Oldest", Classification: api.AlertClassification_ON_ROUTE, Type: api.AlertType_INCIDENT,
+				TimeReported: timestamppb.New(base), Location: &api.Coordinates{Latitude: 38.1, Longitude: -120.5}},
+			{Title: "Undated", Classification: api.AlertClassification_NEARBY, Type: api.AlertType_CONSTRUCTION},
+			{Id: "B", Title: "Newest", Classification: api.AlertClassification_NEARBY, Severity: api.AlertSeverity_WARNING,
+				TimeReported: timestamppb.New(base), LastUpdated: timestamppb.New(base.Add(2 * time.Hour))},
+			{Id: "C", Title: "Middle", Classification: api.AlertClassification_ON_ROUTE,
+				StartTime: timestamppb.New(base.Add(time.Hour))},
+			{Id: "D", Title: "Far away", Classification: api.AlertClassification_DISTANT, TimeReported: timestamppb.New(base.Add(3 * time.Hour))},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Pattern, NewHandler(fakeRoads{road: road}))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://info.ersn.net/api/v1/roads/hwy4/feed.xml", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+
+	var feed parsedFeed
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &feed), "feed must be well-formed XML")
+	assert.Equal(t, "2.0", feed.Version)
+	assert.Equal(t, "Hwy 4 Angels Camp to Murphys alerts", feed.Channel.Title)
+	assert.Equal(t, "http://info.ersn.net/api/v1/roads/hwy4", feed.Channel.Link)
+
+	// One item per ON_ROUTE/NEARBY alert, most recent first
+	var titles []string
+	for _, it := range feed.Channel.Items {
+		titles = append(titles, it.Title)
+	}
+	assert.Equal(t, []string{"Newest", "Middle", "Oldest", "Undated"}, titles)
+
+	newest := feed.Channel.Items[0]
+	assert.Equal(t, base.Add(2*time.Hour).Format(time.RFC1123Z), newest.PubDate)
+	assert.Equal(t, "info.ersn.net:alert:B", newest.GUID)
+	assert.Equal(t, []string{"warning", "nearby"}, newest.Categories)
+
+	oldest := feed.Channel.Items[2]
+	assert.Equal(t, "38.100000", oldest.Lat)
+	assert.Equal(t, "-120.500000", oldest.Long)
+	assert.Empty(t, feed.Channel.Items[3].PubDate)
+}
+
+func TestServeHTTP_UnknownRoad(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(Pattern, NewHandler(fakeRoads{}))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/roads/nope/feed.xml", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// additionalFeed is a configured extra Caltrans KML feed
+type additionalFeed struct {
+	feedType  caltrans.CaltransFeedType
+	url       string
+	alertType string        // "closure", "incident", "construction" or "weather"
+	timeout   time.Duration // Zero uses caltrans.DefaultFeedTimeout
+}
+
+// newAdditionalFeeds registers a feed type for each configured extra feed
+func newAdditionalFeeds(feeds []config.AdditionalCaltransFeed) []additionalFeed {
+	var result []additionalFeed
+	for _, feed := range feeds {
+		alertType := feed.AlertType
+		if alertType == "" {
+			alertType = "incident"
+		}
+		result = append(result, additionalFeed{
+			feedType:  caltrans.RegisterFeedType(feed.Name),
+			url:       feed.URL,
+			alertType: alertType,
+			timeout:   feed.Timeout,
+		})
+	}
+	return result
+}
+
+// parseAdditionalFeeds fetches the extra feeds. A failing feed is logged and
+// skipped so it can't hold up the built-in ones; the incidents of the feeds
+// that worked are returned along with the failures.
+func (s *RoadsService) parseAdditionalFeeds(ctx context.Context) ([]caltrans.CaltransIncident, error) {
+	var incidents []caltrans.CaltransIncident
+	var errs []error
+	for _, feed := range s.extraFeeds {
+		parsed, err := s.parseAdditionalFeed(ctx, feed)
+		if err != nil {
+			logging.Errorw(ctx, "Failed to parse additional Caltrans feed", "feed", feed.feedType, "url", feed.url, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", feed.feedType, err))
+			continue
+		}
+		incidents = append(incidents, parsed...)
+	}
+	return incidents, errors.Join(errs...)
+}
+
+// parseAdditionalFeed fetches one extra feed within its configured timeout
+func (s *RoadsService) parseAdditionalFeed(ctx context.Context, feed additionalFeed) ([]caltrans.CaltransIncident, error) {
+	timeout := feed.timeout
+	if timeout <= 0 {
+		timeout = caltrans.DefaultFeedTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return s.caltransClient.ParseFeed(ctx, feed.url, feed.feedType)
+}
+
+// additionalFeedAlertType returns the configured alert type for an extra feed
+func (s *RoadsService) additionalFeedAlertType(feedType caltrans.CaltransFeedType) (string, bool) {
+	for _, feed := range s.extraFeeds {
+		if feed.feedType == feedType {
+			return feed.alertType, true
+		}
+	}
+	return "", false
+}
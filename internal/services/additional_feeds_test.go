@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// kmlFeeds serves a fixed KML body per URL
+type kmlFeeds map[string]string
+
+func (f kmlFeeds) Do(req *http.Request) (*http.Response, error) {
+	body, ok := f[req.URL.String()]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("Not found"))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+const roadworkKML = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2"><Document>
+<Placemark>
+  <name>Roadwork SR-4</name>
+  <description>Paving operations, 1 LANE CLOSED</description>
+  <Point><coordinates>-120.3510,38.2550,0</coordinates></Point>
+</Placemark>
+</Document></kml>`
+
+func TestAdditionalFeeds(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.CaltransFeeds.Additional = []config.AdditionalCaltransFeed{
+		{Name: "test_svc_roadwork", URL: "https://example.com/roadwork.kml", AlertType: "construction"},
+		{Name: "test_svc_cms", URL: "https://example.com/cms.kml"},
+	}
+	parser := &caltrans.FeedParser{HTTPClient: kmlFeeds{"https://example.com/roadwork.kml": roadworkKML}}
+	s := NewRoadsService(nil, parser, cache.NewCache(), cfg, nil, nil)
+
+	// The CMS feed 404s and is skipped, and its failure reported
+	incidents, err := s.parseAdditionalFeeds(ctx)
+	if err == nil || !strings.Contains(err.Error(), "test_svc_cms") {
+		t.Errorf("error = %v, want the CMS feed's failure", err)
+	}
+	if len(incidents) != 1 {
+		t.Fatalf("got %d incidents, want 1", len(incidents))
+	}
+	if got := incidents[0].FeedType; got != caltrans.RegisterFeedType("test_svc_roadwork") {
+		t.Errorf("feed type = %v, want test_svc_roadwork", got)
+	}
+
+	alert := s.incidentToUnclassifiedAlert(incidents[0])
+	if alert.Type != "construction" {
+		t.Errorf("alert type = %q, want construction", alert.Type)
+	}
+	if got := s.mapCaltransTypeToString(caltrans.RegisterFeedType("test_svc_cms")); got != "incident" {
+		t.Errorf("default alert type = %q, want incident", got)
+	}
+}
+
+func TestAdditionalFeeds_FailureKeepsEnhancements(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(2)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.CaltransFeeds.Additional = []config.AdditionalCaltransFeed{
+		{Name: "test_svc_failing", URL: "https://example.com/failing.kml"},
+	}
+	s := NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+
+	// The extra feed 404s on every refresh, so none of its incidents can be
+	// told apart from resolved ones: no feed is complete
+	for i := 0; i < 2; i++ {
+		if _, err := s.refreshRoadData(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s.sightings.latest != nil {
+		t.Errorf("feed completed with a failed extra feed: %v", s.sightings.latest)
+	}
+	if got := s.countCachedEnhancements(); got != int64(len(roads)) {
+		t.Errorf("%d cached enhancements, want all %d kept", got, len(roads))
+	}
+}
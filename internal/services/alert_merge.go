@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// eventKeyHasher keys incidents for cross-feed merging. Three decimals (~110m)
+// absorbs the small offsets between the lane-closure and CHP placements of
+// one event without merging neighbouring incidents.
+var eventKeyHasher = alerts.NewContentHasher(alerts.WithLocationPrecision(3))
+
+// eventCellDegrees is the size of eventKeyHasher's grid cells
+const eventCellDegrees = 0.001
+
+// eventMergeMeters is how far apart two feeds may place one event. Reports
+// either side of a cell boundary key differently, so neighbouring cells are
+// searched too and the distance decides.
+const eventMergeMeters = 110
+
+// eventGeo measures the distance between reports being merged
+var eventGeo = geo.NewGeoUtils()
+
+// sourceFeedsMetadataKey lists the feeds a merged alert was reported in
+const sourceFeedsMetadataKey = "source_feeds"
+
+// feedIncident is an incident with the alert it converts to
+type feedIncident struct {
+	incident caltrans.CaltransIncident
+	alert    routing.UnclassifiedAlert
+	feeds    map[caltrans.CaltransFeedType]bool
+}
+
+// mergeCrossFeedDuplicates converts incidents to alerts, merging reports of
+// one event from different feeds (a lane closure and the CHP incident behind
+// it) into a single alert. Reports merge when they name the same highways and
+// lie within eventMergeMeters of each other. Of each merged group the more
+// severe report is kept, then the more detailed one, with the feeds it was
+// seen in recorded in SourceFeeds. Reports from the same feed are never merged.
+func (s *RoadsService) mergeCrossFeedDuplicates(ctx context.Context, incidents []caltrans.CaltransIncident) []routing.UnclassifiedAlert {
+	var kept []*feedIncident
+	byKey := make(map[string][]*feedIncident)
+	merged := 0
+	for _, incident := range incidents {
+		fi := &feedIncident{
+			incident: incident,
+			alert:    s.incidentToUnclassifiedAlert(incident),
+			feeds:    map[caltrans.CaltransFeedType]bool{incident.FeedType: true},
+		}
+		key := eventKey(incident, 0, 0)
+		if key != "" {
+			if match := nearbyFromOtherFeed(byKey, incident); match != nil {
+				match.absorb(fi)
+				merged++
+				continue
+			}
+			byKey[key] = append(byKey[key], fi)
+		}
+		kept = append(kept, fi)
+	}
+	if merged > 0 {
+		logging.Infow(ctx, "Merged incidents reported in more than one feed", "merged", merged)
+	}
+
+	unclassifiedAlerts := make([]routing.UnclassifiedAlert, len(kept))
+	for i, fi := range kept {
+		if len(fi.feeds) > 1 {
+			fi.alert.SourceFeeds = s.feedNames(fi.feeds)
+		}
+		unclassifiedAlerts[i] = fi.alert
+	}
+	return unclassifiedAlerts
+}
+
+// eventKey is the incident's cross-feed key with its coordinates moved
+// latCells and lonCells grid cells, empty without coordinates
+func eventKey(incident caltrans.CaltransIncident, latCells, lonCells int) string {
+	if incident.Coordinates == nil {
+		return ""
+	}
+	lat := incident.Coordinates.Latitude + float64(latCells)*eventCellDegrees
+	lon := incident.Coordinates.Longitude + float64(lonCells)*eventCellDegrees
+	return eventKeyHasher.EventKey(alerts.RawAlert{
+		Title:       incident.Name,
+		Description: incident.DescriptionText,
+		Location:    fmt.Sprintf("%.5f, %.5f", lat, lon),
+	})
+}
+
+// nearbyFromOtherFeed returns the first report of the incident's event not
+// yet holding a report from its feed, searching its own grid cell and then
+// the eight around it
+func nearbyFromOtherFeed(byKey map[string][]*feedIncident, incident caltrans.CaltransIncident) *feedIncident {
+	for _, cell := range [][2]int{{0, 0}, {-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}} {
+		for _, candidate := range byKey[eventKey(incident, cell[0], cell[1])] {
+			if candidate.feeds[incident.FeedType] {
+				continue
+			}
+			c := candidate.incident.Coordinates
+			distance, err := eventGeo.DistanceFromCoords(incident.Coordinates.Latitude, incident.Coordinates.Longitude, c.Latitude, c.Longitude)
+			if err == nil && distance <= eventMergeMeters {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
+
+// absorb merges other into fi, taking other's incident and alert when it is
+// the better report
+func (fi *feedIncident) absorb(other *feedIncident) {
+	fi.feeds[other.incident.FeedType] = true
+	if betterReport(other.incident, fi.incident) {
+		fi.incident = other.incident
+		fi.alert = other.alert
+	}
+}
+
+// betterReport reports whether a is more severe than b, or as severe and more
+// detailed
+func betterReport(a, b caltrans.CaltransIncident) bool {
+	sa := incidentSeverity(a, parseIncidentDetail(a).title)
+	sb := incidentSeverity(b, parseIncidentDetail(b).title)
+	if sa != sb {
+		return sa > sb
+	}
+	return len(a.DescriptionText) > len(b.DescriptionText)
+}
+
+// feedNames names feeds by their alert type ("closure", "incident"), sorted
+func (s *RoadsService) feedNames(feeds map[caltrans.CaltransFeedType]bool) []string {
+	names := make([]string, 0, len(feeds))
+	for feedType := range feeds {
+		names = append(names, s.mapCaltransTypeToString(feedType))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// prefilterFixture returns routes of 200 points each spread across the Sierra
+// foothills, and incidents scattered across a wider area, a quarter of them
+// lane closures with a short affected polyline
+func prefilterFixture(numRoutes, numIncidents int) ([]routing.Route, []caltrans.CaltransIncident) {
+	rng := rand.New(rand.NewSource(42))
+
+	routes := make([]routing.Route, numRoutes)
+	for i := range routes {
+		start := geo.Point{Latitude: 37.8 + rng.Float64()*0.8, Longitude: -120.8 + rng.Float64()*0.8}
+		points := make([]geo.Point, 200)
+		for j := range points {
+			points[j] = geo.Point{Latitude: start.Latitude + float64(j)*0.001, Longitude: start.Longitude + float64(j)*0.0012}
+		}
+		routes[i] = routing.Route{
+			ID:          fmt.Sprintf("route-%d", i),
+			Origin:      points[0],
+			Destination: points[len(points)-1],
+			Polyline:    geo.Polyline{Points: points},
+			MaxDistance: config.DefaultMaxDistanceMeters,
+		}
+	}
+
+	incidents := make([]caltrans.CaltransIncident, numIncidents)
+	for i := range incidents {
+		location := &api.Coordinates{Latitude: 37.5 + rng.Float64()*1.5, Longitude: -121.2 + rng.Float64()*1.5}
+		incidents[i] = caltrans.CaltransIncident{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            fmt.Sprintf("CHP Incident 251016ST%04d", i),
+			DescriptionText: "Traffic collision",
+			Coordinates:     location,
+		}
+		if i%4 == 0 {
+			incidents[i].FeedType = caltrans.LANE_CLOSURE
+			incidents[i].Name = fmt.Sprintf("Lane Closure %d", i)
+			incidents[i].AffectedArea = &api.Polyline{Points: []*api.Coordinates{
+				location,
+				{Latitude: location.Latitude + 0.02, Longitude: location.Longitude - 0.03},
+			}}
+		}
+	}
+	return routes, incidents
+}
+
+// TestProcessGlobalAlerts_PrefilterMatchesFullClassification verifies the
+// bounding-box prefilter only skips alert/route pairs full classification
+// would have dropped as DISTANT.
+func TestProcessGlobalAlerts_PrefilterMatchesFullClassification(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher()}
+	routes, incidents := prefilterFixture(6, 400)
+
+	got, err := s.processGlobalAlerts(ctx, incidents, routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reference: classify every pair against the full polyline
+	var all []globalAlertClassification
+	for _, incident := range incidents {
+		alert := s.incidentToUnclassifiedAlert(incident)
+		for _, route := range routes {
+			classified, err := s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{route})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if classified.Classification != routing.Distant {
+				all = append(all, globalAlertClassification{AlertID: alert.ID, RouteID: route.ID, ClassifiedAlert: classified})
+			}
+		}
+	}
+	want := s.deduplicateAlerts(ctx, all)
+
+	if len(want) == 0 {
+		t.Fatal("fixture produced no relevant alerts")
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("prefiltered classifications differ from full classification:\ngot  %d routes\nwant %d routes", len(got), len(want))
+	}
+}
+
+// TestClassifyAlertsForRoute_Prefilter verifies statewide incidents far from
+// a route are skipped before classification while ones inside the radius,
+// including NEARBY ones at the edge, still classify.
+func TestClassifyAlertsForRoute_Prefilter(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	route := routing.Route{
+		ID: "hwy4",
+		Polyline: geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.25, Longitude: -120.35},
+			{Latitude: 38.47, Longitude: -120.04},
+		}},
+		MaxDistance: config.DefaultMaxDistanceMeters,
+	}
+	alert := func(id string, lat, lon float64) routing.UnclassifiedAlert {
+		return routing.UnclassifiedAlert{ID: id, Title: id, Location: geo.Point{Latitude: lat, Longitude: lon}, Type: "incident"}
+	}
+	alerts := []routing.UnclassifiedAlert{
+		alert("on-route", 38.25, -120.35),
+		alert("nearby", 38.25, -120.40),      // ~4.4km west of the origin
+		alert("los-angeles", 34.05, -118.24), // Statewide feed noise
+		alert("redding", 40.59, -122.39),
+	}
+
+	for _, tt := range []struct {
+		name   string
+		radius float64
+		want   []string
+	}{
+		{"default radius", 0, []string{"on-route", "nearby"}},
+		{"below nearby radius is clamped", 100, []string{"on-route", "nearby"}},
+		{"wide radius", 300000, []string{"on-route", "nearby", "redding"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.Roads.PrefilterRadiusMeters = tt.radius
+			s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), config: cfg}
+
+			var got []string
+			for _, classified := range s.classifyAlertsForRoute(ctx, route, alerts) {
+				got = append(got, classified.ID)
+				if classified.ID == "nearby" && classified.Classification != routing.Nearby {
+					t.Errorf("nearby alert classified %s, want NEARBY", classified.Classification)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("classified %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkProcessGlobalAlerts(b *testing.B) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher()}
+	routes, incidents := prefilterFixture(8, 500)
+
+	for b.Loop() {
+		if _, err := s.processGlobalAlerts(ctx, incidents, routes); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
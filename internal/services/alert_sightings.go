@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// alertSeenKeyPrefix prefixes alert ids in the cache entries recording when
+// each alert was first and last seen
+const alertSeenKeyPrefix = "alert_seen:"
+
+// alertSeenRetention is how long an alert's sighting is kept after it was
+// last seen. An alert that drops out of a refresh or two keeps its first
+// sighting; one gone longer counts as new when it returns.
+const alertSeenRetention = time.Hour
+
+// alertSighting is when an alert was first and last seen in the feeds
+type alertSighting struct {
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// recordAlertSightings sets each alert's first_seen and last_seen, with now
+// as the latest sighting. Sightings are cached by alert id so first_seen
+// survives restarts with Redis or a persisted cache, and an alert on several
+// roads gets the same times on each.
+func (s *RoadsService) recordAlertSightings(ctx context.Context, roads []*api.Road, now time.Time) {
+	sightings := make(map[string]alertSighting)
+	for _, road := range roads {
+		for _, alert := range road.Alerts {
+			if alert.Id == "" {
+				continue
+			}
+			sighting, ok := sightings[alert.Id]
+			if !ok {
+				sighting = s.recordAlertSighting(ctx, alert.Id, now)
+				sightings[alert.Id] = sighting
+			}
+			alert.FirstSeen = timestamppb.New(sighting.FirstSeen)
+			alert.LastSeen = timestamppb.New(sighting.LastSeen)
+		}
+	}
+}
+
+// recordAlertSighting records that alert id was seen at now and returns its
+// sighting
+func (s *RoadsService) recordAlertSighting(ctx context.Context, id string, now time.Time) alertSighting {
+	key := alertSeenKeyPrefix + id
+	sighting := alertSighting{FirstSeen: now, LastSeen: now}
+
+	var previous alertSighting
+	if _, found, err := s.cache.GetWithMetadata(key, &previous); err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", key)
+	} else if found && !previous.FirstSeen.IsZero() && previous.FirstSeen.Before(now) {
+		sighting.FirstSeen = previous.FirstSeen
+	}
+
+	if err := s.cache.Set(key, sighting, alertSeenRetention, "roads"); err != nil {
+		logging.Errorw(ctx, "Failed to cache alert sighting", "error", err, "alert_id", id)
+	}
+	return sighting
+}
+
+// withoutLastSeen returns road with its alerts' last_seen cleared, copying it
+// only when there is something to clear. last_seen advances every refresh,
+// so on its own it isn't a change worth streaming.
+func withoutLastSeen(road *api.Road) *api.Road {
+	for _, alert := range road.Alerts {
+		if alert.LastSeen == nil {
+			continue
+		}
+		road = proto.Clone(road).(*api.Road)
+		for _, alert := range road.Alerts {
+			alert.LastSeen = nil
+		}
+		return road
+	}
+	return road
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+)
+
+func TestRecordAlertSightings(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{cache: cache.NewCache()}
+	refresh := func() []*api.Road {
+		return []*api.Road{
+			{Id: "hwy4-angels-murphys", Alerts: []*api.RoadAlert{{Id: "251016ST0001"}}},
+			{Id: "hwy4-murphys-arnold", Alerts: []*api.RoadAlert{{Id: "251016ST0001"}, {}}},
+		}
+	}
+
+	start := time.Date(2025, 10, 16, 9, 0, 0, 0, time.UTC)
+	first := refresh()
+	s.recordAlertSightings(ctx, first, start)
+	if a := first[0].Alerts[0]; !a.FirstSeen.AsTime().Equal(start) || !a.LastSeen.AsTime().Equal(start) {
+		t.Errorf("new alert seen %v to %v, want both %v", a.FirstSeen.AsTime(), a.LastSeen.AsTime(), start)
+	}
+	if first[1].Alerts[1].FirstSeen != nil {
+		t.Error("alert without an id has a first_seen")
+	}
+
+	// Seen again three hours later: first_seen stays, last_seen advances
+	later := start.Add(3 * time.Hour)
+	second := refresh()
+	s.recordAlertSightings(ctx, second, later)
+	for _, road := range second {
+		a := road.Alerts[0]
+		if !a.FirstSeen.AsTime().Equal(start) || !a.LastSeen.AsTime().Equal(later) {
+			t.Errorf("%s: alert seen %v to %v, want %v to %v", road.Id, a.FirstSeen.AsTime(), a.LastSeen.AsTime(), start, later)
+		}
+	}
+
+	// last_seen advancing alone isn't a change for StreamRoadUpdates
+	if hashRoads(first) != hashRoads(second) {
+		t.Error("road hash changed when only last_seen did")
+	}
+	if second[0].Alerts[0].LastSeen == nil {
+		t.Error("hashRoads cleared last_seen on the roads themselves")
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// refreshAndCache refreshes and caches the road set. Concurrent callers share
+// a single refresh and its result.
+func (s *RoadsService) refreshAndCache(ctx context.Context) ([]*api.Road, error) {
+	result, err, _ := s.refreshes.Do("roads:all", func() (any, error) {
+		// Shared by every caller, so one caller going away mustn't cancel it
+		ctx := context.WithoutCancel(ctx)
+		cfg := s.cfg()
+		roads, err := s.refreshRoadData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s.cfg() != cfg {
+			// Don't overwrite the reloaded road set with the previous one
+			logging.Info(ctx, "Configuration reloaded during refresh - not caching roads")
+		} else if err := s.cacheRoads(ctx, roads); err != nil {
+			logging.Errorw(ctx, "Failed to cache roads", "error", err)
+		}
+		return roads, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*api.Road), nil
+}
+
+// refreshInBackground starts a refresh without waiting for it, so stale reads
+// heal even if the periodic refresh has stopped. A refresh already in flight
+// is joined rather than repeated.
+func (s *RoadsService) refreshInBackground(ctx context.Context) {
+	// Outlive the request that triggered the refresh
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		defer func() {
+			// Recover from any panics in the background refresh
+			if r := recover(); r != nil {
+				err, _ := errors.ParseStack(debug.Stack())
+				skipFrames := 3
+				numFrames := 5
+				logging.Errorw(ctx, "Background road refresh: recovered from panic",
+					"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+			}
+		}()
+
+		logging.Info(ctx, "Serving stale roads - refreshing in background")
+		if _, err := s.refreshAndCache(ctx); err != nil {
+			logging.Errorw(ctx, "Background road refresh failed", "error", err)
+		}
+	}()
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
+)
+
+// countingFeeds serves feeds slowly and counts CHP feed fetches, one per refresh
+type countingFeeds struct {
+	kmlFeeds
+	refreshes atomic.Int32
+}
+
+func (c *countingFeeds) Do(req *http.Request) (*http.Response, error) {
+	if req.URL.String() == caltrans.DefaultCHPIncidentsURL {
+		c.refreshes.Add(1)
+		time.Sleep(50 * time.Millisecond)
+	}
+	return c.kmlFeeds.Do(req)
+}
+
+// newRefreshTestService returns a service with two roads whose refreshes are
+// counted by the returned feeds
+func newRefreshTestService() (*RoadsService, *countingFeeds) {
+	roads, parser := concurrencyFixture(2)
+	feeds := &countingFeeds{kmlFeeds: parser.HTTPClient.(kmlFeeds)}
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.RefreshInterval = time.Hour
+	s := NewRoadsService(nil, &caltrans.FeedParser{HTTPClient: feeds}, cache.NewCache(), cfg, nil, nil)
+	return s, feeds
+}
+
+func TestListRoads_StaleReadRefreshesInBackground(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s, feeds := newRefreshTestService()
+
+	// Stale (past its 200ms TTL) but not very stale (under 400ms old)
+	stale := []*api.Road{{Id: "stale"}}
+	if err := s.cache.Set("roads:all", stale, 200*time.Millisecond, "roads"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(resp.Roads) != 1 || resp.Roads[0].Id != "stale" {
+				t.Errorf("got %v, want the stale roads served immediately", resp.Roads)
+			}
+		}()
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for s.cache.IsStale("roads:all") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s.cache.IsStale("roads:all") {
+		t.Fatal("background refresh never cached fresh roads")
+	}
+	if got := feeds.refreshes.Load(); got != 1 {
+		t.Errorf("stale reads triggered %d refreshes, want 1", got)
+	}
+
+	// Fresh reads don't refresh
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Roads) != 2 {
+		t.Errorf("got %d roads after refresh, want 2", len(resp.Roads))
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := feeds.refreshes.Load(); got != 1 {
+		t.Errorf("fresh read triggered a refresh (%d total)", got)
+	}
+}
+
+func TestListRoads_ColdStartSharesOneRefresh(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s, feeds := newRefreshTestService()
+
+	const callers = 10
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(resp.Roads) != 2 {
+				t.Errorf("got %d roads, want 2", len(resp.Roads))
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if got := feeds.refreshes.Load(); got != 1 {
+		t.Errorf("%d concurrent cold-start requests ran %d refreshes, want 1", callers, got)
+	}
+}
+
+func TestRefreshAndCache_ReportsMetrics(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(2)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.RefreshInterval = time.Hour
+	c := cache.NewCache()
+	m := metrics.New(c)
+	s := NewRoadsService(nil, parser, c, cfg, nil, NewSourceHealth(m))
+	s.ReportMetrics(m)
+
+	if _, err := s.refreshAndCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("scrape status = %d, want 200", w.Code)
+	}
+	body := w.Body.String()
+	for _, want := range []string{
+		`ersn_cache_entries{state="fresh"} `,
+		`ersn_cache_entries{state="stale"} 0`,
+		`ersn_cache_evictions_total 0`,
+		`ersn_cache_size_bytes `,
+		`ersn_upstream_fetches_total{result="success",source="caltrans"} 1`,
+		// No Google client: one failed fetch per road
+		`ersn_upstream_fetches_total{result="failure",source="google_routes"} 2`,
+		`ersn_refresh_duration_seconds_count{data="roads",result="success"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("scrape missing %q", want)
+		}
+	}
+}
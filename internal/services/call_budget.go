@@ -0,0 +1,57 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// callBudgetWindow is the rolling window a callBudget limit applies to
+const callBudgetWindow = 24 * time.Hour
+
+// callBudget caps calls to a metered API over a rolling 24 hours. A zero
+// limit is unlimited (calls are still counted). Safe for concurrent use.
+type callBudget struct {
+	limit int
+
+	mutex sync.Mutex
+	calls []time.Time // Call times within the window, oldest first
+}
+
+// reserve counts a call at now, or reports false if the budget is spent
+func (b *callBudget) reserve(now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.pruneLocked(now)
+	if b.limit > 0 && len(b.calls) >= b.limit {
+		return false
+	}
+	b.calls = append(b.calls, now)
+	return true
+}
+
+// used returns the calls made in the window ending at now
+func (b *callBudget) used(now time.Time) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.pruneLocked(now)
+	return len(b.calls)
+}
+
+// remaining returns the calls left in the window ending at now, or -1 when
+// unlimited
+func (b *callBudget) remaining(now time.Time) int {
+	if b.limit <= 0 {
+		return -1
+	}
+	return max(b.limit-b.used(now), 0)
+}
+
+// pruneLocked drops calls older than the window. Caller holds the lock.
+func (b *callBudget) pruneLocked(now time.Time) {
+	cutoff := now.Add(-callBudgetWindow)
+	i := 0
+	for i < len(b.calls) && !b.calls[i].After(cutoff) {
+		i++
+	}
+	b.calls = b.calls[i:]
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCallBudget(t *testing.T) {
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	b := &callBudget{limit: 2}
+
+	if !b.reserve(start) || !b.reserve(start.Add(time.Hour)) {
+		t.Fatal("calls within the budget were refused")
+	}
+	if b.reserve(start.Add(2 * time.Hour)) {
+		t.Error("call past the budget was allowed")
+	}
+	if got := b.remaining(start.Add(2 * time.Hour)); got != 0 {
+		t.Errorf("remaining = %d, want 0", got)
+	}
+
+	// The first call leaves the window 24h later
+	later := start.Add(24*time.Hour + time.Minute)
+	if got := b.used(later); got != 1 {
+		t.Errorf("used = %d, want 1 after the first call aged out", got)
+	}
+	if !b.reserve(later) {
+		t.Error("call refused after budget freed up")
+	}
+
+	unlimited := &callBudget{}
+	for i := 0; i < 1000; i++ {
+		if !unlimited.reserve(start) {
+			t.Fatal("unlimited budget refused a call")
+		}
+	}
+	if got := unlimited.remaining(start); got != -1 {
+		t.Errorf("unlimited remaining = %d, want -1", got)
+	}
+	if got := unlimited.used(start); got != 1000 {
+		t.Errorf("unlimited used = %d, want 1000", got)
+	}
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops calling an upstream that keeps failing. After
+// threshold consecutive failures it opens for cooldown and rejects calls.
+// Once the cooldown has passed it half-opens: one probe call goes through,
+// and its success closes the breaker while its failure reopens it for
+// another cooldown. A threshold <= 0 never opens. Safe for concurrent use.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mutex     sync.Mutex
+	failures  int       // Consecutive failures
+	openUntil time.Time // Zero while closed
+	probing   bool      // A half-open probe is in flight
+}
+
+// allow reports whether a call may go ahead at now. The first caller after
+// the cooldown becomes the probe; others are rejected until it reports.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.openUntil.IsZero() {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// open reports whether the breaker is open or half-open, i.e. the upstream
+// is being skipped
+func (b *circuitBreaker) open() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return !b.openUntil.IsZero()
+}
+
+// record reports the outcome of an allowed call at now, and whether it
+// opened or closed the breaker
+func (b *circuitBreaker) record(success bool, now time.Time) (opened, closed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	wasOpen := !b.openUntil.IsZero()
+	b.probing = false
+	if success {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return false, wasOpen
+	}
+
+	b.failures++
+	if wasOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+		b.openUntil = now.Add(b.cooldown)
+		return !wasOpen, false
+	}
+	return false, false
+}
+
+// release gives up an allowed call without an outcome, e.g. when its caller
+// went away, so a half-open breaker can probe again
+func (b *circuitBreaker) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.probing = false
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := &circuitBreaker{threshold: 3, cooldown: time.Minute}
+	now := time.Unix(1_700_000_000, 0)
+
+	// Failures short of the threshold keep it closed, and a success resets
+	// the count
+	b.record(false, now)
+	b.record(false, now)
+	b.record(true, now)
+	b.record(false, now)
+	b.record(false, now)
+	if !b.allow(now) {
+		t.Fatal("breaker opened before 3 consecutive failures")
+	}
+
+	if opened, _ := b.record(false, now); !opened {
+		t.Fatal("third consecutive failure should open the breaker")
+	}
+	if b.allow(now.Add(59 * time.Second)) {
+		t.Error("calls should be rejected during the cooldown")
+	}
+
+	// Half-open: one probe at a time; its failure reopens the breaker
+	probeAt := now.Add(time.Minute)
+	if !b.allow(probeAt) {
+		t.Fatal("a probe should be allowed after the cooldown")
+	}
+	if b.allow(probeAt) {
+		t.Error("only one probe should be in flight")
+	}
+	if opened, closed := b.record(false, probeAt); opened || closed {
+		t.Errorf("failed probe: opened=%v closed=%v, want it to stay open", opened, closed)
+	}
+	if b.allow(probeAt.Add(30 * time.Second)) {
+		t.Error("a failed probe should restart the cooldown")
+	}
+
+	// A released probe lets the next caller probe instead
+	probeAt = probeAt.Add(time.Minute)
+	b.allow(probeAt)
+	b.release()
+	if !b.allow(probeAt) {
+		t.Fatal("a released probe should allow another")
+	}
+	if _, closed := b.record(true, probeAt); !closed {
+		t.Error("a successful probe should close the breaker")
+	}
+	if !b.allow(probeAt) {
+		t.Error("a closed breaker should allow calls")
+	}
+}
+
+func TestCircuitBreaker_ZeroThresholdNeverOpens(t *testing.T) {
+	var b circuitBreaker
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		b.record(false, now)
+	}
+	if !b.allow(now) {
+		t.Error("a zero threshold should never open")
+	}
+}
+
+// flakyEnhancer fails while down is set, counting calls
+type flakyEnhancer struct {
+	down  atomic.Bool
+	calls atomic.Int32
+}
+
+func (e *flakyEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	e.calls.Add(1)
+	if e.down.Load() {
+		return alerts.EnhancedAlert{}, errors.New("503 service unavailable")
+	}
+	return stubEnhancer{}.EnhanceAlert(ctx, raw)
+}
+
+func (e *flakyEnhancer) HealthCheck(context.Context) error { return nil }
+
+func TestEnhanceAlertWithAI_CircuitBreaker(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	enhancer := &flakyEnhancer{}
+	enhancer.down.Store(true)
+	cfg := &config.Config{OpenAI: config.OpenAIClient{CircuitBreakerFailures: 3, CircuitBreakerCooldown: 50 * time.Millisecond}}
+	s := NewRoadsService(nil, nil, cache.NewCache(), cfg, enhancer, nil)
+
+	next := 0
+	enhance := func() (*alerts.EnhancedAlert, error) {
+		next++
+		return s.EnhanceAlertWithAI(ctx, routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: fmt.Sprint(next), Title: "SR-4", Description: fmt.Sprintf("Lane closure %d", next), Type: "closure", Location: arnold,
+		}})
+	}
+
+	// Sustained failures open the breaker after 3 calls
+	for i := 0; i < 3; i++ {
+		if _, err := enhance(); err == nil {
+			t.Fatal("enhancement should fail while the LLM is down")
+		}
+	}
+	for i := 0; i < 5; i++ {
+		enhanced, err := enhance()
+		if enhanced != nil || err != nil {
+			t.Fatalf("open breaker: got enhanced=%v err=%v, want the raw alert", enhanced, err)
+		}
+	}
+	if calls := enhancer.calls.Load(); calls != 3 {
+		t.Errorf("LLM called %d times, want 3: the open breaker should skip it", calls)
+	}
+
+	// After the cooldown a probe goes through and, with the LLM back, the
+	// breaker closes
+	enhancer.down.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		enhanced, err := enhance()
+		if err != nil || enhanced == nil {
+			t.Fatalf("recovered breaker: got enhanced=%v err=%v", enhanced, err)
+		}
+	}
+	if calls := enhancer.calls.Load(); calls != 6 {
+		t.Errorf("LLM called %d times, want 6", calls)
+	}
+}
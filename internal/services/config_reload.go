@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// cfg returns the configuration in effect. Read it once where settings must
+// agree, e.g. the monitored roads a refresh iterates.
+func (s *RoadsService) cfg() *config.Config {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// MonitoredRoads returns the roads currently configured
+func (s *RoadsService) MonitoredRoads() []config.MonitoredRoad {
+	return s.cfg().Roads.MonitoredRoads
+}
+
+// ApplyRoadsConfig swaps in a reloaded roads section. Settings derived when
+// the service was created keep their startup values until a restart: the
+// ON_ROUTE threshold and closure overlap, alert hash precision, Caltrans feed
+// URLs and timeouts, additional feeds, enhancement workers and weather chain
+// advisories.
+//
+// Road data cached under the old section is dropped so the next ListRoads
+// reflects the new roads. Google Routes results are dropped only for roads
+// whose endpoints moved or that were removed.
+func (s *RoadsService) ApplyRoadsConfig(roads config.RoadsConfig) {
+	s.configMu.Lock()
+	previous := s.config
+	next := &config.Config{}
+	if previous != nil {
+		*next = *previous
+	}
+	next.Roads = roads
+	s.config = next
+	s.configMu.Unlock()
+
+	s.cache.Delete("roads:all")
+	s.cache.Delete(routeGeometryCacheKey)
+
+	if previous == nil {
+		return
+	}
+	for _, old := range previous.Roads.MonitoredRoads {
+		s.cache.Delete(roadCacheKey(old.ID))
+	}
+	current := make(map[string]config.MonitoredRoad, len(roads.MonitoredRoads))
+	for _, road := range roads.MonitoredRoads {
+		current[road.ID] = road
+	}
+	for _, old := range previous.Roads.MonitoredRoads {
+		if road, ok := current[old.ID]; !ok || road.Origin != old.Origin || road.Destination != old.Destination {
+			s.cache.Delete(fmt.Sprintf("google_routes_%s", old.ID))
+		}
+	}
+}
+
+// cfg returns the configuration in effect
+func (s *WeatherService) cfg() *config.Config {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// ApplyWeatherConfig swaps in a reloaded weather section and drops the
+// combined weather and alert lists built from the old locations and zones.
+// Per-location OpenWeatherMap results are keyed by coordinates and stay
+// valid.
+func (s *WeatherService) ApplyWeatherConfig(weather config.WeatherConfig) {
+	s.configMu.Lock()
+	next := &config.Config{}
+	if s.config != nil {
+		*next = *s.config
+	}
+	next.Weather = weather
+	s.config = next
+	s.configMu.Unlock()
+
+	s.cache.Delete("weather:all")
+	s.cache.Delete("weather:alerts")
+	s.cache.Delete("nws:alerts")
+}
+
+// ApplyMonitoredRoads swaps in reloaded monitored roads, whose forecast zones
+// NWS winter alerts are fetched for, and drops the alerts built from the old
+// ones
+func (s *WeatherService) ApplyMonitoredRoads(roads []config.MonitoredRoad) {
+	s.configMu.Lock()
+	next := &config.Config{}
+	if s.config != nil {
+		*next = *s.config
+	}
+	next.Roads.MonitoredRoads = roads
+	s.config = next
+	s.configMu.Unlock()
+
+	s.cache.Delete("weather:alerts")
+	s.cache.Delete("nws:winter_alerts")
+}
+
+// ConfigReloader applies a reloaded configuration to running services
+// without a restart: monitored roads, weather locations and their refresh
+// settings. Either refresh service may be nil.
+type ConfigReloader struct {
+	mutex          sync.Mutex
+	roads          *RoadsService
+	weather        *WeatherService
+	roadsRefresh   *PeriodicRefreshService
+	weatherRefresh *WeatherRefreshService
+}
+
+// NewConfigReloader creates a ConfigReloader for the given services
+func NewConfigReloader(roads *RoadsService, weather *WeatherService, roadsRefresh *PeriodicRefreshService, weatherRefresh *WeatherRefreshService) *ConfigReloader {
+	return &ConfigReloader{
+		roads:          roads,
+		weather:        weather,
+		roadsRefresh:   roadsRefresh,
+		weatherRefresh: weatherRefresh,
+	}
+}
+
+// Reload validates cfg and swaps its roads and weather sections into the
+// services, then restarts the periodic refreshes so new roads and locations
+// are fetched right away on the new intervals. An invalid cfg is rejected
+// whole and the running configuration is left untouched.
+func (r *ConfigReloader) Reload(ctx context.Context, cfg *config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	// Reloads arriving together apply one after the other
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.roads.ApplyRoadsConfig(cfg.Roads)
+	r.weather.ApplyWeatherConfig(cfg.Weather)
+	r.weather.ApplyMonitoredRoads(cfg.Roads.MonitoredRoads)
+
+	if r.roadsRefresh != nil {
+		if err := r.roadsRefresh.Restart(ctx); err != nil {
+			return fmt.Errorf("failed to restart periodic refresh: %w", err)
+		}
+	}
+	if r.weatherRefresh != nil {
+		if err := r.weatherRefresh.Restart(ctx); err != nil {
+			return fmt.Errorf("failed to restart weather refresh: %w", err)
+		}
+	}
+
+	logging.Infow(ctx, "Configuration reloaded",
+		"roads_monitored", len(cfg.Roads.MonitoredRoads),
+		"weather_locations", len(cfg.Weather.Locations))
+	return nil
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// listedRoadIDs returns the ids ListRoads serves
+func listedRoadIDs(t *testing.T, s *RoadsService) []string {
+	t.Helper()
+	resp, err := s.ListRoads(logging.EnsureLogger(context.Background()), &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return roadIDs(resp.Roads)
+}
+
+func TestConfigReloader_AddsRoad(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.EnsureLogger(context.Background()))
+	defer cancel()
+
+	roads, parser := concurrencyFixture(2)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads[:1]
+	cfg.Roads.RefreshInterval = time.Hour // Only the immediate first tick runs
+	roadsService := NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+
+	weatherService := newCachedWeatherService(&countingDoer{calls: map[string]int{}}, time.Hour)
+	weatherService.config.Weather.RefreshInterval = time.Hour
+
+	roadsRefresh := NewPeriodicRefreshService(roadsService)
+	weatherRefresh := NewWeatherRefreshService(weatherService)
+	defer roadsRefresh.Stop()
+	defer weatherRefresh.Stop()
+	if err := roadsRefresh.StartPeriodicRefresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	reloader := NewConfigReloader(roadsService, weatherService, roadsRefresh, weatherRefresh)
+
+	if got := listedRoadIDs(t, roadsService); len(got) != 1 || got[0] != "road-0" {
+		t.Fatalf("roads before reload = %v, want [road-0]", got)
+	}
+
+	// The reloaded file adds a road and a weather location
+	reloaded := &config.Config{}
+	reloaded.Roads.MonitoredRoads = roads
+	reloaded.Roads.RefreshInterval = time.Hour
+	reloaded.Weather = weatherService.config.Weather
+	reloaded.Weather.Locations = append(reloaded.Weather.Locations, config.WeatherLocation{
+		ID: "arnold", Name: "Arnold", Coordinates: config.Coordinates{Latitude: 38.2555, Longitude: -120.3516},
+	})
+	if err := reloader.Reload(ctx, reloaded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := listedRoadIDs(t, roadsService); len(got) != 2 || got[1] != "road-1" {
+		t.Errorf("roads after reload = %v, want [road-0 road-1]", got)
+	}
+	if !roadsRefresh.IsRunning() || !weatherRefresh.IsRunning() {
+		t.Error("periodic refreshes should be running after reload")
+	}
+
+	// The restarted weather refresh fetches the new location right away
+	deadline := time.Now().Add(2 * time.Second)
+	var cached []*api.WeatherData
+	for time.Now().Before(deadline) {
+		if found, _ := weatherService.cache.Get("weather:all", &cached); found && len(cached) == 2 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if len(cached) != 2 {
+		t.Errorf("cached weather for %d locations after reload, want 2", len(cached))
+	}
+}
+
+func TestConfigReloader_RejectsInvalidConfig(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	roads, parser := concurrencyFixture(2)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads[:1]
+	cfg.Roads.RefreshInterval = time.Hour
+	cfg.Weather.RefreshInterval = time.Hour
+	roadsService := NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+	weatherService := NewWeatherService(nil, nil, cache.NewCache(), cfg, nil, nil)
+	reloader := NewConfigReloader(roadsService, weatherService, nil, nil)
+
+	if got := listedRoadIDs(t, roadsService); len(got) != 1 {
+		t.Fatalf("roads before reload = %v, want 1 road", got)
+	}
+
+	// A valid new road alongside a broken one: nothing is applied
+	bad := &config.Config{}
+	bad.Roads.MonitoredRoads = append(append([]config.MonitoredRoad{}, roads...), config.MonitoredRoad{ID: "road-0"})
+	bad.Roads.RefreshInterval = time.Hour
+	bad.Weather.RefreshInterval = time.Hour
+	if err := reloader.Reload(ctx, bad); err == nil {
+		t.Fatal("Reload accepted a config with a duplicate road id")
+	}
+
+	if roadsService.cfg() != cfg || weatherService.cfg() != cfg {
+		t.Error("rejected reload replaced the running configuration")
+	}
+	if roadsService.cache.IsStale("roads:all") {
+		t.Error("rejected reload dropped the cached roads")
+	}
+	if got := listedRoadIDs(t, roadsService); len(got) != 1 || got[0] != "road-0" {
+		t.Errorf("roads after rejected reload = %v, want [road-0]", got)
+	}
+}
+
+func TestApplyRoadsConfig_DropsMovedRoutes(t *testing.T) {
+	roads, _ := concurrencyFixture(2)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	s := &RoadsService{cache: cache.NewCache(), config: cfg}
+	for _, road := range roads {
+		if err := s.cache.Set("google_routes_"+road.ID, googleRouteCache{Polyline: "abc"}, time.Hour, "google_routes"); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.cache.Set(roadCacheKey(road.ID), &api.Road{Id: road.ID}, time.Hour, "roads"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	moved := config.RoadsConfig{MonitoredRoads: append([]config.MonitoredRoad{}, roads...)}
+	moved.MonitoredRoads[1].Destination.Latitude += 0.01
+	s.ApplyRoadsConfig(moved)
+
+	if s.cache.IsStale("google_routes_road-0") {
+		t.Error("unchanged road's Google Routes result should be kept")
+	}
+	if !s.cache.IsStale("google_routes_road-1") {
+		t.Error("moved road's Google Routes result should be dropped")
+	}
+	if !s.cache.IsStale(roadCacheKey("road-0")) {
+		t.Error("cached road should be dropped so GetRoad reflects the new roads")
+	}
+	if s.cfg().Roads.MonitoredRoads[1].Destination != moved.MonitoredRoads[1].Destination {
+		t.Error("ApplyRoadsConfig did not swap in the new roads")
+	}
+}
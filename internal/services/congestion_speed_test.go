@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// fixtureRoutesAPI answers Compute Routes calls with a recorded response
+type fixtureRoutesAPI struct {
+	path string
+}
+
+func (f fixtureRoutesAPI) Do(req *http.Request) (*http.Response, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: file}, nil
+}
+
+func TestClassifyCongestionBySpeed(t *testing.T) {
+	reading := func(start, end int32, speed string) google.SpeedReading {
+		return google.SpeedReading{StartIndex: start, EndIndex: end, Speed: speed}
+	}
+	tests := []struct {
+		name     string
+		readings []google.SpeedReading
+		want     string
+	}{
+		{"all normal", []google.SpeedReading{reading(0, 10, "NORMAL")}, "clear"},
+		{"mostly jammed", []google.SpeedReading{reading(0, 3, "NORMAL"), reading(3, 10, "TRAFFIC_JAM")}, "severe"},
+		{"some jam", []google.SpeedReading{reading(0, 17, "NORMAL"), reading(17, 20, "TRAFFIC_JAM")}, "heavy"},
+		{"slow stretch", []google.SpeedReading{reading(0, 6, "NORMAL"), reading(6, 10, "SLOW")}, "moderate"},
+		{"brief slowdown", []google.SpeedReading{reading(0, 19, "NORMAL"), reading(19, 20, "SLOW")}, "light"},
+		{"empty spans", []google.SpeedReading{reading(4, 4, "TRAFFIC_JAM")}, "clear"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyCongestionBySpeed(tt.readings); got != tt.want {
+				t.Errorf("classifyCongestionBySpeed() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTrafficData_SpeedReadingsWithoutStaticDuration(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	routesAPI := fixtureRoutesAPI{path: "../../tests/testdata/google/traffic_jam_no_static_duration.json"}
+	cfg := &config.Config{GoogleRoutes: config.GoogleRoutesClient{APIKey: "test-key", SpeedReadings: true}}
+	client := google.NewClientWithHTTPDoer("test-key", "https://routes.example.com", routesAPI)
+	client.SpeedReadings = true
+	s := NewRoadsService(client, nil, cache.NewCache(), cfg, nil, nil)
+
+	_, _, congestion, delayMins, _, err := s.getTrafficDataWithPolyline(ctx, config.MonitoredRoad{ID: "jammed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delayMins != 0 {
+		t.Errorf("delay = %d, want 0 without a static duration", delayMins)
+	}
+	if congestion == "clear" || congestion == "unknown" {
+		t.Errorf("congestion = %q, want a congested level from the TRAFFIC_JAM readings", congestion)
+	}
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// corridorWeatherRadiusMeters is how close a configured weather location must
+// be to a road's midpoint to stand in for it. Farther away, weather is fetched
+// for the midpoint itself.
+const corridorWeatherRadiusMeters = 25000
+
+// IncludeCorridorWeather lets GetCorridorStatus add the current weather along
+// each road from the weather service. Call before serving.
+func (s *RoadsService) IncludeCorridorWeather(weather *WeatherService) {
+	s.weather = weather
+}
+
+// GetCorridorStatus implements the gRPC method for a road's conditions plus
+// the weather along it. Weather that can't be fetched is left out rather than
+// failing the road.
+func (s *RoadsService) GetCorridorStatus(ctx context.Context, req *api.GetCorridorStatusRequest) (*api.GetCorridorStatusResponse, error) {
+	logging.Infow(ctx, "GetCorridorStatus called", "road_id", req.RoadId)
+
+	var monitored *config.MonitoredRoad
+	for _, road := range s.MonitoredRoads() {
+		if road.ID == req.RoadId {
+			monitored = &road
+			break
+		}
+	}
+	if monitored == nil {
+		return nil, status.Errorf(codes.NotFound, "road not found: %s", req.RoadId)
+	}
+
+	roadResp, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: req.RoadId})
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.GetCorridorStatusResponse{
+		Road:        roadResp.Road,
+		LastUpdated: roadResp.LastUpdated,
+	}
+
+	if s.weather != nil {
+		weather, err := s.weather.corridorWeather(ctx, *monitored)
+		if err != nil {
+			logging.Errorw(ctx, "Failed to get corridor weather", "road_id", req.RoadId, "error", err)
+		} else {
+			resp.Weather = weather
+		}
+	}
+	return resp, nil
+}
+
+// corridorWeather returns the current weather and alerts near the road's
+// midpoint
+func (s *WeatherService) corridorWeather(ctx context.Context, road config.MonitoredRoad) (*api.WeatherData, error) {
+	midpoint := config.Coordinates{
+		Latitude:  (road.Origin.Latitude + road.Destination.Latitude) / 2,
+		Longitude: (road.Origin.Longitude + road.Destination.Longitude) / 2,
+	}
+	return s.weatherNear(ctx, config.WeatherLocation{ID: road.ID, Name: road.Name, Coordinates: midpoint}, corridorWeatherRadiusMeters)
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+func TestGetCorridorStatus(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{
+		// Midpoint about 8 km from the Murphys weather location
+		{ID: "hwy4-murphys-arnold", Name: "Murphys to Arnold",
+			Origin:      config.Coordinates{Latitude: 38.1377, Longitude: -120.4627},
+			Destination: config.Coordinates{Latitude: 38.2555, Longitude: -120.3516}},
+		// No weather location near Ebbetts Pass
+		{ID: "hwy4-bearvalley-ebbetts", Name: "Bear Valley to Ebbetts Pass",
+			Origin:      config.Coordinates{Latitude: 38.4610, Longitude: -120.0424},
+			Destination: config.Coordinates{Latitude: 38.5446, Longitude: -119.8132}},
+	}
+	c := cache.NewCache()
+	roads := []*api.Road{
+		{Id: "hwy4-murphys-arnold", Status: api.RoadStatus_OPEN, CongestionLevel: api.CongestionLevel_MODERATE,
+			ChainControl: api.ChainControlStatus_NONE},
+		{Id: "hwy4-bearvalley-ebbetts", Status: api.RoadStatus_RESTRICTED, CongestionLevel: api.CongestionLevel_CLEAR,
+			ChainControl: api.ChainControlStatus_REQUIRED},
+	}
+	if err := c.Set("roads:all", roads, time.Hour, "roads"); err != nil {
+		t.Fatal(err)
+	}
+	s := &RoadsService{cache: c, config: cfg}
+
+	doer := &countingDoer{calls: map[string]int{}}
+	weatherService := newCachedWeatherService(doer, time.Hour)
+	s.IncludeCorridorWeather(weatherService)
+
+	resp, err := s.GetCorridorStatus(ctx, &api.GetCorridorStatusRequest{RoadId: "hwy4-murphys-arnold"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Road.Id != "hwy4-murphys-arnold" || resp.Road.CongestionLevel != api.CongestionLevel_MODERATE {
+		t.Errorf("road = %v, want hwy4-murphys-arnold with MODERATE congestion", resp.Road)
+	}
+	if resp.Weather == nil || resp.Weather.LocationId != "murphys" || resp.Weather.TemperatureCelsius != 21 {
+		t.Errorf("weather = %v, want the Murphys location's conditions", resp.Weather)
+	}
+	if resp.LastUpdated == nil {
+		t.Error("last_updated is unset")
+	}
+
+	// Far from any weather location, the midpoint's weather is fetched
+	resp, err = s.GetCorridorStatus(ctx, &api.GetCorridorStatusRequest{RoadId: "hwy4-bearvalley-ebbetts"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Road.ChainControl != api.ChainControlStatus_REQUIRED {
+		t.Errorf("chain control = %v, want REQUIRED", resp.Road.ChainControl)
+	}
+	if resp.Weather == nil || resp.Weather.LocationId != "hwy4-bearvalley-ebbetts" || resp.Weather.LocationName != "Bear Valley to Ebbetts Pass" {
+		t.Errorf("weather = %v, want conditions at the road's midpoint", resp.Weather)
+	}
+
+	if _, err := s.GetCorridorStatus(ctx, &api.GetCorridorStatusRequest{RoadId: "hwy88"}); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown road error = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// errEnhancementQueueFull is returned by enqueue for an alert dropped because
+// the queue stayed full
+var errEnhancementQueueFull = errors.New("enhancement queue full")
+
+// enhancementJob is one alert waiting for background enhancement
+type enhancementJob struct {
+	alert       routing.ClassifiedAlert
+	contentHash string
+	requestID   string // Correlation ID of the refresh that queued the alert
+}
+
+// enhancementQueue runs AI enhancement off the refresh path. Workers cache
+// each result for later refreshes.
+type enhancementQueue struct {
+	jobs    chan enhancementJob
+	workers sync.WaitGroup
+	cancel  context.CancelFunc // Abandons in-flight enhancements
+	senders sync.WaitGroup     // enqueue calls that may still send on jobs
+	dropped atomic.Int64       // Alerts dropped because the queue was full
+
+	mutex   sync.Mutex
+	pending map[string]bool // Content hashes queued or being enhanced
+	closed  bool            // Set by StopBackgroundEnhancement; jobs is closed
+}
+
+// StartBackgroundEnhancement makes refreshes queue cache-miss alerts for
+// workers instead of waiting on the enhancer, serving raw alerts until the
+// enhancement lands in the cache. Call before the first refresh; workers
+// stop when ctx is done or after StopBackgroundEnhancement.
+func (s *RoadsService) StartBackgroundEnhancement(ctx context.Context, workers int) {
+	if workers <= 0 || s.alertEnhancer == nil || s.enhancements != nil {
+		return
+	}
+
+	queueSize := s.enhancementQueueSize()
+	q := &enhancementQueue{
+		jobs:    make(chan enhancementJob, queueSize),
+		pending: make(map[string]bool),
+	}
+	ctx, q.cancel = context.WithCancel(ctx)
+	s.enhancements = q
+
+	logging.Infow(ctx, "Starting background alert enhancement", "workers", workers, "queue_size", queueSize)
+	q.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go s.enhancementWorker(ctx, q)
+	}
+}
+
+// enhancementQueueSize is roads.enhancementQueueSize, or the default when
+// unset
+func (s *RoadsService) enhancementQueueSize() int {
+	if cfg := s.cfg(); cfg != nil && cfg.Roads.EnhancementQueueSize > 0 {
+		return cfg.Roads.EnhancementQueueSize
+	}
+	return config.DefaultEnhancementQueueSize
+}
+
+// enhancementQueueWait is how long a refresh waits for room in a full queue
+func (s *RoadsService) enhancementQueueWait() time.Duration {
+	if cfg := s.cfg(); cfg != nil {
+		return cfg.Roads.EnhancementQueueWait
+	}
+	return 0
+}
+
+// StopBackgroundEnhancement stops queueing alerts and waits for workers to
+// enhance and cache the ones already queued. If ctx is done first the
+// workers are cancelled, abandoning in-flight LLM calls and the rest of the
+// queue; the next refresh after a restart queues those alerts again.
+func (s *RoadsService) StopBackgroundEnhancement(ctx context.Context) error {
+	q := s.enhancements
+	if q == nil {
+		return nil
+	}
+	drained := make(chan struct{})
+	go func() {
+		q.close()
+		q.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		abandoned := len(q.jobs)
+		q.cancel()
+		return fmt.Errorf("enhancement queue not drained, %d queued alerts abandoned: %w", abandoned, ctx.Err())
+	}
+}
+
+// enhancementWorker enhances queued alerts until ctx is done or the queue is
+// closed and empty
+func (s *RoadsService) enhancementWorker(ctx context.Context, q *enhancementQueue) {
+	defer q.workers.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job, ok := <-q.jobs:
+			if !ok {
+				return // Drained after StopBackgroundEnhancement
+			}
+			s.runEnhancementJob(ctx, q, job)
+		}
+	}
+}
+
+// runEnhancementJob enhances and caches one queued alert. A panic is
+// recovered here so the worker moves on to the next job.
+func (s *RoadsService) runEnhancementJob(ctx context.Context, q *enhancementQueue, job enhancementJob) {
+	defer q.done(job.contentHash)
+	defer func() {
+		// Recover from any panics in the enhancement worker
+		if r := recover(); r != nil {
+			err, _ := errors.ParseStack(debug.Stack())
+			skipFrames := 3
+			numFrames := 5
+			logging.Errorw(ctx, "Alert enhancement worker: recovered from panic",
+				"error", r, "hash", job.contentHash[:8], "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+		}
+	}()
+
+	// The refresh already counted this lookup as a miss; recheck without
+	// counting in case another path cached it meanwhile. Errors are logged
+	// by enhanceAndCache; the next refresh retries.
+	if _, found := s.cachedEnhancement(job.contentHash); found {
+		return
+	}
+	jobCtx := ctx
+	if job.requestID != "" {
+		jobCtx = requestid.With(ctx, job.requestID)
+	}
+	_, _ = s.enhanceAndCache(jobCtx, job.alert.Type, s.rawAlertFor(job.alert), job.contentHash)
+}
+
+// enqueue adds an alert unless it's already pending, reporting whether it
+// was added. When the queue is full it waits up to wait for room (or until
+// ctx is done), then drops the alert with errEnhancementQueueFull; a later
+// refresh queues it again. requestID ties the worker's log lines back to the
+// refresh that queued it.
+func (q *enhancementQueue) enqueue(ctx context.Context, alert routing.ClassifiedAlert, contentHash, requestID string, wait time.Duration) (bool, error) {
+	q.mutex.Lock()
+	if q.closed || q.pending[contentHash] {
+		q.mutex.Unlock()
+		return false, nil
+	}
+	// Reserve the hash and register as a sender so close() can't close jobs
+	// under a send that is waiting for room
+	q.pending[contentHash] = true
+	q.senders.Add(1)
+	q.mutex.Unlock()
+	defer q.senders.Done()
+
+	job := enhancementJob{alert: alert, contentHash: contentHash, requestID: requestID}
+	select {
+	case q.jobs <- job:
+		return true, nil
+	default:
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case q.jobs <- job:
+			return true, nil
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+
+	q.done(contentHash)
+	q.dropped.Add(1)
+	return false, errEnhancementQueueFull
+}
+
+// done clears a finished alert so it can be queued again if enhancement failed
+func (q *enhancementQueue) done(contentHash string) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	delete(q.pending, contentHash)
+}
+
+// close stops accepting alerts. Workers exit once they have drained the
+// alerts already queued.
+func (q *enhancementQueue) close() {
+	q.mutex.Lock()
+	if q.closed {
+		q.mutex.Unlock()
+		return
+	}
+	q.closed = true
+	q.mutex.Unlock()
+
+	// No new senders once closed. Workers keep draining meanwhile, so
+	// senders waiting for room finish within their wait.
+	q.senders.Wait()
+	close(q.jobs)
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func TestBackgroundEnhancement_ServesRawThenEnhanced(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.EnsureLogger(context.Background()))
+	defer cancel()
+
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: stubEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+	s.StartBackgroundEnhancement(ctx, 1)
+
+	classified := routing.ClassifiedAlert{
+		UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: "a1", Title: "SR-4", Description: "R2 CHAIN CONTROLS IN EFFECT", Type: "weather", Location: arnold,
+		},
+		Classification: routing.OnRoute,
+	}
+	road := config.MonitoredRoad{ID: "hwy4"}
+
+	// First refresh: nothing cached, so the raw alert is served and the
+	// enhancement is queued
+	alert, enhanced, err := s.buildEnhancedRoadAlert(ctx, classified, road)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enhanced != nil || alert.CondensedSummary != "" || alert.Description != "R2 CHAIN CONTROLS IN EFFECT" {
+		t.Fatalf("first refresh should serve the raw alert, got summary %q description %q", alert.CondensedSummary, alert.Description)
+	}
+
+	// Later refresh: the worker has cached the enhancement
+	deadline := time.Now().Add(time.Second)
+	for {
+		alert, enhanced, err = s.buildEnhancedRoadAlert(ctx, classified, road)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enhanced != nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if enhanced == nil || alert.CondensedSummary != "Chains required" {
+		t.Fatalf("later refresh should serve the enhanced alert, got summary %q", alert.CondensedSummary)
+	}
+}
+
+func TestEnhancementQueue_SkipsPendingDuplicates(t *testing.T) {
+	ctx := context.Background()
+	q := &enhancementQueue{jobs: make(chan enhancementJob, 1), pending: map[string]bool{}}
+	alert := routing.ClassifiedAlert{}
+
+	if queued, _ := q.enqueue(ctx, alert, "h1", "", 0); !queued {
+		t.Fatal("first enqueue should succeed")
+	}
+	if queued, err := q.enqueue(ctx, alert, "h1", "", 0); queued || err != nil {
+		t.Errorf("a pending alert should be skipped, got queued %v, error %v", queued, err)
+	}
+	if queued, _ := q.enqueue(ctx, alert, "h2", "", 0); queued {
+		t.Error("a full queue should skip new alerts")
+	}
+
+	<-q.jobs
+	q.done("h1")
+	if queued, _ := q.enqueue(ctx, alert, "h1", "", 0); !queued {
+		t.Error("a finished alert should be queueable again")
+	}
+}
+
+func TestEnhancementQueue_FullQueue(t *testing.T) {
+	ctx := context.Background()
+	q := &enhancementQueue{jobs: make(chan enhancementJob, 2), pending: map[string]bool{}}
+	alert := routing.ClassifiedAlert{}
+	for _, hash := range []string{"h1", "h2"} {
+		if queued, err := q.enqueue(ctx, alert, hash, "", 0); !queued || err != nil {
+			t.Fatalf("enqueue %s: queued %v, error %v", hash, queued, err)
+		}
+	}
+
+	// Without a wait a full queue drops the alert and counts it
+	if queued, err := q.enqueue(ctx, alert, "h3", "", 0); queued || !errors.Is(err, errEnhancementQueueFull) {
+		t.Fatalf("enqueue to a full queue: queued %v, error %v", queued, err)
+	}
+	if q.dropped.Load() != 1 {
+		t.Errorf("dropped = %d, want 1", q.dropped.Load())
+	}
+	if q.pending["h3"] {
+		t.Error("a dropped alert should not stay pending")
+	}
+
+	// With a wait it blocks until a worker makes room
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		<-q.jobs
+	}()
+	start := time.Now()
+	if queued, err := q.enqueue(ctx, alert, "h3", "", time.Second); !queued || err != nil {
+		t.Fatalf("enqueue waiting for room: queued %v, error %v", queued, err)
+	}
+	if waited := time.Since(start); waited < 20*time.Millisecond {
+		t.Errorf("enqueue returned after %s, before there was room", waited)
+	}
+
+	// and drops the alert if none is made in time
+	if queued, err := q.enqueue(ctx, alert, "h4", "", 10*time.Millisecond); queued || !errors.Is(err, errEnhancementQueueFull) {
+		t.Fatalf("enqueue timing out: queued %v, error %v", queued, err)
+	}
+	if q.dropped.Load() != 2 {
+		t.Errorf("dropped = %d, want 2", q.dropped.Load())
+	}
+}
+
+// drainEnhancer takes delay per alert, or blocks until its context is
+// cancelled when delay is zero
+type drainEnhancer struct {
+	delay     time.Duration
+	cancelled chan struct{}
+}
+
+func (e drainEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	if e.delay == 0 {
+		<-ctx.Done()
+		close(e.cancelled)
+		return alerts.EnhancedAlert{}, ctx.Err()
+	}
+	time.Sleep(e.delay)
+	return stubEnhancer{}.EnhanceAlert(ctx, raw)
+}
+
+func (drainEnhancer) HealthCheck(context.Context) error { return nil }
+
+// queueAlerts queues n distinct alerts and returns their content hashes
+func queueAlerts(t *testing.T, s *RoadsService, n int) []string {
+	t.Helper()
+	var hashes []string
+	for i := 0; i < n; i++ {
+		alert := routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: fmt.Sprint(i), Title: "SR-4", Description: fmt.Sprintf("Lane closure %d", i), Type: "closure", Location: arnold,
+		}}
+		hash := s.contentHasher.HashRawAlert(s.rawAlertFor(alert))
+		if queued, _ := s.enhancements.enqueue(context.Background(), alert, hash, "", 0); !queued {
+			t.Fatalf("alert %d was not queued", i)
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+func TestStopBackgroundEnhancement_DrainsQueue(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: drainEnhancer{delay: 20 * time.Millisecond},
+		contentHasher: alerts.NewContentHasher(),
+	}
+	s.StartBackgroundEnhancement(ctx, 1)
+	hashes := queueAlerts(t, s, 3)
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := s.StopBackgroundEnhancement(stopCtx); err != nil {
+		t.Fatalf("StopBackgroundEnhancement: %v", err)
+	}
+
+	for i, hash := range hashes {
+		if _, found := s.cachedEnhancement(hash); !found {
+			t.Errorf("alert %d was not enhanced before shutdown", i)
+		}
+	}
+	if queued, _ := s.enhancements.enqueue(ctx, routing.ClassifiedAlert{}, "late", "", 0); queued {
+		t.Error("a stopped queue should not accept alerts")
+	}
+	if err := s.StopBackgroundEnhancement(stopCtx); err != nil {
+		t.Errorf("stopping twice: %v", err)
+	}
+}
+
+func TestStopBackgroundEnhancement_CancelsAfterTimeout(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	enhancer := drainEnhancer{cancelled: make(chan struct{})}
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: enhancer,
+		contentHasher: alerts.NewContentHasher(),
+	}
+	s.StartBackgroundEnhancement(ctx, 1)
+	queueAlerts(t, s, 2)
+
+	stopCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := s.StopBackgroundEnhancement(stopCtx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want a deadline error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("StopBackgroundEnhancement took %v after its timeout", elapsed)
+	}
+
+	select {
+	case <-enhancer.cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the in-flight enhancement was not cancelled")
+	}
+}
+
+// panicEnhancer panics on the first alert queued by queueAlerts
+type panicEnhancer struct{}
+
+func (panicEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	if raw.ID == "0" {
+		panic("enhancer bug")
+	}
+	return stubEnhancer{}.EnhanceAlert(ctx, raw)
+}
+
+func (panicEnhancer) HealthCheck(context.Context) error { return nil }
+
+func TestBackgroundEnhancement_WorkerSurvivesPanic(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: panicEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+	s.StartBackgroundEnhancement(ctx, 1)
+	hashes := queueAlerts(t, s, 2)
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := s.StopBackgroundEnhancement(stopCtx); err != nil {
+		t.Fatalf("StopBackgroundEnhancement: %v", err)
+	}
+
+	// The only worker recovered and went on to the next alert
+	if _, found := s.cachedEnhancement(hashes[1]); !found {
+		t.Error("the alert after the panic was not enhanced")
+	}
+	if s.enhancements.pending[hashes[0]] {
+		t.Error("the panicked alert is still pending, so no refresh can queue it again")
+	}
+}
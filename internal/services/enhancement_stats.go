@@ -0,0 +1,74 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// hitRateBuckets is the number of hourly buckets in a hitRateWindow (24h)
+const hitRateBuckets = 24
+
+// hitRateBucket counts lookups within one clock hour
+type hitRateBucket struct {
+	hour   int64 // Unix time / 1h; identifies which hour the counts belong to
+	hits   int64
+	misses int64
+}
+
+// hitRateWindow counts cache hits and misses over the last 24 hours in
+// hourly buckets; safe for concurrent use. The zero value is ready to use.
+type hitRateWindow struct {
+	mutex   sync.Mutex
+	buckets [hitRateBuckets]hitRateBucket
+}
+
+// record counts one lookup at now
+func (w *hitRateWindow) record(hit bool, now time.Time) {
+	hour := now.Unix() / int64(time.Hour/time.Second)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	bucket := &w.buckets[hour%hitRateBuckets]
+	if bucket.hour != hour {
+		*bucket = hitRateBucket{hour: hour} // Reuse the slot from 24h ago
+	}
+	if hit {
+		bucket.hits++
+	} else {
+		bucket.misses++
+	}
+}
+
+// totals returns hits and misses within the 24 hours ending at now
+func (w *hitRateWindow) totals(now time.Time) (hits, misses int64) {
+	hour := now.Unix() / int64(time.Hour/time.Second)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, bucket := range w.buckets {
+		if bucket.hour > hour-hitRateBuckets && bucket.hour <= hour {
+			hits += bucket.hits
+			misses += bucket.misses
+		}
+	}
+	return hits, misses
+}
+
+// hitRate is hits/(hits+misses), or 0 with no lookups
+func hitRate(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// countCachedEnhancements counts fresh enhanced alerts in the cache, as of
+// the cache's last stats scan (see cache.RedisStore.Stats)
+func (s *RoadsService) countCachedEnhancements() int64 {
+	if s.cache == nil {
+		return 0
+	}
+	return int64(s.cache.Stats().EnhancedAlerts)
+}
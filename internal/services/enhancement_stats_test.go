@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// cannedProvider answers every completion with a fixed enhancement
+type cannedProvider struct{}
+
+func (cannedProvider) Complete(ctx context.Context, req alerts.CompletionRequest) (alerts.CompletionResponse, error) {
+	return alerts.CompletionResponse{Content: `{"details": "Tree down", "condensed_summary": "Tree down",
+		"location": {"description": "SR-4", "latitude": 38.25, "longitude": -120.35},
+		"impact": "light", "road_status": "open", "restriction_details": null, "chain_status": "none"}`}, nil
+}
+
+func (cannedProvider) Name() string { return "canned" }
+
+func (p cannedProvider) WithModel(model string) alerts.EnhancerProvider { return p }
+
+func TestHitRateWindow(t *testing.T) {
+	var w hitRateWindow
+	now := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+
+	w.record(true, now.Add(-25*time.Hour)) // Outside the window
+	w.record(false, now.Add(-23*time.Hour))
+	w.record(true, now.Add(-time.Hour))
+	w.record(true, now)
+	w.record(true, now)
+
+	hits, misses := w.totals(now)
+	if hits != 3 || misses != 1 {
+		t.Errorf("hits=%d misses=%d, want 3 and 1", hits, misses)
+	}
+	if rate := hitRate(hits, misses); rate != 0.75 {
+		t.Errorf("rate = %v, want 0.75", rate)
+	}
+
+	// A day later the old buckets have aged out
+	if hits, misses := w.totals(now.Add(24 * time.Hour)); hits != 0 || misses != 0 {
+		t.Errorf("after 24h: hits=%d misses=%d, want 0 and 0", hits, misses)
+	}
+	if rate := hitRate(0, 0); rate != 0 {
+		t.Errorf("empty rate = %v, want 0", rate)
+	}
+}
+
+func TestGetProcessingMetrics_CacheHitRate(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: alerts.NewAlertEnhancerWithProvider(cannedProvider{}),
+		contentHasher: alerts.NewContentHasher(),
+	}
+
+	alertWith := func(description string) routing.ClassifiedAlert {
+		return routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: description, Title: "SR-4", Description: description, Type: "incident", Location: arnold,
+		}}
+	}
+
+	// Two distinct alerts miss once each, then hit three times between them
+	for _, description := range []string{"Tree down", "Rock slide", "Tree down", "Rock slide", "Tree down"} {
+		if _, err := s.EnhanceAlertWithAI(ctx, alertWith(description)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	metrics, err := s.GetProcessingMetrics(ctx, &api.GetProcessingMetricsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.CacheHits_24H != 3 || metrics.CacheMisses_24H != 2 {
+		t.Errorf("hits=%d misses=%d, want 3 and 2", metrics.CacheHits_24H, metrics.CacheMisses_24H)
+	}
+	if metrics.CacheHitRate_24H != 0.6 {
+		t.Errorf("hit rate = %v, want 0.6", metrics.CacheHitRate_24H)
+	}
+	if metrics.CachedEnhancements != 2 {
+		t.Errorf("cached enhancements = %d, want 2", metrics.CachedEnhancements)
+	}
+	if metrics.EnhancedAlerts != 2 {
+		t.Errorf("enhanced alerts = %d, want 2 (hits make no AI call)", metrics.EnhancedAlerts)
+	}
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+)
+
+// enhancedAlertKeyPrefix prefixes content hashes in enhanced-alert cache keys
+const enhancedAlertKeyPrefix = "enhanced_alert:"
+
+// feedSightings records which alert content hashes appeared in the Caltrans
+// feeds, so enhancements for incidents that have left the feed (resolved)
+// can be dropped instead of lingering until their TTL. The zero value is
+// ready to use.
+type feedSightings struct {
+	mutex       sync.Mutex
+	latest      map[string]bool // Seen during the last complete refresh; nil before the first
+	latestStart time.Time       // When the refresh that saw latest started
+	// known holds every hash seen by a complete refresh and not yet
+	// resolved: the enhancements this server may have cached. Tracking them
+	// here spares scanning a shared cache, other replicas' keys and all.
+	known map[string]bool
+}
+
+// refreshSightings collects the alert content hashes seen by one refresh.
+// Each refresh owns its own, so refreshes that overlap don't mix sightings.
+type refreshSightings struct {
+	startedAt time.Time
+	mutex     sync.Mutex
+	seen      map[string]bool
+}
+
+// refreshSightingsKey is the context key for the refresh's sightings
+type refreshSightingsKey struct{}
+
+// withRefreshSightings returns ctx carrying a new sightings collection for a
+// refresh started at startedAt
+func withRefreshSightings(ctx context.Context, startedAt time.Time) (context.Context, *refreshSightings) {
+	sightings := &refreshSightings{startedAt: startedAt, seen: make(map[string]bool)}
+	return context.WithValue(ctx, refreshSightingsKey{}, sightings), sightings
+}
+
+// markSeen records that contentHash is in the feed the refresh in ctx is
+// processing. Outside a refresh it does nothing.
+func markSeen(ctx context.Context, contentHash string) {
+	sightings, ok := ctx.Value(refreshSightingsKey{}).(*refreshSightings)
+	if !ok {
+		return
+	}
+	sightings.mutex.Lock()
+	defer sightings.mutex.Unlock()
+	sightings.seen[contentHash] = true
+}
+
+// completeFeed makes a finished refresh's sightings the latest feed, unless
+// a refresh that started after it has already completed
+func (f *feedSightings) completeFeed(refresh *refreshSightings) {
+	refresh.mutex.Lock()
+	seen := make(map[string]bool, len(refresh.seen))
+	for contentHash := range refresh.seen {
+		seen[contentHash] = true
+	}
+	refresh.mutex.Unlock()
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.known == nil {
+		f.known = make(map[string]bool)
+	}
+	for contentHash := range seen {
+		f.known[contentHash] = true
+	}
+	if f.latest != nil && refresh.startedAt.Before(f.latestStart) {
+		return
+	}
+	f.latest = seen
+	f.latestStart = refresh.startedAt
+}
+
+// resolved returns the known hashes that were not seen in the latest
+// complete feed. Nothing is resolved before the first complete feed.
+func (f *feedSightings) resolved() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.latest == nil {
+		return nil
+	}
+	var gone []string
+	for contentHash := range f.known {
+		if !f.latest[contentHash] {
+			gone = append(gone, contentHash)
+		}
+	}
+	return gone
+}
+
+// forget stops tracking hashes whose enhancements were dropped
+func (f *feedSightings) forget(contentHashes []string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, contentHash := range contentHashes {
+		delete(f.known, contentHash)
+	}
+}
+
+// ResolvedEnhancements returns content hashes of enhanced alerts whose
+// incidents were in an earlier complete Caltrans feed but absent from the
+// latest one
+func (s *RoadsService) ResolvedEnhancements() []string {
+	return s.sightings.resolved()
+}
+
+// dropResolvedEnhancements removes enhancements for incidents absent from
+// the latest complete feed
+func (s *RoadsService) dropResolvedEnhancements(ctx context.Context) {
+	resolved := s.ResolvedEnhancements()
+	for _, contentHash := range resolved {
+		s.cache.Delete(enhancedAlertKeyPrefix + contentHash)
+	}
+	s.sightings.forget(resolved)
+	if len(resolved) > 0 {
+		logging.Infow(ctx, "Dropped enhancements for resolved incidents", "count", len(resolved))
+	}
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func TestResolvedEnhancements(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: stubEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+	alertWith := func(description string) routing.ClassifiedAlert {
+		return routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: description, Title: "SR-4", Description: description, Type: "closure", Location: arnold,
+		}}
+	}
+	hashOf := func(a routing.ClassifiedAlert) string {
+		return s.contentHasher.HashRawAlert(s.rawAlertFor(a))
+	}
+	stillOpen, cleared := alertWith("Lane closed for paving"), alertWith("Rock slide blocking lane")
+
+	// First refresh: both incidents are in the feed and get enhanced
+	firstCtx, first := withRefreshSightings(ctx, time.Now())
+	for _, a := range []routing.ClassifiedAlert{stillOpen, cleared} {
+		if _, err := s.EnhanceAlertWithAI(firstCtx, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := s.ResolvedEnhancements(); len(got) != 0 {
+		t.Fatalf("resolved before any complete feed = %v, want none", got)
+	}
+	s.sightings.completeFeed(first)
+	if got := s.ResolvedEnhancements(); len(got) != 0 {
+		t.Fatalf("resolved after first feed = %v, want none", got)
+	}
+
+	// Second refresh: only one is still in the feed
+	secondCtx, second := withRefreshSightings(ctx, time.Now())
+	if _, err := s.EnhanceAlertWithAI(secondCtx, stillOpen); err != nil {
+		t.Fatal(err)
+	}
+	s.sightings.completeFeed(second)
+
+	resolved := s.ResolvedEnhancements()
+	if len(resolved) != 1 || resolved[0] != hashOf(cleared) {
+		t.Fatalf("resolved = %v, want only the cleared incident %s", resolved, hashOf(cleared))
+	}
+
+	// Another replica's enhancement in a shared cache is not this server's
+	// to drop
+	if err := s.cache.SetEnhancedAlert("other-replica", alerts.EnhancedAlert{}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	s.dropResolvedEnhancements(ctx)
+	if _, found := s.cachedEnhancement(hashOf(cleared)); found {
+		t.Error("resolved incident's enhancement should be dropped")
+	}
+	if _, found := s.cachedEnhancement(hashOf(stillOpen)); !found {
+		t.Error("open incident's enhancement should be kept")
+	}
+	if _, found := s.cachedEnhancement("other-replica"); !found {
+		t.Error("an enhancement this server never saw in a feed should be kept")
+	}
+	if got := s.ResolvedEnhancements(); len(got) != 0 {
+		t.Errorf("resolved after dropping = %v, want none", got)
+	}
+}
+
+func TestResolvedEnhancements_OverlappingRefreshes(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(8)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	s := NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+
+	// Enhance every incident, then refresh twice at a time: every incident
+	// is still in the feed, so none may look resolved
+	if _, err := s.refreshRoadData(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for round := 0; round < 5; round++ {
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := s.refreshRoadData(ctx); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+		if got := s.countCachedEnhancements(); got != int64(len(roads)) {
+			t.Fatalf("round %d: %d cached enhancements, want all %d incidents kept", round, got, len(roads))
+		}
+	}
+
+	// An older refresh finishing last doesn't replace a newer one's feed
+	_, older := withRefreshSightings(ctx, time.Now().Add(-time.Minute))
+	s.sightings.completeFeed(older)
+	if got := s.ResolvedEnhancements(); len(got) != 0 {
+		t.Errorf("resolved after a stale refresh completed = %v, want none", got)
+	}
+}
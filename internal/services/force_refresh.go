@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/dpup/prefab/plugins/auth"
+	"github.com/dpup/prefab/plugins/auth/apikey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// AdminKeyPrefix marks the admin API key in the Authorization header:
+// "Authorization: admin_<admin.apiKey>"
+const AdminKeyPrefix = "admin"
+
+// adminSubject is the identity the admin API key authenticates as
+const adminSubject = "admin"
+
+// AdminKeyOwner returns the Prefab API key lookup for the admin key: only
+// apiKey authenticates, as the admin
+func AdminKeyOwner(apiKey string) apikey.KeyFunc {
+	return func(ctx context.Context, key string) (*apikey.KeyOwner, error) {
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+			return nil, errors.New("invalid admin API key")
+		}
+		return &apikey.KeyOwner{UserID: adminSubject, Name: "Admin"}, nil
+	}
+}
+
+// ForceRefresh refreshes road data now, bypassing the periodic interval, and
+// caches the result. Unlike a stale read it doesn't join a refresh already in
+// flight, so the data is fetched after the call. Google Routes results are
+// still reused within googleRoutes.cacheTTL.
+func (s *RoadsService) ForceRefresh(ctx context.Context, req *api.ForceRefreshRequest) (*api.ForceRefreshResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	logging.Info(ctx, "ForceRefresh called")
+
+	start := time.Now()
+	cfg := s.cfg()
+	roads, err := s.refreshRoadData(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "refresh failed: %v", err)
+	}
+	if s.cfg() != cfg {
+		return nil, status.Error(codes.Aborted, "configuration reloaded during refresh; try again")
+	}
+	if err := s.cacheRoads(ctx, roads); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cache roads: %v", err)
+	}
+	duration := time.Since(start)
+
+	var alertCount int
+	for _, road := range roads {
+		alertCount += len(road.Alerts)
+	}
+	logging.Infow(ctx, "Forced road refresh complete",
+		"road_count", len(roads), "alert_count", alertCount, "duration", duration)
+
+	return &api.ForceRefreshResponse{
+		RoadCount:   int32(len(roads)),
+		AlertCount:  int32(alertCount),
+		DurationMs:  duration.Milliseconds(),
+		LastUpdated: timestamppb.Now(),
+	}, nil
+}
+
+// requireAdmin checks the request was authenticated with the admin API key.
+// The admin RPCs are unimplemented while no key is configured.
+func (s *RoadsService) requireAdmin(ctx context.Context) error {
+	if s.cfg().Admin.APIKey == "" {
+		return status.Error(codes.Unimplemented, "admin RPCs are disabled: admin.apiKey is not configured")
+	}
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "admin API key required")
+	}
+	// Prefab also accepts signed session tokens; only the API key is an admin
+	if identity.Provider != apikey.ProviderName || identity.Subject != adminSubject {
+		return status.Error(codes.PermissionDenied, "admin API key required")
+	}
+	return nil
+}
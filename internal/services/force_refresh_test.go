@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"github.com/dpup/prefab/plugins/auth"
+	"github.com/dpup/prefab/plugins/auth/apikey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+func TestForceRefresh(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(3)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.RefreshInterval = time.Hour
+	cfg.Admin.APIKey = "s3cret"
+	s := NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+
+	// Only the admin API key's identity may refresh
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"anonymous", auth.WithIdentityExtractorsForTest(ctx), codes.Unauthenticated},
+		{"session user", auth.WithIdentityForTest(ctx, auth.Identity{Provider: "google", Subject: adminSubject}), codes.PermissionDenied},
+	} {
+		if _, err := s.ForceRefresh(tc.ctx, &api.ForceRefreshRequest{}); status.Code(err) != tc.want {
+			t.Errorf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+	if _, found, _ := s.cache.GetWithMetadata("roads:all", nil); found {
+		t.Fatal("rejected ForceRefresh cached roads")
+	}
+
+	admin := auth.WithIdentityForTest(ctx, auth.Identity{Provider: apikey.ProviderName, Subject: adminSubject})
+	resp, err := s.ForceRefresh(admin, &api.ForceRefreshRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RoadCount != 3 || resp.AlertCount != 3 || resp.LastUpdated == nil {
+		t.Errorf("response = %v, want 3 roads with one alert each", resp)
+	}
+	var cached []*api.Road
+	if found, _ := s.cache.Get("roads:all", &cached); !found || len(cached) != 3 {
+		t.Errorf("cached %d roads (found %v), want the 3 refreshed roads", len(cached), found)
+	}
+	if _, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "road-1"}); err != nil {
+		t.Errorf("GetRoad after refresh: %v", err)
+	}
+
+	// Without a configured key the admin RPCs are off
+	s.config.Admin.APIKey = ""
+	if _, err := s.ForceRefresh(admin, &api.ForceRefreshRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("error without admin.apiKey = %v, want Unimplemented", err)
+	}
+}
+
+func TestAdminKeyOwner(t *testing.T) {
+	ctx := context.Background()
+	if owner, err := AdminKeyOwner("s3cret")(ctx, "s3cret"); err != nil || owner.UserID != adminSubject {
+		t.Errorf("matching key: owner %v, error %v; want the admin", owner, err)
+	}
+	if _, err := AdminKeyOwner("s3cret")(ctx, "guess"); err == nil {
+		t.Error("wrong key was accepted")
+	}
+	if _, err := AdminKeyOwner("")(ctx, ""); err == nil {
+		t.Error("empty key was accepted with no admin key configured")
+	}
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// fakeRoutesAPI answers every Compute Routes call with the same route, or a
+// 503 while down is set
+type fakeRoutesAPI struct {
+	calls atomic.Int32
+	down  atomic.Bool
+}
+
+func (f *fakeRoutesAPI) Do(req *http.Request) (*http.Response, error) {
+	f.calls.Add(1)
+	if f.down.Load() {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("backend error"))}, nil
+	}
+	body := `{"routes":[{"duration":"1500s","staticDuration":"1200s","distanceMeters":20000,"polyline":{"encodedPolyline":"_p~iF~ps|U_ulLnnqC"}}]}`
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func newGoogleRoutesTestService(cacheTTL time.Duration) (*RoadsService, *fakeRoutesAPI) {
+	routesAPI := &fakeRoutesAPI{}
+	cfg := &config.Config{GoogleRoutes: config.GoogleRoutesClient{APIKey: "test-key", CacheTTL: cacheTTL}}
+	s := NewRoadsService(google.NewClientWithHTTPDoer("test-key", "https://routes.example.com", routesAPI), nil, cache.NewCache(), cfg, nil, nil)
+	return s, routesAPI
+}
+
+func TestGetTrafficData_GoogleRoutesCacheTTL(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	road := config.MonitoredRoad{ID: "test-road"}
+
+	for _, tt := range []struct {
+		name     string
+		cacheTTL time.Duration
+		want     time.Duration
+	}{
+		{"default", 0, config.DefaultGoogleRoutesCacheTTL},
+		{"configured", 2 * time.Hour, 2 * time.Hour},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s, routesAPI := newGoogleRoutesTestService(tt.cacheTTL)
+
+			for i := 0; i < 2; i++ {
+				if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, road); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := routesAPI.calls.Load(); got != 1 {
+				t.Errorf("Google Routes called %d times, want 1 with the second call cached", got)
+			}
+
+			entry, found, err := s.cache.GetWithMetadata("google_routes_test-road", nil)
+			if err != nil || !found {
+				t.Fatalf("route data not cached: found=%v err=%v", found, err)
+			}
+			if got := entry.ExpiresAt.Sub(entry.CreatedAt); got != tt.want {
+				t.Errorf("cache TTL = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTrafficData_DailyBudgetServesStaleData(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s, routesAPI := newGoogleRoutesTestService(20 * time.Millisecond)
+	s.googleCalls.limit = 2
+	roadA := config.MonitoredRoad{ID: "road-a"}
+	roadB := config.MonitoredRoad{ID: "road-b"}
+
+	for _, road := range []config.MonitoredRoad{roadA, roadB} {
+		if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, road); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(30 * time.Millisecond) // Both entries expire
+
+	// Budget spent: the expired entry is served without calling Google
+	duration, _, _, _, polyline, err := s.getTrafficDataWithPolyline(ctx, roadA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duration != 25 || polyline == "" {
+		t.Errorf("got duration %d polyline %q, want the stale cached route", duration, polyline)
+	}
+	if got := routesAPI.calls.Load(); got != 2 {
+		t.Errorf("Google Routes called %d times, want 2 (budget)", got)
+	}
+	if got := s.googleCalls.remaining(time.Now()); got != 0 {
+		t.Errorf("remaining budget = %d, want 0", got)
+	}
+
+	// With nothing cached at all there's nothing to serve
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, config.MonitoredRoad{ID: "road-c"}); err == nil {
+		t.Error("expected an error for an uncached road once the budget is spent")
+	}
+}
+
+func TestGetTrafficData_UnavailableServesStaleData(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s, routesAPI := newGoogleRoutesTestService(20 * time.Millisecond)
+	road := config.MonitoredRoad{ID: "test-road"}
+
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, road); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond) // Entry expires
+	routesAPI.down.Store(true)
+
+	duration, _, _, _, polyline, err := s.getTrafficDataWithPolyline(ctx, road)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duration != 25 || polyline == "" {
+		t.Errorf("got duration %d polyline %q, want the stale cached route", duration, polyline)
+	}
+
+	// Nothing cached to fall back on
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, config.MonitoredRoad{ID: "other-road"}); !errors.Is(err, upstream.ErrUpstreamUnavailable) {
+		t.Errorf("err = %v, want ErrUpstreamUnavailable", err)
+	}
+}
+
+func TestGetTrafficData_CircuitBreakerSkipsGoogle(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s, routesAPI := newGoogleRoutesTestService(20 * time.Millisecond)
+	s.googleBreaker.threshold = 2
+	s.googleBreaker.cooldown = time.Hour
+	cachedRoad := config.MonitoredRoad{ID: "cached-road"}
+
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, cachedRoad); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond) // Entry expires
+	routesAPI.down.Store(true)
+
+	// Two failed calls in a row trip the breaker
+	for _, id := range []string{"road-a", "road-b"} {
+		if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, config.MonitoredRoad{ID: id}); err == nil {
+			t.Fatalf("%s: expected an error while Google is down", id)
+		}
+	}
+	if got := routesAPI.calls.Load(); got != 3 {
+		t.Fatalf("Google Routes called %d times, want 3", got)
+	}
+	health, err := s.GetServiceHealth(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if google := health.Sources[0]; !google.Degraded {
+		t.Error("google_routes health not degraded with the breaker open")
+	}
+
+	// Later refreshes serve last-known traffic without calling Google or
+	// spending budget
+	for i := 0; i < 3; i++ {
+		duration, _, _, _, polyline, err := s.getTrafficDataWithPolyline(ctx, cachedRoad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if duration != 25 || polyline == "" {
+			t.Errorf("got duration %d polyline %q, want the stale cached route", duration, polyline)
+		}
+	}
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, config.MonitoredRoad{ID: "road-a"}); !errors.Is(err, upstream.ErrUpstreamUnavailable) {
+		t.Errorf("uncached road err = %v, want ErrUpstreamUnavailable", err)
+	}
+	if got := routesAPI.calls.Load(); got != 3 {
+		t.Errorf("Google Routes called %d times with the breaker open, want 3", got)
+	}
+	if got := s.googleCalls.used(time.Now()); got != 3 {
+		t.Errorf("budget used = %d, want 3", got)
+	}
+
+	// After the cooldown one probe goes through; its success closes the
+	// breaker
+	s.googleBreaker.cooldown = 0
+	s.googleBreaker.openUntil = time.Now()
+	routesAPI.down.Store(false)
+	if _, _, _, _, _, err := s.getTrafficDataWithPolyline(ctx, cachedRoad); err != nil {
+		t.Fatal(err)
+	}
+	if got := routesAPI.calls.Load(); got != 4 {
+		t.Errorf("Google Routes called %d times, want 4 after the probe", got)
+	}
+	if s.googleBreaker.open() {
+		t.Error("breaker still open after a successful probe")
+	}
+}
+
+func TestRefreshRoadData_RouteLength(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(1)
+	cfg := &config.Config{GoogleRoutes: config.GoogleRoutesClient{APIKey: "test-key"}}
+	cfg.Roads.MonitoredRoads = roads
+	s := NewRoadsService(google.NewClientWithHTTPDoer("test-key", "https://routes.example.com", &fakeRoutesAPI{}), parser, cache.NewCache(), cfg, nil, nil)
+
+	result, err := s.refreshRoadData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The fake route's polyline runs from 38.5,-120.2 to 40.7,-120.95,
+	// ~253km, not the ~4km between the road's configured ends
+	if got := result[0].RouteLengthKm; got < 250 || got > 256 {
+		t.Errorf("route length = %.1fkm, want the polyline's ~253km", got)
+	}
+
+	// Without Google geometry there's no route length
+	cfg.GoogleRoutes.APIKey = ""
+	s = NewRoadsService(nil, parser, cache.NewCache(), cfg, nil, nil)
+	if result, err = s.refreshRoadData(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := result[0].RouteLengthKm; got != 0 {
+		t.Errorf("route length without a polyline = %.1fkm, want 0", got)
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
+)
+
+// Upstream data sources reported by GetServiceHealth.
+const (
+	SourceGoogleRoutes = "google_routes"
+	SourceCaltrans     = "caltrans"
+	SourceOpenWeather  = "openweather"
+	SourceNWS          = "nws"
+)
+
+// SourceHealth records the outcome of the most recent fetches from each
+// upstream data source. It is shared by the roads and weather services so a
+// single health report can cover every source. A nil *SourceHealth is valid
+// and records nothing (handy for tests that build services directly).
+type SourceHealth struct {
+	mu      sync.RWMutex
+	sources map[string]sourceStatus
+	metrics *metrics.Metrics // Counts every fetch; may be nil
+}
+
+// sourceStatus is the last-known fetch state of one source.
+type sourceStatus struct {
+	LastSuccess   time.Time
+	LastErrorTime time.Time
+	LastError     string
+	Failing       bool // The most recent fetch failed
+}
+
+// NewSourceHealth creates an empty SourceHealth tracker that also counts
+// fetches in m. m may be nil.
+func NewSourceHealth(m *metrics.Metrics) *SourceHealth {
+	return &SourceHealth{sources: make(map[string]sourceStatus), metrics: m}
+}
+
+// RecordSuccess marks a successful fetch from source.
+func (h *SourceHealth) RecordSuccess(source string) {
+	if h == nil {
+		return
+	}
+	h.metrics.RecordFetch(source, nil)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st := h.sources[source]
+	st.LastSuccess = time.Now()
+	st.Failing = false
+	h.sources[source] = st
+}
+
+// RecordError marks a failed fetch from source.
+func (h *SourceHealth) RecordError(source string, err error) {
+	if h == nil || err == nil {
+		return
+	}
+	h.metrics.RecordFetch(source, err)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st := h.sources[source]
+	st.LastErrorTime = time.Now()
+	st.LastError = err.Error()
+	st.Failing = true
+	h.sources[source] = st
+}
+
+// status returns the recorded state for source (zero value if never fetched).
+func (h *SourceHealth) status(source string) sourceStatus {
+	if h == nil {
+		return sourceStatus{}
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.sources[source]
+}
+
+// GetServiceHealth implements the gRPC method reporting upstream freshness.
+func (s *RoadsService) GetServiceHealth(ctx context.Context, req *api.GetServiceHealthRequest) (*api.GetServiceHealthResponse, error) {
+	logging.Info(ctx, "GetServiceHealth called")
+
+	monitoredRoads := s.cfg().Roads.MonitoredRoads
+	googleKeys := make([]string, 0, len(monitoredRoads))
+	for _, road := range monitoredRoads {
+		googleKeys = append(googleKeys, fmt.Sprintf("google_routes_%s", road.ID))
+	}
+
+	_, roadsCached, _ := s.cache.GetWithMetadata("roads:all", nil)
+
+	googleHealth := s.sourceHealth(SourceGoogleRoutes, googleKeys...)
+	googleHealth.Degraded = s.googleBreaker.open()
+
+	return &api.GetServiceHealthResponse{
+		Sources: []*api.DataSourceHealth{
+			googleHealth,
+			s.sourceHealth(SourceCaltrans, "roads:all"),
+			s.sourceHealth(SourceOpenWeather, "weather:all"),
+			s.sourceHealth(SourceNWS, "nws:alerts"),
+		},
+		Ready:     roadsCached,
+		CheckedAt: timestamppb.Now(),
+	}, nil
+}
+
+// sourceHealth builds the health entry for one source. Staleness is the worst
+// staleness among the cache keys the source populates.
+func (s *RoadsService) sourceHealth(source string, cacheKeys ...string) *api.DataSourceHealth {
+	st := s.health.status(source)
+	out := &api.DataSourceHealth{
+		Source:    source,
+		Healthy:   !st.Failing,
+		Staleness: "unknown",
+		LastError: st.LastError,
+	}
+	if !st.LastSuccess.IsZero() {
+		out.LastSuccess = timestamppb.New(st.LastSuccess)
+	}
+	if !st.LastErrorTime.IsZero() {
+		out.LastErrorTime = timestamppb.New(st.LastErrorTime)
+	}
+
+	for _, key := range cacheKeys {
+		if staleness := cacheStaleness(s.cache, key); stalenessRank[staleness] > stalenessRank[out.Staleness] {
+			out.Staleness = staleness
+		}
+	}
+	return out
+}
+
+// stalenessRank orders staleness labels from best to worst.
+var stalenessRank = map[string]int{
+	"unknown":    0,
+	"fresh":      1,
+	"stale":      2,
+	"very_stale": 3,
+}
+
+// cacheStaleness labels a cache entry "fresh", "stale", or "very_stale", or
+// "unknown" when nothing is cached under key.
+func cacheStaleness(c cache.Store, key string) string {
+	if _, found, _ := c.GetWithMetadata(key, nil); !found {
+		return "unknown"
+	}
+	switch {
+	case !c.IsStale(key):
+		return "fresh"
+	case !c.IsVeryStale(key):
+		return "stale"
+	default:
+		return "very_stale"
+	}
+}
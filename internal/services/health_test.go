@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// TestGetServiceHealth_CaltransErrored verifies a failed Caltrans fetch is
+// reported as unhealthy with its error, while Google stays healthy.
+func TestGetServiceHealth_CaltransErrored(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	c := cache.NewCache()
+	health := NewSourceHealth(nil)
+	s := &RoadsService{
+		cache:  c,
+		health: health,
+		config: &config.Config{Roads: config.RoadsConfig{
+			MonitoredRoads: []config.MonitoredRoad{{ID: "hwy4-angels-murphys"}},
+		}},
+	}
+
+	if err := c.Set("google_routes_hwy4-angels-murphys", "route", time.Hour, "google_routes"); err != nil {
+		t.Fatal(err)
+	}
+	health.RecordSuccess(SourceGoogleRoutes)
+	health.RecordSuccess(SourceCaltrans)
+	health.RecordError(SourceCaltrans, errors.New("lane closures: 503 Service Unavailable"))
+
+	resp, err := s.GetServiceHealth(ctx, &api.GetServiceHealthRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bySource := make(map[string]*api.DataSourceHealth)
+	for _, src := range resp.Sources {
+		bySource[src.Source] = src
+	}
+
+	google := bySource[SourceGoogleRoutes]
+	if google == nil || !google.Healthy {
+		t.Fatalf("google_routes = %+v, want healthy", google)
+	}
+	if google.Staleness != "fresh" {
+		t.Errorf("google_routes staleness = %q, want fresh", google.Staleness)
+	}
+	if google.LastSuccess == nil || google.LastErrorTime != nil {
+		t.Errorf("google_routes last_success=%v last_error_time=%v, want success only", google.LastSuccess, google.LastErrorTime)
+	}
+
+	caltrans := bySource[SourceCaltrans]
+	if caltrans == nil || caltrans.Healthy {
+		t.Fatalf("caltrans = %+v, want unhealthy", caltrans)
+	}
+	if caltrans.LastError != "lane closures: 503 Service Unavailable" {
+		t.Errorf("caltrans last_error = %q", caltrans.LastError)
+	}
+	if caltrans.LastSuccess == nil {
+		t.Error("caltrans should keep its earlier last_success")
+	}
+	if caltrans.Staleness != "unknown" {
+		t.Errorf("caltrans staleness = %q, want unknown (nothing cached)", caltrans.Staleness)
+	}
+
+	if weather := bySource[SourceOpenWeather]; weather == nil || !weather.Healthy || weather.LastSuccess != nil {
+		t.Errorf("openweather = %+v, want never-fetched (healthy, no last_success)", weather)
+	}
+	if resp.Ready {
+		t.Error("ready = true with no roads cached")
+	}
+
+	// A later success clears the unhealthy state.
+	health.RecordSuccess(SourceCaltrans)
+	resp, _ = s.GetServiceHealth(ctx, &api.GetServiceHealthRequest{})
+	for _, src := range resp.Sources {
+		if src.Source == SourceCaltrans && !src.Healthy {
+			t.Error("caltrans still unhealthy after a successful fetch")
+		}
+	}
+}
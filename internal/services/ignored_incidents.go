@@ -0,0 +1,34 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+)
+
+// incidentSeparatorRe matches the hyphens and whitespace runs that vary
+// between reports of one incident type ("Hazard-Animal", "Hazard - Animal")
+var incidentSeparatorRe = regexp.MustCompile(`[\s-]+`)
+
+// normalizedIncidentText is the description roads.ignoredIncidentPatterns
+// match: lowercase, CHP code dropped and abbreviations expanded, hyphens and
+// whitespace collapsed to single spaces
+func normalizedIncidentText(incident caltrans.CaltransIncident) string {
+	text := strings.ToLower(humanizeIncidentType(incident.DescriptionText))
+	return strings.TrimSpace(incidentSeparatorRe.ReplaceAllString(text, " "))
+}
+
+// ignoredIncident reports whether any of patterns matches the incident
+func ignoredIncident(incident caltrans.CaltransIncident, patterns []*regexp.Regexp) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+	text := normalizedIncidentText(incident)
+	for _, re := range patterns {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,7 @@ import (
 	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
 	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
 )
 
 // ListIncidents returns region-wide CHP/Caltrans dispatch incidents for a
@@ -71,7 +72,7 @@ func (s *RoadsService) ListIncidents(ctx context.Context, req *api.ListIncidents
 		return nil, fmt.Errorf("failed to refresh incidents: %w", err)
 	}
 
-	if err := s.cache.Set(cacheKey, incidents, s.config.Roads.CaltransFeeds.CHPIncidents.RefreshInterval, "incidents"); err != nil {
+	if err := s.cache.Set(cacheKey, incidents, s.cfg().Roads.CaltransFeeds.CHPIncidents.RefreshInterval, "incidents"); err != nil {
 		logging.Errorw(ctx, "Failed to cache incidents", "error", err)
 	}
 
@@ -85,7 +86,7 @@ func (s *RoadsService) ListIncidents(ctx context.Context, req *api.ListIncidents
 // resolveIncidentArea looks up a configured area by id. The id is required (it
 // is a path param); there is no default area.
 func (s *RoadsService) resolveIncidentArea(id string) (config.IncidentArea, bool) {
-	for _, a := range s.config.Roads.IncidentAreas {
+	for _, a := range s.cfg().Roads.IncidentAreas {
 		if a.ID == id {
 			return a, true
 		}
@@ -182,15 +183,30 @@ func (s *RoadsService) buildIncident(in caltrans.CaltransIncident, area config.I
 	return inc
 }
 
-// incidentID builds a stable identifier, preferring the CHP log number.
+// incidentIDHasher hashes incidents without a log number into IDs. It keeps
+// full coordinate precision whatever roads.alertHashLocationDecimals is, so
+// nearby closures with the same name don't share an ID.
+var incidentIDHasher = alerts.NewContentHasher()
+
+// incidentID builds an identifier that is stable across fetches, preferring
+// the CHP or lane-closure log number. Without one it is a hash of the
+// incident's content (never its fetch time): unchanged, the incident keeps
+// its ID; once its title, description or location changes it gets a new one.
 func incidentID(in caltrans.CaltransIncident, logNumber string) string {
 	if logNumber != "" {
 		return logNumber
 	}
-	// Fall back to a slug of the name for lane closures without a log number.
-	slug := strings.ToLower(strings.TrimSpace(in.Name))
-	slug = regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(slug, "-")
-	return strings.Trim(slug, "-")
+	raw := alerts.RawAlert{Title: in.Name, Description: in.DescriptionText, StyleUrl: in.StyleUrl}
+	if in.Coordinates != nil {
+		raw.Location = fmt.Sprintf("%.5f, %.5f", in.Coordinates.Latitude, in.Coordinates.Longitude)
+	}
+	hash := incidentIDHasher.HashRawAlert(raw)
+	if hash == "" {
+		// Unset or out-of-range coordinates don't tell incidents apart
+		raw.Location = ""
+		hash = incidentIDHasher.HashRawAlert(raw)
+	}
+	return hash[:16]
 }
 
 // chpCodePrefixRe matches a leading CHP dispatch code (numeric like "1182" or
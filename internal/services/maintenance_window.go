@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// maintenanceMetadataKey marks alerts for closures inside their road's
+// maintenance window
+const maintenanceMetadataKey = "maintenance_window"
+
+// applyMaintenanceWindows marks on-route closures that fall inside one of
+// their road's maintenance windows at now as scheduled maintenance, at INFO
+// severity. A road restricted or closed only by such closures is reported
+// as MAINTENANCE.
+func (s *RoadsService) applyMaintenanceWindows(ctx context.Context, roads []*api.Road, now time.Time) {
+	windowsByRoad := make(map[string][]config.MaintenanceWindow)
+	for _, road := range s.cfg().Roads.MonitoredRoads {
+		if len(road.MaintenanceWindows) > 0 {
+			windowsByRoad[road.ID] = road.MaintenanceWindows
+		}
+	}
+	if len(windowsByRoad) == 0 {
+		return
+	}
+
+	local := now.In(s.maintenanceLocation(ctx))
+	for _, road := range roads {
+		var open []config.MaintenanceWindow
+		for _, window := range windowsByRoad[road.Id] {
+			if window.Contains(local) {
+				open = append(open, window)
+			}
+		}
+		if len(open) == 0 {
+			continue
+		}
+
+		maintenance, unexpected := 0, false
+		for _, alert := range road.Alerts {
+			if alert.Classification != api.AlertClassification_ON_ROUTE {
+				continue
+			}
+			if alert.Type == api.AlertType_CLOSURE && anyWindowMatches(open, alert) {
+				alert.Severity = api.AlertSeverity_INFO
+				if alert.Metadata == nil {
+					alert.Metadata = make(map[string]string)
+				}
+				alert.Metadata[maintenanceMetadataKey] = "true"
+				maintenance++
+			} else if alert.Severity >= api.AlertSeverity_WARNING {
+				unexpected = true
+			}
+		}
+
+		if maintenance > 0 && !unexpected &&
+			(road.Status == api.RoadStatus_RESTRICTED || road.Status == api.RoadStatus_CLOSED) {
+			road.Status = api.RoadStatus_MAINTENANCE
+		}
+		if maintenance > 0 {
+			logging.Infow(ctx, "Closures inside maintenance window", "road_id", road.Id, "closures", maintenance, "status", road.Status)
+		}
+	}
+}
+
+// anyWindowMatches reports whether a closure alert matches one of windows
+func anyWindowMatches(windows []config.MaintenanceWindow, alert *api.RoadAlert) bool {
+	text := strings.Join([]string{alert.Title, alert.Description, alert.CondensedSummary}, " ")
+	for _, window := range windows {
+		if window.Matches(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceLocation is the zone maintenance windows are read in:
+// roads.calendarTimezone, else config.DefaultCalendarTimezone
+func (s *RoadsService) maintenanceLocation(ctx context.Context) *time.Location {
+	zone := s.cfg().Roads.CalendarTimezone
+	if zone == "" {
+		zone = config.DefaultCalendarTimezone
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		logging.Errorw(ctx, "Unknown roads.calendarTimezone, reading maintenance windows in UTC", "zone", zone, "error", err)
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+func TestApplyMaintenanceWindows(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.CalendarTimezone = "America/Los_Angeles"
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{{
+		ID: "hwy4-angels-murphys",
+		MaintenanceWindows: []config.MaintenanceWindow{
+			{Days: []string{"mon", "tue", "wed", "thu"}, Start: "21:00", End: "05:00", Pattern: "lane closure"},
+		},
+	}}
+	s := &RoadsService{config: cfg}
+
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	road := func(extra ...*api.RoadAlert) *api.Road {
+		return &api.Road{
+			Id:     "hwy4-angels-murphys",
+			Status: api.RoadStatus_RESTRICTED,
+			Alerts: append([]*api.RoadAlert{{
+				Type:           api.AlertType_CLOSURE,
+				Severity:       api.AlertSeverity_WARNING,
+				Classification: api.AlertClassification_ON_ROUTE,
+				Title:          "Lane Closure C4MA",
+				Description:    "Right lane closed for pavement work",
+			}}, extra...),
+		}
+	}
+
+	for _, tt := range []struct {
+		name         string
+		now          time.Time
+		extra        []*api.RoadAlert
+		wantStatus   api.RoadStatus
+		wantSeverity api.AlertSeverity
+	}{
+		{"Tuesday night", time.Date(2026, 10, 13, 23, 30, 0, 0, pacific), nil, api.RoadStatus_MAINTENANCE, api.AlertSeverity_INFO},
+		{"Wednesday before dawn", time.Date(2026, 10, 14, 4, 0, 0, 0, pacific), nil, api.RoadStatus_MAINTENANCE, api.AlertSeverity_INFO},
+		{"Tuesday afternoon", time.Date(2026, 10, 13, 14, 0, 0, 0, pacific), nil, api.RoadStatus_RESTRICTED, api.AlertSeverity_WARNING},
+		{"Friday night", time.Date(2026, 10, 16, 23, 30, 0, 0, pacific), nil, api.RoadStatus_RESTRICTED, api.AlertSeverity_WARNING},
+		{"with an unexpected incident", time.Date(2026, 10, 13, 23, 30, 0, 0, pacific), []*api.RoadAlert{{
+			Type:           api.AlertType_INCIDENT,
+			Severity:       api.AlertSeverity_CRITICAL,
+			Classification: api.AlertClassification_ON_ROUTE,
+			Title:          "CHP Incident 261013ST0001",
+		}}, api.RoadStatus_RESTRICTED, api.AlertSeverity_INFO},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := road(tt.extra...)
+			s.applyMaintenanceWindows(ctx, []*api.Road{r}, tt.now.UTC())
+			if r.Status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", r.Status, tt.wantStatus)
+			}
+			closure := r.Alerts[0]
+			if closure.Severity != tt.wantSeverity {
+				t.Errorf("closure severity = %v, want %v", closure.Severity, tt.wantSeverity)
+			}
+			if inWindow := closure.Metadata[maintenanceMetadataKey] == "true"; inWindow != (tt.wantSeverity == api.AlertSeverity_INFO) {
+				t.Errorf("closure metadata = %v", closure.Metadata)
+			}
+		})
+	}
+
+	// A closure the window's pattern doesn't match is unexpected
+	r := road()
+	r.Alerts[0].Title = "Full Closure"
+	r.Alerts[0].Description = "Bridge inspection"
+	s.applyMaintenanceWindows(ctx, []*api.Road{r}, time.Date(2026, 10, 13, 23, 30, 0, 0, pacific))
+	if r.Status != api.RoadStatus_RESTRICTED || r.Alerts[0].Severity != api.AlertSeverity_WARNING {
+		t.Errorf("unmatched closure: status %v, severity %v", r.Status, r.Alerts[0].Severity)
+	}
+}
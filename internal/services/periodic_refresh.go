@@ -3,49 +3,58 @@ package services
 import (
 	"context"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/dpup/prefab/errors"
 	"github.com/dpup/prefab/logging"
-	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
 )
 
 // PeriodicRefreshService simulates regular API requests to maintain cache warmth
 // Replaces complex CacheWarmer with simple periodic calls to existing refresh logic
 type PeriodicRefreshService struct {
 	roadsService *RoadsService
-	config       *config.Config
-	
-	// Background refresh control
+
+	// Background refresh control: stopChan is closed to stop the running
+	// loop, and nil when none is running; cancel cancels the loop's context,
+	// and with it a refresh in progress; done is closed once the loop has
+	// exited
+	mutex    sync.Mutex
 	stopChan chan struct{}
-	running  bool
+	cancel   context.CancelFunc
+	done     chan struct{}
 }
 
 // NewPeriodicRefreshService creates a new periodic refresh service
-func NewPeriodicRefreshService(roadsService *RoadsService, config *config.Config) *PeriodicRefreshService {
-	return &PeriodicRefreshService{
-		roadsService: roadsService,
-		config:       config,
-		stopChan:     make(chan struct{}),
-	}
+func NewPeriodicRefreshService(roadsService *RoadsService) *PeriodicRefreshService {
+	return &PeriodicRefreshService{roadsService: roadsService}
 }
 
 // StartPeriodicRefresh begins simulated API requests to maintain cache freshness
-// Uses existing refresh intervals from configuration
+// Uses the roads refresh interval in effect when it starts
 func (p *PeriodicRefreshService) StartPeriodicRefresh(ctx context.Context) error {
-	if p.running {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.stopChan != nil {
 		return nil // Already running
 	}
 	
-	p.running = true
+	stop, done := make(chan struct{}), make(chan struct{})
+	loopCtx, cancel := context.WithCancel(ctx)
+	p.stopChan, p.cancel, p.done = stop, cancel, done
 	
 	// Use roads refresh interval from config (default 5 minutes)
-	interval := p.config.Roads.RefreshInterval
+	roadsCfg := p.roadsService.cfg().Roads
+	interval := roadsCfg.RefreshInterval
 	
-	logging.Infow(ctx, "Starting periodic refresh", "interval", interval)
+	logging.Infow(ctx, "Starting periodic refresh", "interval", interval,
+		"jitter", roadsCfg.RefreshJitter, "stagger", roadsCfg.RefreshStagger)
 	
 	// Start background goroutine for periodic refresh
 	go func() {
+		defer close(done)
+		defer cancel()
 		defer func() {
 			// Recover from any panics in the periodic refresh goroutine
 			if r := recover(); r != nil {
@@ -55,29 +64,52 @@ func (p *PeriodicRefreshService) StartPeriodicRefresh(ctx context.Context) error
 				logging.Errorw(ctx, "Periodic refresh: recovered from panic",
 					"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
 			}
-			// Mark as not running when goroutine exits
-			p.running = false
+			// Mark as not running when goroutine exits, unless a restart
+			// already replaced this loop
+			p.mutex.Lock()
+			if p.stopChan == stop {
+				p.stopChan = nil
+			}
+			p.mutex.Unlock()
 		}()
 
-		p.refreshLoop(ctx, interval)
+		p.refreshLoop(loopCtx, interval, roadsCfg.RefreshJitter, stop)
 	}()
 	
 	return nil
 }
 
-// Stop gracefully stops the periodic refresh
+// Stop gracefully stops the periodic refresh. A refresh in progress is cancelled,
+// caching nothing, and Stop returns once the loop has exited.
 func (p *PeriodicRefreshService) Stop() {
-	if !p.running {
+	p.mutex.Lock()
+	if p.stopChan == nil {
+		p.mutex.Unlock()
 		return
 	}
 
-	p.running = false
+	// The loop logs its own shutdown; context.Background() carries no logger
 	close(p.stopChan)
-	logging.Info(context.Background(), "Stopped periodic refresh service")
+	p.cancel()
+	p.stopChan = nil
+	done := p.done
+	p.mutex.Unlock()
+
+	// The loop's exit takes the mutex, so wait without holding it
+	<-done
+}
+
+// Restart stops the periodic refresh, cancelling a refresh in progress, and
+// starts it again on the current refresh interval, refreshing immediately
+func (p *PeriodicRefreshService) Restart(ctx context.Context) error {
+	p.Stop()
+	return p.StartPeriodicRefresh(ctx)
 }
 
-// refreshLoop runs the periodic refresh in background
-func (p *PeriodicRefreshService) refreshLoop(ctx context.Context, interval time.Duration) {
+// refreshLoop runs the periodic refresh in background until stop is closed.
+// Each refresh after the first waits a random delay up to jitter past its
+// tick.
+func (p *PeriodicRefreshService) refreshLoop(ctx context.Context, interval, jitter time.Duration, stop <-chan struct{}) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 	
@@ -89,10 +121,19 @@ func (p *PeriodicRefreshService) refreshLoop(ctx context.Context, interval time.
 		case <-ctx.Done():
 			logging.Info(ctx, "Periodic refresh stopping due to context cancellation")
 			return
-		case <-p.stopChan:
+		case <-stop:
 			logging.Info(ctx, "Periodic refresh stopping due to stop signal")
 			return
 		case <-ticker.C:
+			if delay := jitterDelay(jitter); delay > 0 {
+				select {
+				case <-ctx.Done():
+					continue
+				case <-stop:
+					continue
+				case <-time.After(delay):
+				}
+			}
 			p.refreshCacheData(ctx)
 		}
 	}
@@ -100,23 +141,37 @@ func (p *PeriodicRefreshService) refreshLoop(ctx context.Context, interval time.
 
 // refreshCacheData directly refreshes the cached road data
 func (p *PeriodicRefreshService) refreshCacheData(ctx context.Context) {
+	// Correlate this refresh's log lines the way a request's are
+	ctx = requestid.With(ctx, requestid.New())
 	logging.Info(ctx, "Periodic refresh: starting data refresh")
 
 	// Create a timeout context for the refresh operation
-	// Allow 5 minutes for processing multiple roads sequentially (4 roads × ~30s each + buffer)
-	refreshCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	// Allow 5 minutes for processing multiple roads sequentially (4 roads × ~30s each + buffer),
+	// plus the time the Google Routes lookups are staggered across
+	cfg := p.roadsService.cfg()
+	stagger := cfg.Roads.RefreshStagger
+	refreshCtx, cancel := context.WithTimeout(ctx, 5*time.Minute+stagger)
 	defer cancel()
 
 	// Call the road service refresh method directly
-	roads, err := p.roadsService.refreshRoadData(refreshCtx)
+	roads, err := p.roadsService.refreshRoadDataStaggered(refreshCtx, stagger)
+	if ctx.Err() != nil {
+		// Failed feeds don't fail the refresh, so check for the stop itself
+		logging.Info(ctx, "Periodic refresh: stopped during refresh, discarding roads")
+		return
+	}
 	if err != nil {
 		logging.Errorw(ctx, "Periodic refresh: failed to refresh road data", "error", err)
 		return
 	}
+	if p.roadsService.cfg() != cfg {
+		// The restarted refresh caches the reloaded road set
+		logging.Info(ctx, "Periodic refresh: configuration reloaded during refresh, discarding roads")
+		return
+	}
 
-	// Cache the refreshed data
-	cacheKey := "roads:all"
-	if err := p.roadsService.cache.Set(cacheKey, roads, p.config.Roads.RefreshInterval, "roads"); err != nil {
+	// Cache the refreshed data (and push it to any streaming clients if changed)
+	if err := p.roadsService.cacheRoads(ctx, roads); err != nil {
 		logging.Errorw(ctx, "Periodic refresh: failed to cache roads", "error", err)
 	} else {
 		logging.Infow(ctx, "Periodic refresh: successfully cached roads", "road_count", len(roads))
@@ -125,5 +180,7 @@ func (p *PeriodicRefreshService) refreshCacheData(ctx context.Context) {
 
 // IsRunning returns whether periodic refresh is active
 func (p *PeriodicRefreshService) IsRunning() bool {
-	return p.running
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.stopChan != nil
 }
\ No newline at end of file
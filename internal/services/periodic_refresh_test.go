@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// stalledDoer holds every request until its context ends, like an upstream
+// that stopped responding
+type stalledDoer struct {
+	started  chan struct{}
+	once     sync.Once
+	inFlight atomic.Int32
+}
+
+func (d *stalledDoer) Do(req *http.Request) (*http.Response, error) {
+	d.inFlight.Add(1)
+	defer d.inFlight.Add(-1)
+	d.once.Do(func() { close(d.started) })
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestPeriodicRefreshService_StopCancelsRefresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.EnsureLogger(context.Background()))
+	defer cancel()
+	roads, _ := concurrencyFixture(1)
+	doer := &stalledDoer{started: make(chan struct{})}
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.RefreshInterval = time.Hour // only the immediate first tick runs
+	store := cache.NewCache()
+	refresher := NewPeriodicRefreshService(NewRoadsService(nil, &caltrans.FeedParser{HTTPClient: doer}, store, cfg, nil, nil))
+
+	if err := refresher.StartPeriodicRefresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-doer.started
+
+	// The refresh would otherwise stall until its 5 minute timeout
+	stopped := make(chan struct{})
+	go func() {
+		refresher.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop waited out a stalled refresh instead of cancelling it")
+	}
+	if got := doer.inFlight.Load(); got != 0 {
+		t.Errorf("%d requests still in flight after Stop returned", got)
+	}
+	if !store.IsStale("roads:all") {
+		t.Error("a cancelled refresh cached roads")
+	}
+	if refresher.IsRunning() {
+		t.Error("refresher should not be running after Stop")
+	}
+}
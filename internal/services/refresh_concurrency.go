@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// refreshConcurrency returns how many roads a refresh processes at once
+func (s *RoadsService) refreshConcurrency() int {
+	if cfg := s.cfg(); cfg != nil && cfg.Roads.RefreshConcurrency > 0 {
+		return cfg.Roads.RefreshConcurrency
+	}
+	return config.DefaultRefreshConcurrency
+}
+
+// forEachConcurrently calls fn(i) for i in [0, n) on at most limit goroutines
+// and waits for every call to return. A panicking call is logged and treated
+// as finished so one bad road can't take down the refresh.
+func forEachConcurrently(ctx context.Context, n, limit int, fn func(i int)) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	slots := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					err, _ := errors.ParseStack(debug.Stack())
+					skipFrames := 3
+					numFrames := 5
+					logging.Errorw(ctx, "Road refresh worker: recovered from panic",
+						"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+				}
+				<-slots
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
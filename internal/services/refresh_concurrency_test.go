@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+)
+
+// slowEnhancer takes a while per alert and records peak concurrent calls
+type slowEnhancer struct {
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (e *slowEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	n := e.inFlight.Add(1)
+	defer e.inFlight.Add(-1)
+	for {
+		peak := e.peak.Load()
+		if n <= peak || e.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	return alerts.EnhancedAlert{ID: raw.ID, OriginalDescription: raw.Description, CondensedSummary: raw.Title}, nil
+}
+
+func (e *slowEnhancer) HealthCheck(ctx context.Context) error { return nil }
+
+// concurrencyFixture returns roads spaced 0.1° apart with one CHP incident on
+// each, and a parser serving those incidents
+func concurrencyFixture(numRoads int) ([]config.MonitoredRoad, *caltrans.FeedParser) {
+	var roads []config.MonitoredRoad
+	var placemarks strings.Builder
+	for i := 0; i < numRoads; i++ {
+		lat := 38.0 + float64(i)*0.1
+		roads = append(roads, config.MonitoredRoad{
+			ID:          fmt.Sprintf("road-%d", i),
+			Name:        fmt.Sprintf("Test Road %d", i),
+			Origin:      config.Coordinates{Latitude: lat, Longitude: -120.0},
+			Destination: config.Coordinates{Latitude: lat, Longitude: -120.05},
+		})
+		fmt.Fprintf(&placemarks, `<Placemark><name>CHP Incident 251016ST%04d</name>
+<description>Oct 16 2025 9:%02dAM 1125-Traffic Hazard on road %d</description>
+<Point><coordinates>-120.02,%f,0</coordinates></Point></Placemark>`, i, i, i, lat)
+	}
+	kml := `<kml><Document>` + placemarks.String() + `</Document></kml>`
+	parser := &caltrans.FeedParser{HTTPClient: kmlFeeds{
+		caltrans.DefaultCHPIncidentsURL:  kml,
+		caltrans.DefaultLaneClosuresURL:  `<kml><Document></Document></kml>`,
+		caltrans.DefaultChainControlsURL: `<kml><Document></Document></kml>`,
+	}}
+	return roads, parser
+}
+
+func TestRefreshRoadData_Concurrent(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(8)
+
+	refresh := func(concurrency int) ([]string, *slowEnhancer) {
+		cfg := &config.Config{}
+		cfg.Roads.MonitoredRoads = roads
+		cfg.Roads.RefreshConcurrency = concurrency
+		enhancer := &slowEnhancer{}
+		s := NewRoadsService(nil, parser, cache.NewCache(), cfg, enhancer, nil)
+
+		result, err := s.refreshRoadData(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var summary []string
+		for _, road := range result {
+			var alertTitles []string
+			for _, alert := range road.Alerts {
+				alertTitles = append(alertTitles, alert.Title)
+			}
+			summary = append(summary, road.Id+": "+strings.Join(alertTitles, ","))
+		}
+		return summary, enhancer
+	}
+
+	sequential, enhancer := refresh(1)
+	if peak := enhancer.peak.Load(); peak != 1 {
+		t.Errorf("sequential refresh peaked at %d concurrent enhancements, want 1", peak)
+	}
+	if len(sequential) != len(roads) {
+		t.Fatalf("got %d roads, want %d", len(sequential), len(roads))
+	}
+	for i, line := range sequential {
+		want := fmt.Sprintf("road-%d: CHP Incident 251016ST%04d", i, i)
+		if line != want {
+			t.Errorf("road %d = %q, want %q", i, line, want)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, concurrency := range []int{3, 8} {
+		wg.Add(1)
+		go func(concurrency int) {
+			defer wg.Done()
+			concurrent, enhancer := refresh(concurrency)
+			if peak := enhancer.peak.Load(); peak > int32(concurrency) || peak < 2 {
+				t.Errorf("concurrency %d: peaked at %d concurrent enhancements", concurrency, peak)
+			}
+			if strings.Join(concurrent, "\n") != strings.Join(sequential, "\n") {
+				t.Errorf("concurrency %d: roads differ from sequential refresh:\n%s", concurrency, strings.Join(concurrent, "\n"))
+			}
+		}(concurrency)
+	}
+	wg.Wait()
+}
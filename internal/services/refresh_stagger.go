@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// staggerOffset is when, within window, the i-th of n staggered calls starts:
+// evenly spaced, the first at once
+func staggerOffset(window time.Duration, i, n int) time.Duration {
+	if window <= 0 || n <= 1 {
+		return 0
+	}
+	return window * time.Duration(i) / time.Duration(n)
+}
+
+// jitterDelay is a random delay in [0, maxJitter), or zero without jitter
+func jitterDelay(maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	return rand.N(maxJitter)
+}
+
+// sleepUntil waits until t, returning early if ctx is done
+func sleepUntil(ctx context.Context, t time.Time) {
+	d := time.Until(t)
+	if d <= 0 {
+		return
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
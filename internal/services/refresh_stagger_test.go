@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// timedRoutesAPI records when each Compute Routes call was made
+type timedRoutesAPI struct {
+	mutex sync.Mutex
+	calls []time.Time
+}
+
+func (f *timedRoutesAPI) Do(req *http.Request) (*http.Response, error) {
+	f.mutex.Lock()
+	f.calls = append(f.calls, time.Now())
+	f.mutex.Unlock()
+	body := `{"routes":[{"duration":"1500s","staticDuration":"1200s","distanceMeters":20000,"polyline":{"encodedPolyline":"_p~iF~ps|U_ulLnnqC"}}]}`
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestRefreshRoadData_Staggered(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	roads, parser := concurrencyFixture(4)
+
+	// callOffsets refreshes with stagger and returns when each road's Google
+	// Routes call started, relative to the first
+	callOffsets := func(stagger time.Duration) []time.Duration {
+		routesAPI := &timedRoutesAPI{}
+		cfg := &config.Config{GoogleRoutes: config.GoogleRoutesClient{APIKey: "test-key"}}
+		cfg.Roads.MonitoredRoads = roads
+		s := NewRoadsService(google.NewClientWithHTTPDoer("test-key", "https://routes.example.com", routesAPI), parser, cache.NewCache(), cfg, nil, nil)
+		if _, err := s.refreshRoadDataStaggered(ctx, stagger); err != nil {
+			t.Fatal(err)
+		}
+		if len(routesAPI.calls) != len(roads) {
+			t.Fatalf("got %d Google Routes calls, want %d", len(routesAPI.calls), len(roads))
+		}
+		sort.Slice(routesAPI.calls, func(i, j int) bool { return routesAPI.calls[i].Before(routesAPI.calls[j]) })
+		var offsets []time.Duration
+		for _, call := range routesAPI.calls {
+			offsets = append(offsets, call.Sub(routesAPI.calls[0]))
+		}
+		return offsets
+	}
+
+	// Unstaggered, every road's call goes out together
+	if last := callOffsets(0)[len(roads)-1]; last > 50*time.Millisecond {
+		t.Errorf("unstaggered calls spread over %s, want them together", last)
+	}
+
+	// Staggered across 400ms, a call starts about every 100ms
+	offsets := callOffsets(400 * time.Millisecond)
+	for i, offset := range offsets {
+		if want := time.Duration(i) * 100 * time.Millisecond; offset < want-10*time.Millisecond {
+			t.Errorf("call %d started at %s, want no earlier than %s", i, offset, want)
+		}
+	}
+}
+
+func TestJitterDelay(t *testing.T) {
+	if d := jitterDelay(0); d != 0 {
+		t.Errorf("jitterDelay(0) = %s, want 0", d)
+	}
+	for i := 0; i < 100; i++ {
+		if d := jitterDelay(time.Second); d < 0 || d >= time.Second {
+			t.Fatalf("jitterDelay(1s) = %s, want within [0, 1s)", d)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+)
+
+// logLine is one captured log call with the fields in scope
+type logLine struct {
+	msg    string
+	fields map[string]any
+}
+
+// captureLog records every line written through its loggers
+type captureLog struct {
+	mu    sync.Mutex
+	lines []logLine
+}
+
+func (c *captureLog) snapshot() []logLine {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]logLine(nil), c.lines...)
+}
+
+// captureLogger is a logging.Logger writing to a captureLog
+type captureLogger struct {
+	log    *captureLog
+	fields map[string]any
+}
+
+func (l *captureLogger) write(msg string, keysAndValues ...any) {
+	fields := make(map[string]any, len(l.fields))
+	for k, v := range l.fields {
+		fields[k] = v
+	}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	l.log.mu.Lock()
+	defer l.log.mu.Unlock()
+	l.log.lines = append(l.log.lines, logLine{msg: msg, fields: fields})
+}
+
+func (l *captureLogger) Debug(args ...any)                { l.write(fmt.Sprint(args...)) }
+func (l *captureLogger) Debugw(msg string, kv ...any)     { l.write(msg, kv...) }
+func (l *captureLogger) Debugf(msg string, args ...any)   { l.write(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Info(args ...any)                 { l.write(fmt.Sprint(args...)) }
+func (l *captureLogger) Infow(msg string, kv ...any)      { l.write(msg, kv...) }
+func (l *captureLogger) Infof(msg string, args ...any)    { l.write(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Warn(args ...any)                 { l.write(fmt.Sprint(args...)) }
+func (l *captureLogger) Warnw(msg string, kv ...any)      { l.write(msg, kv...) }
+func (l *captureLogger) Warnf(msg string, args ...any)    { l.write(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Error(args ...any)                { l.write(fmt.Sprint(args...)) }
+func (l *captureLogger) Errorw(msg string, kv ...any)     { l.write(msg, kv...) }
+func (l *captureLogger) Errorf(msg string, args ...any)   { l.write(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Panic(args ...any)                { panic(fmt.Sprint(args...)) }
+func (l *captureLogger) Panicw(msg string, kv ...any)     { panic(msg) }
+func (l *captureLogger) Panicf(msg string, args ...any)   { panic(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Fatal(args ...any)                { panic(fmt.Sprint(args...)) }
+func (l *captureLogger) Fatalw(msg string, kv ...any)     { panic(msg) }
+func (l *captureLogger) Fatalf(msg string, args ...any)   { panic(fmt.Sprintf(msg, args...)) }
+func (l *captureLogger) Named(name string) logging.Logger { return l }
+func (l *captureLogger) With(field string, value any) logging.Logger {
+	fields := map[string]any{field: value}
+	for k, v := range l.fields {
+		if k != field {
+			fields[k] = v
+		}
+	}
+	return &captureLogger{log: l.log, fields: fields}
+}
+
+// serveUnary runs handler behind the request ID interceptor the way a gRPC
+// request is served: in its own logging scope, with incoming metadata md
+func serveUnary(ctx context.Context, md metadata.MD, handler grpc.UnaryHandler) (any, error) {
+	ctx = logging.With(ctx, logging.FromContext(ctx).Named("request"))
+	ctx = metadata.NewIncomingContext(ctx, md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.v1.RoadsService/ListRoads"}
+	return requestid.UnaryServerInterceptor(ctx, &api.ListRoadsRequest{}, info, handler)
+}
+
+// linesWithout returns the messages of lines whose request_id isn't want
+func linesWithout(lines []logLine, want string) []string {
+	var bad []string
+	for _, line := range lines {
+		if line.fields[requestid.Field] != want {
+			bad = append(bad, fmt.Sprintf("%q (request_id=%v)", line.msg, line.fields[requestid.Field]))
+		}
+	}
+	return bad
+}
+
+// messages returns the set of logged messages
+func messages(lines []logLine) map[string]bool {
+	out := make(map[string]bool)
+	for _, line := range lines {
+		out[line.msg] = true
+	}
+	return out
+}
+
+func newRequestLoggingService(t *testing.T) *RoadsService {
+	t.Helper()
+	roads, parser := concurrencyFixture(1)
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = roads
+	cfg.Roads.RefreshInterval = time.Hour
+	return NewRoadsService(nil, parser, cache.NewCache(), cfg, &slowEnhancer{}, nil)
+}
+
+func TestListRoads_LogsCarryRequestID(t *testing.T) {
+	captured := &captureLog{}
+	ctx := logging.With(context.Background(), &captureLogger{log: captured})
+	s := newRequestLoggingService(t)
+
+	// Cold cache: ListRoads refreshes, classifies and enhances inline
+	md := metadata.Pairs("x-request-id", "req-1234")
+	_, err := serveUnary(ctx, md, func(ctx context.Context, req any) (any, error) {
+		return s.ListRoads(ctx, req.(*api.ListRoadsRequest))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := captured.snapshot()
+	if bad := linesWithout(lines, "req-1234"); len(bad) > 0 {
+		t.Errorf("lines without the request's ID: %v", bad)
+	}
+	logged := messages(lines)
+	for _, want := range []string{
+		"ListRoads called",
+		"No cached data available - performing fallback refresh",
+		"Cache miss for alert content hash - calling OpenAI",
+		"Cached enhanced alert",
+	} {
+		if !logged[want] {
+			t.Errorf("missing log line %q", want)
+		}
+	}
+}
+
+func TestListRoads_BackgroundEnhancementKeepsRequestID(t *testing.T) {
+	captured := &captureLog{}
+	ctx, cancel := context.WithCancel(logging.With(context.Background(), &captureLogger{log: captured}))
+	defer cancel()
+	s := newRequestLoggingService(t)
+	s.StartBackgroundEnhancement(ctx, 1)
+
+	// No ID sent: one is generated and follows the alert into the worker
+	_, err := serveUnary(ctx, metadata.MD{}, func(ctx context.Context, req any) (any, error) {
+		return s.ListRoads(ctx, req.(*api.ListRoadsRequest))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !messages(captured.snapshot())["Cached enhanced alert"] && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var id any
+	var pipeline []logLine
+	for _, line := range captured.snapshot() {
+		if line.msg == "ListRoads called" {
+			id = line.fields[requestid.Field]
+		}
+		if line.msg != "Starting background alert enhancement" {
+			pipeline = append(pipeline, line)
+		}
+	}
+	generated, _ := id.(string)
+	if len(generated) != 16 {
+		t.Fatalf("request_id = %v, want a generated 16-character ID", id)
+	}
+	if bad := linesWithout(pipeline, generated); len(bad) > 0 {
+		t.Errorf("lines without the request's ID: %v", bad)
+	}
+	logged := messages(pipeline)
+	for _, want := range []string{"Queued alert for background enhancement", "Cached enhanced alert"} {
+		if !logged[want] {
+			t.Errorf("missing log line %q", want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// alertFilter selects the alerts roads are returned with, from the
+// min_severity and classification request parameters. The zero value keeps
+// every alert.
+type alertFilter struct {
+	minSeverity     api.AlertSeverity
+	classifications map[api.AlertClassification]bool // nil keeps every classification
+}
+
+// newAlertFilter builds the filter for a request's parameters
+func newAlertFilter(minSeverity api.AlertSeverity, classifications []api.AlertClassification) alertFilter {
+	f := alertFilter{minSeverity: minSeverity}
+	if len(classifications) > 0 {
+		f.classifications = make(map[api.AlertClassification]bool, len(classifications))
+		for _, c := range classifications {
+			f.classifications[c] = true
+		}
+	}
+	return f
+}
+
+// keep reports whether alert passes the filter
+func (f alertFilter) keep(alert *api.RoadAlert) bool {
+	if alert.Severity < f.minSeverity {
+		return false
+	}
+	return f.classifications == nil || f.classifications[alert.Classification]
+}
+
+// apply returns road with only the alerts f keeps. Roads can be shared with
+// the cache and stream subscribers, so a road that loses alerts is copied
+// rather than changed.
+func (f alertFilter) apply(road *api.Road) *api.Road {
+	if f.minSeverity == api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED && f.classifications == nil {
+		return road
+	}
+	var kept []*api.RoadAlert
+	for _, alert := range road.Alerts {
+		if f.keep(alert) {
+			kept = append(kept, alert)
+		}
+	}
+	if len(kept) == len(road.Alerts) {
+		return road
+	}
+	filtered := proto.Clone(road).(*api.Road)
+	filtered.Alerts = kept
+	return filtered
+}
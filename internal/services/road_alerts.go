@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// ListAllAlerts implements the gRPC method returning one flat alert feed across
+// every monitored road, so clients showing a single incident list don't have
+// to flatten and dedupe ListRoads themselves.
+func (s *RoadsService) ListAllAlerts(ctx context.Context, req *api.ListAllAlertsRequest) (*api.ListAllAlertsResponse, error) {
+	logging.Info(ctx, "ListAllAlerts called")
+
+	listResp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roads: %w", err)
+	}
+
+	return &api.ListAllAlertsResponse{
+		Alerts:      s.aggregateRoadAlerts(ctx, listResp.Roads),
+		LastUpdated: listResp.LastUpdated,
+	}, nil
+}
+
+// aggregateRoadAlerts merges the per-road alert lists into one entry per
+// distinct alert, listing every road it applies to. Road membership goes
+// through deduplicateAlerts, so an alert that is ON_ROUTE for one road is not
+// also attributed to roads where it is merely NEARBY.
+func (s *RoadsService) aggregateRoadAlerts(ctx context.Context, roads []*api.Road) []*api.AggregatedRoadAlert {
+	var classifications []globalAlertClassification
+	representative := make(map[string]*api.RoadAlert)
+	roadOrder := make(map[string]int, len(roads))
+
+	for i, road := range roads {
+		roadOrder[road.Id] = i
+		for _, alert := range road.Alerts {
+			key := roadAlertKey(alert)
+			if current, ok := representative[key]; !ok || moreDirectAlert(alert, current) {
+				representative[key] = alert
+			}
+			classifications = append(classifications, globalAlertClassification{
+				AlertID: key,
+				RouteID: road.Id,
+				ClassifiedAlert: routing.ClassifiedAlert{
+					UnclassifiedAlert: routing.UnclassifiedAlert{ID: key, Title: alert.Title},
+					Classification:    apiToRoutingClassification(alert.Classification),
+					DistanceToRoute:   alert.DistanceToRouteMeters,
+				},
+			})
+		}
+	}
+
+	roadIDs := make(map[string][]string)
+	for routeID, routeAlerts := range s.deduplicateAlerts(ctx, classifications) {
+		seen := make(map[string]bool)
+		for _, alert := range routeAlerts {
+			if seen[alert.ID] {
+				continue
+			}
+			seen[alert.ID] = true
+			roadIDs[alert.ID] = append(roadIDs[alert.ID], routeID)
+		}
+	}
+
+	aggregated := make([]*api.AggregatedRoadAlert, 0, len(roadIDs))
+	for key, ids := range roadIDs {
+		sort.Slice(ids, func(i, j int) bool { return roadOrder[ids[i]] < roadOrder[ids[j]] })
+		aggregated = append(aggregated, &api.AggregatedRoadAlert{
+			Alert:   representative[key],
+			RoadIds: ids,
+		})
+	}
+
+	// Most severe first, then closest to a route; title keeps ties stable.
+	sort.Slice(aggregated, func(i, j int) bool {
+		a, b := aggregated[i].Alert, aggregated[j].Alert
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.DistanceToRouteMeters != b.DistanceToRouteMeters {
+			return a.DistanceToRouteMeters < b.DistanceToRouteMeters
+		}
+		return a.Title < b.Title
+	})
+
+	return aggregated
+}
+
+// roadAlertKey identifies the same alert across roads: the stable CHP/closure
+// id when present, otherwise its title and text (road conditions have no id).
+func roadAlertKey(alert *api.RoadAlert) string {
+	if alert.Id != "" {
+		return alert.Id
+	}
+	return alert.Title + "|" + alert.Description
+}
+
+// moreDirectAlert reports whether a is a better representative than b: a more
+// direct classification (ON_ROUTE over NEARBY), then the shorter distance.
+func moreDirectAlert(a, b *api.RoadAlert) bool {
+	if classificationRank(a.Classification) != classificationRank(b.Classification) {
+		return classificationRank(a.Classification) < classificationRank(b.Classification)
+	}
+	return a.DistanceToRouteMeters < b.DistanceToRouteMeters
+}
+
+// classificationRank orders classifications from most to least direct.
+func classificationRank(c api.AlertClassification) int {
+	switch c {
+	case api.AlertClassification_ON_ROUTE:
+		return 0
+	case api.AlertClassification_NEARBY:
+		return 1
+	case api.AlertClassification_DISTANT:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// apiToRoutingClassification is the inverse of mapRoutingToAPIClassification.
+func apiToRoutingClassification(c api.AlertClassification) routing.AlertClassification {
+	switch c {
+	case api.AlertClassification_ON_ROUTE:
+		return routing.OnRoute
+	case api.AlertClassification_NEARBY:
+		return routing.Nearby
+	default:
+		return routing.Distant
+	}
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// TestListAllAlerts_DedupesAcrossRoads verifies an alert ON_ROUTE for two
+// adjoining segments appears once listing both roads, an alert NEARBY on one
+// road but ON_ROUTE on another is attributed only to the ON_ROUTE road, and
+// the feed is sorted by severity then distance.
+func TestListAllAlerts_DedupesAcrossRoads(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	// Collision at Murphys, where the two Hwy 4 segments meet.
+	shared := func() *api.RoadAlert {
+		return &api.RoadAlert{
+			Id:             "251016ST0001",
+			Title:          "CHP Incident 251016ST0001",
+			Severity:       api.AlertSeverity_WARNING,
+			Classification: api.AlertClassification_ON_ROUTE,
+		}
+	}
+	closure := &api.RoadAlert{
+		Id:                    "C4-1",
+		Title:                 "Lane closure",
+		Severity:              api.AlertSeverity_CRITICAL,
+		Classification:        api.AlertClassification_ON_ROUTE,
+		DistanceToRouteMeters: 20,
+	}
+	closureNearby := &api.RoadAlert{
+		Id:                    "C4-1",
+		Title:                 "Lane closure",
+		Severity:              api.AlertSeverity_CRITICAL,
+		Classification:        api.AlertClassification_NEARBY,
+		DistanceToRouteMeters: 3000,
+	}
+	minor := &api.RoadAlert{
+		Id:                    "251016ST0002",
+		Title:                 "CHP Incident 251016ST0002",
+		Severity:              api.AlertSeverity_WARNING,
+		Classification:        api.AlertClassification_NEARBY,
+		DistanceToRouteMeters: 1500,
+	}
+
+	c := cache.NewCache()
+	roads := []*api.Road{
+		{Id: "hwy4-angels-murphys", Alerts: []*api.RoadAlert{shared(), closureNearby}},
+		{Id: "hwy4-murphys-arnold", Alerts: []*api.RoadAlert{shared(), closure, minor}},
+	}
+	if err := c.Set("roads:all", roads, time.Hour, "roads"); err != nil {
+		t.Fatal(err)
+	}
+	s := &RoadsService{cache: c, config: &config.Config{}}
+
+	resp, err := s.ListAllAlerts(ctx, &api.ListAllAlertsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Alerts) != 3 {
+		t.Fatalf("got %d alerts, want 3 (one per distinct alert)", len(resp.Alerts))
+	}
+
+	// CRITICAL closure first, attributed only to the road it is ON_ROUTE for.
+	first := resp.Alerts[0]
+	if first.Alert.Id != "C4-1" || first.Alert.Classification != api.AlertClassification_ON_ROUTE {
+		t.Errorf("first = %s/%v, want the ON_ROUTE closure", first.Alert.Id, first.Alert.Classification)
+	}
+	if len(first.RoadIds) != 1 || first.RoadIds[0] != "hwy4-murphys-arnold" {
+		t.Errorf("closure road_ids = %v, want [hwy4-murphys-arnold]", first.RoadIds)
+	}
+
+	// Shared WARNING next (distance 0 beats 1500m), listing both roads in order.
+	second := resp.Alerts[1]
+	if second.Alert.Id != "251016ST0001" {
+		t.Errorf("second = %s, want the shared incident", second.Alert.Id)
+	}
+	if len(second.RoadIds) != 2 || second.RoadIds[0] != "hwy4-angels-murphys" || second.RoadIds[1] != "hwy4-murphys-arnold" {
+		t.Errorf("shared road_ids = %v, want both Hwy 4 segments", second.RoadIds)
+	}
+
+	if third := resp.Alerts[2]; third.Alert.Id != "251016ST0002" {
+		t.Errorf("third = %s, want the nearby incident", third.Alert.Id)
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// roadChangeKeyPrefix prefixes road ids in the cache entries recording each
+// road's content hash and when it last changed
+const roadChangeKeyPrefix = "road_changed:"
+
+// roadChangeRetention is how long a road's change record is kept without a
+// refresh. A road whose record expired counts as changed, which only costs a
+// polling client one extra copy.
+const roadChangeRetention = 24 * time.Hour
+
+// roadChange is a road's content hash and when that content first appeared
+type roadChange struct {
+	Hash      string    `json:"hash"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// recordRoadChanges sets each road's changed_at: the previous time when its
+// content hash is unchanged, otherwise now. Alert last_seen advances every
+// refresh, so it isn't a change (see hashRoads).
+func (s *RoadsService) recordRoadChanges(ctx context.Context, roads []*api.Road, now time.Time) {
+	for _, road := range roads {
+		key := roadChangeKeyPrefix + road.Id
+		unstamped := road
+		if road.ChangedAt != nil {
+			unstamped = proto.Clone(road).(*api.Road)
+			unstamped.ChangedAt = nil
+		}
+		change := roadChange{Hash: hashRoads([]*api.Road{unstamped}), ChangedAt: now}
+
+		var previous roadChange
+		if _, found, err := s.cache.GetWithMetadata(key, &previous); err != nil {
+			logging.Errorw(ctx, "Cache error", "error", err, "cache_key", key)
+		} else if found && previous.Hash == change.Hash && !previous.ChangedAt.IsZero() {
+			change.ChangedAt = previous.ChangedAt
+		}
+
+		if err := s.cache.Set(key, change, roadChangeRetention, "roads"); err != nil {
+			logging.Errorw(ctx, "Failed to cache road change", "error", err, "road_id", road.Id)
+		}
+		road.ChangedAt = timestamppb.New(change.ChangedAt)
+	}
+}
+
+// changedSince returns the roads that changed after since. Roads cached
+// before changed_at was recorded are always returned.
+func changedSince(roads []*api.Road, since time.Time) []*api.Road {
+	var changed []*api.Road
+	for _, road := range roads {
+		if road.ChangedAt == nil || road.ChangedAt.AsTime().After(since) {
+			changed = append(changed, road)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// Unit systems for the converted distances on roads (the units request
+// parameter). Cached roads are metric.
+const (
+	roadUnitsMetric   = "metric"   // Kilometers
+	roadUnitsImperial = "imperial" // Miles
+)
+
+const kmPerMile = 1.609344
+
+// parseRoadUnits validates a request's units parameter; empty means metric
+func parseRoadUnits(units string) (string, error) {
+	switch strings.ToLower(units) {
+	case "", roadUnitsMetric:
+		return roadUnitsMetric, nil
+	case roadUnitsImperial:
+		return roadUnitsImperial, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "units must be %q or %q", roadUnitsMetric, roadUnitsImperial)
+	}
+}
+
+// setRoadUnits fills road's converted distances, and those of its alerts, in
+// units from the always-metric distance_km and distance_to_route_meters
+func setRoadUnits(road *api.Road, units string) {
+	scale := 1.0
+	if units == roadUnitsImperial {
+		scale = 1 / kmPerMile
+	}
+	road.Units = units
+	road.Distance = float32(float64(road.DistanceKm) * scale)
+	for _, alert := range road.Alerts {
+		alert.DistanceToRoute = alert.DistanceToRouteMeters / 1000 * scale
+	}
+}
+
+// roadInUnits returns road with its converted distances in units. Roads can
+// be shared with the cache and stream subscribers, so a road in other units
+// is copied rather than changed.
+func roadInUnits(road *api.Road, units string) *api.Road {
+	if road.Units == units {
+		return road
+	}
+	converted := proto.Clone(road).(*api.Road)
+	setRoadUnits(converted, units)
+	return converted
+}
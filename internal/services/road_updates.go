@@ -0,0 +1,130 @@
+package services
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+)
+
+// roadUpdate is one road set pushed to StreamRoadUpdates subscribers.
+type roadUpdate struct {
+	hash string
+	resp *api.ListRoadsResponse
+}
+
+// roadUpdates fans refreshed road sets out to StreamRoadUpdates subscribers.
+// Only sets whose content differs from the previous publish are sent. The zero
+// value is ready to use.
+type roadUpdates struct {
+	mu       sync.Mutex
+	lastHash string
+	subs     map[chan roadUpdate]struct{}
+}
+
+// subscribe registers a new subscriber. Each channel buffers one update; a
+// slow subscriber only ever sees the newest road set, never a backlog.
+func (u *roadUpdates) subscribe() chan roadUpdate {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.subs == nil {
+		u.subs = make(map[chan roadUpdate]struct{})
+	}
+	ch := make(chan roadUpdate, 1)
+	u.subs[ch] = struct{}{}
+	return ch
+}
+
+// unsubscribe removes a subscriber registered with subscribe.
+func (u *roadUpdates) unsubscribe(ch chan roadUpdate) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.subs, ch)
+}
+
+// publish notifies subscribers of a refreshed road set if it changed since the
+// last publish. It reports whether subscribers were notified.
+func (u *roadUpdates) publish(roads []*api.Road) bool {
+	hash := hashRoads(roads)
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if hash == u.lastHash {
+		return false
+	}
+	u.lastHash = hash
+
+	update := roadUpdate{
+		hash: hash,
+		resp: &api.ListRoadsResponse{Roads: roads, LastUpdated: timestamppb.Now()},
+	}
+	for ch := range u.subs {
+		// Replace any undelivered update with this newer one.
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- update
+	}
+	return true
+}
+
+// hashRoads returns a content hash of a road set, used to detect refreshes
+// that changed nothing.
+func hashRoads(roads []*api.Road) string {
+	h := sha256.New()
+	opts := proto.MarshalOptions{Deterministic: true}
+	for _, road := range roads {
+		b, err := opts.Marshal(withoutLastSeen(road))
+		if err != nil {
+			// Unreachable for well-formed messages; fall back to a value that
+			// never matches so the update is still delivered.
+			return fmt.Sprintf("unhashable:%p", road)
+		}
+		h.Write(b)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// StreamRoadUpdates implements the server-streaming gRPC method. It sends the
+// current roads, then every changed road set published by a refresh until the
+// client disconnects.
+func (s *RoadsService) StreamRoadUpdates(req *api.StreamRoadUpdatesRequest, stream api.RoadsService_StreamRoadUpdatesServer) error {
+	ctx := stream.Context()
+	logging.Info(ctx, "StreamRoadUpdates called")
+
+	// Subscribe before reading the current roads so a refresh landing in
+	// between isn't missed.
+	updates := s.updates.subscribe()
+	defer s.updates.unsubscribe(updates)
+
+	initial, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(initial); err != nil {
+		return err
+	}
+	lastHash := hashRoads(initial.Roads)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logging.Info(ctx, "StreamRoadUpdates client disconnected")
+			return nil
+		case update := <-updates:
+			if update.hash == lastHash {
+				continue
+			}
+			if err := stream.Send(update.resp); err != nil {
+				return err
+			}
+			lastHash = update.hash
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// TestStreamRoadUpdates_SendsChangedRefreshes runs the stream over an
+// in-process gRPC server: the client gets the cached roads immediately, an
+// unchanged refresh is suppressed, and a changed refresh arrives as the next
+// message.
+func TestStreamRoadUpdates_SendsChangedRefreshes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(logging.EnsureLogger(context.Background()), 5*time.Second)
+	defer cancel()
+
+	cfg := &config.Config{Roads: config.RoadsConfig{RefreshInterval: time.Hour}}
+	s := NewRoadsService(nil, nil, cache.NewCache(), cfg, nil, nil)
+	initial := []*api.Road{{Id: "hwy4-angels-murphys", Status: api.RoadStatus_OPEN}}
+	if err := s.cacheRoads(ctx, initial); err != nil {
+		t.Fatal(err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &loggedStream{ServerStream: ss})
+	}))
+	api.RegisterRoadsServiceServer(srv, s)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := api.NewRoadsServiceClient(conn).StreamRoadUpdates(ctx, &api.StreamRoadUpdatesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Roads) != 1 || first.Roads[0].Status != api.RoadStatus_OPEN {
+		t.Fatalf("first message = %v, want the cached OPEN road", first.Roads)
+	}
+
+	// Simulate periodic refreshes: one that changes nothing, then one that
+	// closes the road.
+	if err := s.cacheRoads(ctx, []*api.Road{{Id: "hwy4-angels-murphys", Status: api.RoadStatus_OPEN}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.cacheRoads(ctx, []*api.Road{{Id: "hwy4-angels-murphys", Status: api.RoadStatus_CLOSED}}); err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Roads) != 1 || second.Roads[0].Status != api.RoadStatus_CLOSED {
+		t.Errorf("second message = %v, want the CLOSED road", second.Roads)
+	}
+}
+
+// loggedStream attaches a logger to the stream context, as Prefab does in
+// production.
+type loggedStream struct {
+	grpc.ServerStream
+}
+
+func (l *loggedStream) Context() context.Context {
+	return logging.EnsureLogger(l.ServerStream.Context())
+}
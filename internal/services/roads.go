@@ -2,12 +2,17 @@ package services
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dpup/prefab/logging"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -16,10 +21,13 @@ import (
 	"github.com/dpup/info.ersn.net/server/internal/cache"
 	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
 	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/clients/upstream"
 	"github.com/dpup/info.ersn.net/server/internal/config"
 	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
 	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
 	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
 )
 
 // RoadsService implements the gRPC RoadsService
@@ -28,12 +36,27 @@ type RoadsService struct {
 	api.UnimplementedRoadsServiceServer
 	googleClient   *google.Client
 	caltransClient *caltrans.FeedParser
-	cache          *cache.Cache
-	config         *config.Config
+	cache          cache.Store
+	config         *config.Config // Read through cfg(): ApplyRoadsConfig swaps it
+	configMu       sync.RWMutex
 	alertEnhancer  alerts.AlertEnhancer
 	routeMatcher   routing.RouteMatcher
 	geoUtils       geo.GeoUtils
 	contentHasher  *alerts.ContentHasher
+	health         *SourceHealth
+	updates        roadUpdates
+	enhancements   *enhancementQueue // nil when alerts are enhanced inline
+	lookups        hitRateWindow     // Enhanced-alert cache hits/misses
+	sightings      feedSightings     // Alert content hashes seen in the Caltrans feeds
+	polylines      routePolylines    // Decoded Google polylines per road
+	extraFeeds     []additionalFeed  // roads.caltransFeeds.additional
+	refreshes      singleflight.Group
+	googleCalls    callBudget       // Google Routes calls, capped by googleRoutes.dailyCallBudget
+	googleBreaker  circuitBreaker   // Skips Google Routes while it keeps failing
+	enhancerCalls  circuitBreaker   // Pauses enhancement while the LLM keeps failing
+	chainWeather   *WeatherService  // Chain advisories; nil unless roads.weatherChainAdvisories is enabled
+	weather        *WeatherService  // Corridor weather; nil unless IncludeCorridorWeather is called
+	metrics        *metrics.Metrics // nil unless ReportMetrics is called
 }
 
 // trafficData holds traffic information for a road
@@ -42,6 +65,7 @@ type trafficData struct {
 	DistanceKm      int32
 	CongestionLevel string
 	DelayMins       int32
+	RouteLengthKm   float64 // Length of the Google polyline; 0 without one
 }
 
 // googleRouteCache holds cached Google Routes API responses to reduce API usage
@@ -52,24 +76,72 @@ type googleRouteCache struct {
 	DelayMins       int32     `json:"delay_mins"`
 	Polyline        string    `json:"polyline"`
 	CachedAt        time.Time `json:"cached_at"`
+
+	SpeedReadings []google.SpeedReading `json:"speed_readings,omitempty"`
 }
 
 // NewRoadsService creates a new RoadsService
-func NewRoadsService(googleClient *google.Client, caltransClient *caltrans.FeedParser, cache *cache.Cache, config *config.Config, alertEnhancer alerts.AlertEnhancer) *RoadsService {
+func NewRoadsService(googleClient *google.Client, caltransClient *caltrans.FeedParser, cache cache.Store, config *config.Config, alertEnhancer alerts.AlertEnhancer, health *SourceHealth) *RoadsService {
+	var hasherOpts []alerts.ContentHasherOption
+	if config != nil && config.Roads.AlertHashLocationDecimals > 0 {
+		hasherOpts = append(hasherOpts, alerts.WithLocationPrecision(config.Roads.AlertHashLocationDecimals))
+	}
+
+	var matcherOpts []routing.RouteMatcherOption
+	if config != nil && config.Roads.ClosureOverlapPercent > 0 {
+		matcherOpts = append(matcherOpts, routing.WithOnRouteOverlapPercent(config.Roads.ClosureOverlapPercent))
+	}
+	if config != nil && config.Roads.ClosureOverlapMeters > 0 {
+		matcherOpts = append(matcherOpts, routing.WithOverlapThresholdMeters(config.Roads.ClosureOverlapMeters))
+	}
+
+	routeMatcher := routing.NewRouteMatcher(matcherOpts...)
+	if config != nil && config.Roads.OnRouteThresholdMeters > 0 {
+		routeMatcher = routing.NewRouteMatcherWithThreshold(config.Roads.OnRouteThresholdMeters, matcherOpts...)
+	}
+
+	var extraFeeds []additionalFeed
+	if config != nil {
+		extraFeeds = newAdditionalFeeds(config.Roads.CaltransFeeds.Additional)
+	}
+
+	var googleCallBudget int
+	if config != nil {
+		googleCallBudget = config.GoogleRoutes.DailyCallBudget
+	}
+
+	var googleFailures, breakerFailures int
+	var googleCooldown, breakerCooldown time.Duration
+	if config != nil {
+		googleFailures, googleCooldown = config.GoogleRoutes.CircuitBreaker()
+		breakerFailures, breakerCooldown = config.OpenAI.CircuitBreaker()
+	}
+
 	return &RoadsService{
 		googleClient:   googleClient,
 		caltransClient: caltransClient,
 		cache:          cache,
 		config:         config,
 		alertEnhancer:  alertEnhancer,
-		routeMatcher:   routing.NewRouteMatcher(),
+		routeMatcher:   routeMatcher,
 		geoUtils:       geo.NewGeoUtils(),
-		contentHasher:  alerts.NewContentHasher(),
+		contentHasher:  alerts.NewContentHasher(hasherOpts...),
+		health:         health,
+		extraFeeds:     extraFeeds,
+		googleCalls:    callBudget{limit: googleCallBudget},
+		googleBreaker:  circuitBreaker{threshold: googleFailures, cooldown: googleCooldown},
+		enhancerCalls:  circuitBreaker{threshold: breakerFailures, cooldown: breakerCooldown},
 	}
 }
 
+// ReportMetrics times road refreshes in m. Call before the first refresh.
+func (s *RoadsService) ReportMetrics(m *metrics.Metrics) {
+	s.metrics = m
+}
+
 // ListRoads implements the gRPC method defined in contracts/roads.proto line 12-17
 // Returns cached data with timestamp, relying on periodic background refresh to update data
+// and refreshing in the background when serving stale data
 func (s *RoadsService) ListRoads(ctx context.Context, req *api.ListRoadsRequest) (*api.ListRoadsResponse, error) {
 	logging.Info(ctx, "ListRoads called")
 
@@ -84,49 +156,136 @@ func (s *RoadsService) ListRoads(ctx context.Context, req *api.ListRoadsRequest)
 
 	// If we have cached data (fresh or stale), return it with timestamp
 	if found {
+		// server_time is when the served roads were cached, not now: roads
+		// are stamped with changed_at before they are cached, so a refresh
+		// that lands after this read has changes stamped later than it
 		var lastUpdated *timestamppb.Timestamp
+		serverTime := time.Now()
 		if entry != nil {
 			lastUpdated = timestamppb.New(entry.CreatedAt)
+			serverTime = entry.CreatedAt
 		}
 
-		isStale := s.cache.IsStale(cacheKey)
-		isVeryStale := s.cache.IsVeryStale(cacheKey)
-
-		var staleness string
-		if !isStale {
-			staleness = "fresh"
-		} else if !isVeryStale {
-			staleness = "stale"
-		} else {
-			staleness = "very_stale"
-		}
+		staleness := cacheStaleness(s.cache, cacheKey)
 
 		logging.Infow(ctx, "Returning cached roads",
 			"road_count", len(cachedRoads),
 			"staleness", staleness,
 			"last_updated", lastUpdated.AsTime().Format(time.RFC3339))
 
-		return &api.ListRoadsResponse{
-			Roads:       cachedRoads,
-			LastUpdated: lastUpdated,
-		}, nil
+		// Serve stale data now and refresh for the next request. Very stale
+		// data is left to the periodic refresh.
+		if staleness == "stale" {
+			s.refreshInBackground(ctx)
+		}
+
+		return listRoadsResponse(cachedRoads, lastUpdated, serverTime, req)
 	}
 
-	// No cached data available - perform synchronous refresh as fallback
+	// No cached data available - perform synchronous refresh as fallback.
+	// Requests arriving together (e.g. after a restart) share one refresh.
 	logging.Info(ctx, "No cached data available - performing fallback refresh")
-	roads, err := s.refreshRoadData(ctx)
+	roads, err := s.refreshAndCache(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh road data and no cached data available: %w", err)
 	}
 
-	// Cache the refreshed data
-	if err := s.cache.Set(cacheKey, roads, s.config.Roads.RefreshInterval, "roads"); err != nil {
-		logging.Errorw(ctx, "Failed to cache roads", "error", err)
+	now := time.Now()
+	return listRoadsResponse(roads, timestamppb.New(now), now, req)
+}
+
+// cacheRoads stores a freshly refreshed road set, and each road on its own for
+// GetRoad, and notifies StreamRoadUpdates subscribers when it differs from the
+// previous one. Roads are stamped with changed_at as they are stored rather
+// than when the refresh began, so a client polling with since in between
+// doesn't skip the change.
+func (s *RoadsService) cacheRoads(ctx context.Context, roads []*api.Road) error {
+	s.recordRoadChanges(ctx, roads, time.Now())
+	refreshInterval := s.cfg().Roads.RefreshInterval
+	if err := s.cache.Set("roads:all", roads, refreshInterval, "roads"); err != nil {
+		return err
+	}
+	for _, road := range roads {
+		if err := s.cache.Set(roadCacheKey(road.Id), road, refreshInterval, "roads"); err != nil {
+			return err
+		}
+	}
+	s.updates.publish(roads)
+	return nil
+}
+
+// roadCacheKey is the cache key of a single road, written alongside roads:all
+func roadCacheKey(roadID string) string {
+	return "road:" + roadID
+}
+
+// listRoadsResponse applies the request's since, status and alert filters,
+// units and pagination to the full road set. Unpaged requests keep the configured road order; paged
+// requests are sorted by road id so page boundaries are deterministic, and the
+// page token is the (opaque) id of the last road on the previous page.
+func listRoadsResponse(roads []*api.Road, lastUpdated *timestamppb.Timestamp, serverTime time.Time, req *api.ListRoadsRequest) (*api.ListRoadsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	units, err := parseRoadUnits(req.GetUnits())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetSince() != nil {
+		roads = changedSince(roads, req.GetSince().AsTime())
+	}
+
+	if len(req.GetStatusFilter()) > 0 {
+		wanted := make(map[api.RoadStatus]bool)
+		for _, st := range req.GetStatusFilter() {
+			wanted[st] = true
+		}
+		var filtered []*api.Road
+		for _, road := range roads {
+			if wanted[road.Status] {
+				filtered = append(filtered, road)
+			}
+		}
+		roads = filtered
+	}
+
+	filter := newAlertFilter(req.GetMinSeverity(), req.GetClassification())
+	converted := make([]*api.Road, len(roads))
+	for i, road := range roads {
+		converted[i] = roadInUnits(filter.apply(road), units)
+	}
+	roads = converted
+
+	paged := req.GetPageSize() > 0 || req.GetPageToken() != ""
+	if !paged {
+		return &api.ListRoadsResponse{Roads: roads, LastUpdated: lastUpdated, ServerTime: timestamppb.New(serverTime)}, nil
+	}
+
+	sorted := make([]*api.Road, len(roads))
+	copy(sorted, roads)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Id < sorted[j].Id })
+
+	if req.GetPageToken() != "" {
+		afterID, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		start := sort.Search(len(sorted), func(i int) bool { return sorted[i].Id > string(afterID) })
+		sorted = sorted[start:]
+	}
+
+	var nextPageToken string
+	if size := int(req.GetPageSize()); size > 0 && len(sorted) > size {
+		sorted = sorted[:size]
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(sorted[size-1].Id))
 	}
 
 	return &api.ListRoadsResponse{
-		Roads:       roads,
-		LastUpdated: timestamppb.Now(),
+		Roads:         sorted,
+		LastUpdated:   lastUpdated,
+		NextPageToken: nextPageToken,
+		ServerTime:    timestamppb.New(serverTime),
 	}, nil
 }
 
@@ -134,7 +293,30 @@ func (s *RoadsService) ListRoads(ctx context.Context, req *api.ListRoadsRequest)
 func (s *RoadsService) GetRoad(ctx context.Context, req *api.GetRoadRequest) (*api.GetRoadResponse, error) {
 	logging.Infow(ctx, "GetRoad called", "road_id", req.RoadId)
 
-	// Get all roads (will use cache if available)
+	// Serve the road's own cache entry, refreshing in the background when stale
+	// as ListRoads does
+	units, err := parseRoadUnits(req.GetUnits())
+	if err != nil {
+		return nil, err
+	}
+	filter := newAlertFilter(req.GetMinSeverity(), req.GetClassification())
+	road := &api.Road{}
+	cacheKey := roadCacheKey(req.RoadId)
+	entry, found, err := s.cache.GetWithMetadata(cacheKey, road)
+	if err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", cacheKey)
+	}
+	if err == nil && found && entry != nil {
+		if cacheStaleness(s.cache, cacheKey) == "stale" {
+			s.refreshInBackground(ctx)
+		}
+		return &api.GetRoadResponse{
+			Road:        roadInUnits(filter.apply(road), units),
+			LastUpdated: timestamppb.New(entry.CreatedAt),
+		}, nil
+	}
+
+	// Not cached on its own: get all roads (will use cache if available)
 	listResp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get roads: %w", err)
@@ -144,7 +326,7 @@ func (s *RoadsService) GetRoad(ctx context.Context, req *api.GetRoadRequest) (*a
 	for _, road := range listResp.Roads {
 		if road.Id == req.RoadId {
 			return &api.GetRoadResponse{
-				Road:        road,
+				Road:        roadInUnits(filter.apply(road), units),
 				LastUpdated: listResp.LastUpdated,
 			}, nil
 		}
@@ -155,44 +337,96 @@ func (s *RoadsService) GetRoad(ctx context.Context, req *api.GetRoadRequest) (*a
 
 // GetProcessingMetrics implements the gRPC method for processing metrics.
 //
-// Metrics collection is not implemented yet. Rather than shipping a misleading
-// all-zeros payload that looks like real telemetry, return Unimplemented (HTTP
-// 501) so consumers can tell the difference. Replace this with real counters
-// (instrument the refresh pipeline) when metrics are needed.
+// Counts come from the alert enhancer's usage tracking. If the enhancer
+// doesn't track usage, return Unimplemented (HTTP 501) rather than a
+// misleading all-zeros payload.
 func (s *RoadsService) GetProcessingMetrics(ctx context.Context, req *api.GetProcessingMetricsRequest) (*api.ProcessingMetrics, error) {
-	logging.Info(ctx, "GetProcessingMetrics called (not implemented)")
-	return nil, status.Error(codes.Unimplemented, "processing metrics are not yet implemented")
+	reporter, ok := s.alertEnhancer.(alerts.UsageReporter)
+	if !ok {
+		logging.Info(ctx, "GetProcessingMetrics called (enhancer does not report usage)")
+		return nil, status.Error(codes.Unimplemented, "processing metrics are not available for this alert enhancer")
+	}
+
+	usage := reporter.Usage()
+	now := time.Now()
+	hits, misses := s.lookups.totals(now)
+	return &api.ProcessingMetrics{
+		EnhancedAlerts:      usage.Enhanced,
+		EnhancementFailures: usage.Failures,
+		AvgProcessingTimeMs: float64(usage.AvgDuration()) / float64(time.Millisecond),
+		P95ProcessingTimeMs: float64(usage.P95Duration) / float64(time.Millisecond),
+		PromptTokens:        usage.PromptTokens,
+		CompletionTokens:    usage.CompletionTokens,
+		EstimatedCostUsd:    usage.EstimatedCostUSD,
+		JsonRepairs:         usage.JSONRepairs,
+		CacheHits_24H:       hits,
+		CacheMisses_24H:     misses,
+		CacheHitRate_24H:    hitRate(hits, misses),
+		CachedEnhancements:  s.countCachedEnhancements(),
+
+		GoogleRoutesCalls_24H:       int64(s.googleCalls.used(now)),
+		GoogleRoutesBudgetRemaining: int64(s.googleCalls.remaining(now)),
+	}, nil
 }
 
 // refreshRoadData fetches fresh data from all external sources
 func (s *RoadsService) refreshRoadData(ctx context.Context) ([]*api.Road, error) {
+	return s.refreshRoadDataStaggered(ctx, 0)
+}
+
+// refreshRoadDataStaggered is refreshRoadData with the roads' Google Routes
+// lookups spread evenly across stagger rather than started together
+func (s *RoadsService) refreshRoadDataStaggered(ctx context.Context, stagger time.Duration) (_ []*api.Road, err error) {
+	start := time.Now()
+	defer func() { s.metrics.ObserveRefresh("roads", time.Since(start), err) }()
+	ctx, sightings := withRefreshSightings(ctx, start)
+
 	// Fetch Caltrans data once for all roads
-	laneClosures, _ := s.caltransClient.ParseLaneClosures(ctx)
-	chpIncidents, _ := s.caltransClient.ParseCHPIncidents(ctx)
-	allIncidents := append(laneClosures, chpIncidents...)
+	laneClosures, laneErr := s.caltransClient.ParseLaneClosures(ctx)
+	chpIncidents, chpErr := s.caltransClient.ParseCHPIncidents(ctx)
+	additionalIncidents, extraErr := s.parseAdditionalFeeds(ctx)
+	allIncidents := append(append(laneClosures, chpIncidents...), additionalIncidents...)
 
 	// Fetch chain control data once for all roads
-	chainControls, err := s.caltransClient.ParseChainControlsDetailed(ctx)
-	if err != nil {
-		logging.Errorw(ctx, "Failed to get chain controls", "error", err)
+	chainControls, chainErr := s.caltransClient.ParseChainControlsDetailed(ctx)
+	if chainErr != nil {
+		logging.Errorw(ctx, "Failed to get chain controls", "error", chainErr)
 		chainControls = nil
 	}
 
+	switch {
+	case laneErr != nil:
+		s.health.RecordError(SourceCaltrans, fmt.Errorf("lane closures: %w", laneErr))
+	case chpErr != nil:
+		s.health.RecordError(SourceCaltrans, fmt.Errorf("CHP incidents: %w", chpErr))
+	case chainErr != nil:
+		s.health.RecordError(SourceCaltrans, fmt.Errorf("chain controls: %w", chainErr))
+	default:
+		s.health.RecordSuccess(SourceCaltrans)
+	}
+
 	// Fetch road conditions from roads.dot.ca.gov for each unique highway
 	roadConditionsByHighway := s.fetchRoadConditions(ctx)
 
 	logging.Infow(ctx, "Retrieved Caltrans incidents for all roads",
 		"lane_closures", len(laneClosures),
 		"chp_incidents", len(chpIncidents),
+		"additional_incidents", len(additionalIncidents),
 		"chain_controls", len(chainControls),
 		"road_conditions_highways", len(roadConditionsByHighway))
 
-	// Build routes and collect traffic data for all monitored roads
-	var allRoutes []routing.Route
-	var roadRouteMap = make(map[string]routing.Route) // Map road ID to route
-	var trafficDataMap = make(map[string]trafficData) // Map road ID to traffic data
+	// Build routes and collect traffic data for all monitored roads. Roads
+	// are independent, so fetch them concurrently (bounded for Google's rate
+	// limits); results are stored by index to keep config order.
+	monitoredRoads := s.cfg().Roads.MonitoredRoads
+	allRoutes := make([]routing.Route, len(monitoredRoads))
+	trafficByRoad := make([]trafficData, len(monitoredRoads))
+	speedReadings := make([][]google.SpeedReading, len(monitoredRoads))
+	lookupStart := time.Now()
+	forEachConcurrently(ctx, len(monitoredRoads), s.refreshConcurrency(), func(i int) {
+		monitoredRoad := monitoredRoads[i]
+		sleepUntil(ctx, lookupStart.Add(staggerOffset(stagger, i, len(monitoredRoads))))
 
-	for _, monitoredRoad := range s.config.Roads.MonitoredRoads {
 		// Get traffic data and Google polyline for this road
 		durationMins, distanceKm, congestionLevel, delayMins, googlePolyline, err := s.getTrafficDataWithPolyline(ctx, monitoredRoad)
 		if err != nil {
@@ -206,16 +440,24 @@ func (s *RoadsService) refreshRoadData(ctx context.Context) ([]*api.Road, error)
 		}
 
 		// Store traffic data for later use
-		trafficDataMap[monitoredRoad.ID] = trafficData{
+		trafficByRoad[i] = trafficData{
 			DurationMins:    durationMins,
 			DistanceKm:      distanceKm,
 			CongestionLevel: congestionLevel,
 			DelayMins:       delayMins,
+			RouteLengthKm:   s.routeLengthKm(monitoredRoad.ID, googlePolyline),
+		}
+		allRoutes[i] = s.buildRouteFromMonitoredRoad(ctx, monitoredRoad, googlePolyline)
+		if googlePolyline != "" {
+			speedReadings[i] = s.cachedSpeedReadings(monitoredRoad.ID)
 		}
+	})
 
-		route := s.buildRouteFromMonitoredRoad(ctx, monitoredRoad, googlePolyline)
-		allRoutes = append(allRoutes, route)
-		roadRouteMap[monitoredRoad.ID] = route
+	var roadRouteMap = make(map[string]routing.Route) // Map road ID to route
+	var readingsByRoad = make(map[string][]google.SpeedReading)
+	for i, monitoredRoad := range monitoredRoads {
+		roadRouteMap[monitoredRoad.ID] = allRoutes[i]
+		readingsByRoad[monitoredRoad.ID] = speedReadings[i]
 	}
 
 	// Process alerts globally across all routes for deduplication
@@ -223,13 +465,15 @@ func (s *RoadsService) refreshRoadData(ctx context.Context) ([]*api.Road, error)
 	if err != nil {
 		return nil, fmt.Errorf("failed to process global alerts: %w", err)
 	}
+	s.cacheRouteGeometry(ctx, roadRouteMap, alertsByRoute, readingsByRoad)
 
-	// Build roads with their respective alerts and traffic data
-	var roads []*api.Road
-	for _, monitoredRoad := range s.config.Roads.MonitoredRoads {
-		route := roadRouteMap[monitoredRoad.ID]
+	// Build roads with their respective alerts and traffic data, enhancing
+	// each road's alerts concurrently
+	built := make([]*api.Road, len(monitoredRoads))
+	forEachConcurrently(ctx, len(monitoredRoads), s.refreshConcurrency(), func(i int) {
+		monitoredRoad := monitoredRoads[i]
+		route := allRoutes[i]
 		routeAlerts := alertsByRoute[route.ID]
-		traffic := trafficDataMap[monitoredRoad.ID]
 
 		// Get road conditions for this road's highway
 		hwNum := extractHighwayNumber(monitoredRoad.Name)
@@ -238,17 +482,37 @@ func (s *RoadsService) refreshRoadData(ctx context.Context) ([]*api.Road, error)
 			roadConditions = roadConditionsByHighway[hwNum]
 		}
 
-		road, err := s.buildRoadFromRouteAndAlerts(ctx, monitoredRoad, route, routeAlerts, traffic, chainControls, roadConditions)
+		road, err := s.buildRoadFromRouteAndAlerts(ctx, monitoredRoad, route, routeAlerts, trafficByRoad[i], chainControls, roadConditions)
 		if err != nil {
 			logging.Errorw(ctx, "Failed to build road", "road_id", monitoredRoad.ID, "error", err)
-			continue
+			return
+		}
+		built[i] = road
+	})
+
+	var roads []*api.Road
+	for _, road := range built {
+		if road != nil {
+			roads = append(roads, road)
 		}
-		roads = append(roads, road)
 	}
 
 	if len(roads) == 0 {
 		return nil, fmt.Errorf("no roads could be processed")
 	}
+	now := time.Now()
+	s.applyMaintenanceWindows(ctx, roads, now)
+	s.recordAlertSightings(ctx, roads, now)
+	for _, road := range roads {
+		setRoadUnits(road, roadUnitsMetric)
+	}
+
+	// Incidents that left the feed are resolved. Only trust feeds that were
+	// fully fetched, or a failed fetch would look like everything resolving.
+	if laneErr == nil && chpErr == nil && extraErr == nil && s.alertEnhancer != nil {
+		s.sightings.completeFeed(sightings)
+		s.dropResolvedEnhancements(ctx)
+	}
 
 	return roads, nil
 }
@@ -258,8 +522,8 @@ func (s *RoadsService) buildRouteFromMonitoredRoad(ctx context.Context, monitore
 	// Create route definition for classification using actual Google polyline if available
 	var routePolyline geo.Polyline
 	if googlePolyline != "" {
-		// Decode Google polyline to get actual route points
-		decodedPoints, err := s.geoUtils.DecodePolyline(googlePolyline)
+		// Decode Google polyline to get actual route points (memoized while unchanged)
+		decodedPoints, err := s.polylines.decode(s.geoUtils, monitoredRoad.ID, googlePolyline)
 		if err != nil {
 			logging.Errorw(ctx, "Failed to decode Google polyline", "road_id", monitoredRoad.ID, "error", err)
 			// Fall back to simple 2-point polyline
@@ -285,41 +549,55 @@ func (s *RoadsService) buildRouteFromMonitoredRoad(ctx context.Context, monitore
 		Origin:      geo.Point{Latitude: monitoredRoad.Origin.Latitude, Longitude: monitoredRoad.Origin.Longitude},
 		Destination: geo.Point{Latitude: monitoredRoad.Destination.Latitude, Longitude: monitoredRoad.Destination.Longitude},
 		Polyline:    routePolyline,
-		MaxDistance: 5000, // Default 5 kilometers
+		MaxDistance: monitoredRoad.MaxDistance(),
+
+		StartMileMarker: monitoredRoad.StartMileMarker,
+		EndMileMarker:   monitoredRoad.EndMileMarker,
+	}
+}
+
+// routeLengthKm measures a road's Google polyline in kilometers. It is 0
+// without a polyline that decodes, rather than the straight line the route
+// falls back to.
+func (s *RoadsService) routeLengthKm(roadID, googlePolyline string) float64 {
+	if googlePolyline == "" {
+		return 0
 	}
+	points, err := s.polylines.decode(s.geoUtils, roadID, googlePolyline)
+	if err != nil {
+		return 0
+	}
+	meters, err := s.geoUtils.PolylineLength(geo.Polyline{Points: points})
+	if err != nil {
+		return 0
+	}
+	return meters / 1000
 }
 
 // processGlobalAlerts classifies alerts across all routes and applies deduplication
 func (s *RoadsService) processGlobalAlerts(ctx context.Context, allIncidents []caltrans.CaltransIncident, allRoutes []routing.Route) (map[string][]routing.ClassifiedAlert, error) {
 	// Convert Caltrans incidents to unclassified alerts
-	var unclassifiedAlerts []routing.UnclassifiedAlert
-	for _, incident := range allIncidents {
-		unclassifiedAlert := routing.UnclassifiedAlert{
-			ID:          fmt.Sprintf("%s_%d", incident.Name, incident.LastFetched.Unix()),
-			Title:       incident.Name,
-			Location:    geo.Point{Latitude: incident.Coordinates.Latitude, Longitude: incident.Coordinates.Longitude},
-			Description: incident.DescriptionText,
-			Type:        s.mapCaltransTypeToString(incident.FeedType),
-			StyleUrl:    incident.StyleUrl,
-		}
-
-		// Add affected polyline if available
-		if incident.AffectedArea != nil {
-			geoPolyline := geo.Polyline{Points: make([]geo.Point, len(incident.AffectedArea.Points))}
-			for i, point := range incident.AffectedArea.Points {
-				geoPolyline.Points[i] = geo.Point{Latitude: point.Latitude, Longitude: point.Longitude}
-			}
-			unclassifiedAlert.AffectedPolyline = &geoPolyline
-		}
+	unclassifiedAlerts := s.unclassifiedAlerts(ctx, allIncidents)
 
-		unclassifiedAlerts = append(unclassifiedAlerts, unclassifiedAlert)
+	// Routes' bounding boxes, expanded by their nearby radius. An alert outside
+	// a box can't be within MaxDistance of that route, so the pair is DISTANT
+	// without measuring against the full polyline.
+	routeBounds := make([]geo.BoundingBox, len(allRoutes))
+	for i, route := range allRoutes {
+		routeBounds[i] = s.prefilterBounds(route)
 	}
 
 	// Classify each alert against all routes to find the best classification
 	var globalClassifications []globalAlertClassification
 
 	for _, unclassifiedAlert := range unclassifiedAlerts {
-		for _, route := range allRoutes {
+		alertBounds := alertBoundingBox(unclassifiedAlert)
+		for i, route := range allRoutes {
+			// Routes without geometry still go to ClassifyAlert so the error is logged
+			if len(route.Polyline.Points) >= 2 && !routeBounds[i].Intersects(alertBounds) {
+				continue
+			}
+
 			classifiedAlert, err := s.routeMatcher.ClassifyAlert(ctx, unclassifiedAlert, []routing.Route{route})
 			if err != nil {
 				logging.Errorw(ctx, "Error classifying alert",
@@ -331,6 +609,7 @@ func (s *RoadsService) processGlobalAlerts(ctx context.Context, allIncidents []c
 
 			// Only include relevant alerts (ON_ROUTE and NEARBY)
 			if classifiedAlert.Classification != routing.Distant {
+				classifiedAlert.RoutePositionMeters = s.alertRoutePosition(route, unclassifiedAlert)
 				globalClassifications = append(globalClassifications, globalAlertClassification{
 					AlertID:         unclassifiedAlert.ID,
 					RouteID:         route.ID,
@@ -344,6 +623,58 @@ func (s *RoadsService) processGlobalAlerts(ctx context.Context, allIncidents []c
 	return s.deduplicateAlerts(ctx, globalClassifications), nil
 }
 
+// unclassifiedAlerts converts incidents to alerts for classification. Closures
+// past their stated end time are dropped so they don't keep a road
+// RESTRICTED, as are incidents matching roads.ignoredIncidentPatterns, and an
+// event reported in more than one feed becomes one alert.
+func (s *RoadsService) unclassifiedAlerts(ctx context.Context, allIncidents []caltrans.CaltransIncident) []routing.UnclassifiedAlert {
+	var current []caltrans.CaltransIncident
+	var ignorePatterns []*regexp.Regexp
+	if cfg := s.cfg(); cfg != nil {
+		ignorePatterns = cfg.Roads.IgnoredIncidentRegexps()
+	}
+	now := time.Now()
+	expired, ignored := 0, 0
+	for _, incident := range allIncidents {
+		if !incident.ParsedEndTime.IsZero() && incident.ParsedEndTime.Before(now) {
+			expired++
+			continue
+		}
+		if ignoredIncident(incident, ignorePatterns) {
+			ignored++
+			continue
+		}
+		current = append(current, incident)
+	}
+	if expired > 0 {
+		logging.Infow(ctx, "Skipped expired Caltrans incidents", "expired", expired)
+	}
+	if ignored > 0 {
+		logging.Infow(ctx, "Skipped Caltrans incidents matching roads.ignoredIncidentPatterns", "ignored", ignored)
+	}
+	return s.mergeCrossFeedDuplicates(ctx, current)
+}
+
+// prefilterBounds is the box an alert must touch to be classified against a
+// route: the route's bounds expanded by roads.prefilterRadiusMeters, but never
+// less than the route's NEARBY radius so no NEARBY alert is skipped
+func (s *RoadsService) prefilterBounds(route routing.Route) geo.BoundingBox {
+	radius := route.MaxDistance
+	if cfg := s.cfg(); cfg != nil && cfg.Roads.PrefilterRadiusMeters > radius {
+		radius = cfg.Roads.PrefilterRadiusMeters
+	}
+	return geo.BoundsOf(route.Polyline.Points).Expand(radius)
+}
+
+// alertBoundingBox bounds the points ClassifyAlert measures: the affected
+// polyline for multi-point closures, otherwise the alert location
+func alertBoundingBox(alert routing.UnclassifiedAlert) geo.BoundingBox {
+	if alert.AffectedPolyline != nil && len(alert.AffectedPolyline.Points) > 1 {
+		return geo.BoundsOf(alert.AffectedPolyline.Points)
+	}
+	return geo.BoundsOf([]geo.Point{alert.Location})
+}
+
 // globalAlertClassification represents an alert's classification for a specific route
 type globalAlertClassification struct {
 	AlertID         string
@@ -454,6 +785,9 @@ func (s *RoadsService) buildRoadFromRouteAndAlerts(ctx context.Context, monitore
 			"location", chainControlInfo.LocationName)
 	}
 
+	// Severe winter weather raises a chain advisory ahead of Caltrans
+	s.applyWeatherChainAdvisory(ctx, route, &chainControl, &statusExplanation)
+
 	// Convert congestion level to enum
 	congestionEnum := s.mapCongestionLevel(congestionLevel)
 
@@ -470,6 +804,7 @@ func (s *RoadsService) buildRoadFromRouteAndAlerts(ctx context.Context, monitore
 		ChainControl:      chainControl,
 		Alerts:            enhancedAlerts,
 		ChainControlInfo:  chainControlInfo,
+		RouteLengthKm:     traffic.RouteLengthKm,
 	}, nil
 }
 
@@ -523,8 +858,10 @@ func (s *RoadsService) processMonitoredRoad(ctx context.Context, monitoredRoad c
 // getTrafficDataWithPolyline fetches traffic data and route geometry from Google Routes API
 // Implements dedicated caching to reduce API calls and stay within 10k monthly limit
 func (s *RoadsService) getTrafficDataWithPolyline(ctx context.Context, monitoredRoad config.MonitoredRoad) (int32, int32, string, int32, string, error) {
-	if s.config.GoogleRoutes.APIKey == "" {
-		return 0, 0, "unknown", 0, "", fmt.Errorf("google Routes API key not configured")
+	if s.cfg().GoogleRoutes.APIKey == "" {
+		err := fmt.Errorf("google Routes API key not configured")
+		s.health.RecordError(SourceGoogleRoutes, err)
+		return 0, 0, "unknown", 0, "", err
 	}
 
 	// Check Google Routes-specific cache first (separate from main road cache)
@@ -535,24 +872,61 @@ func (s *RoadsService) getTrafficDataWithPolyline(ctx context.Context, monitored
 		return routeCache.DurationMins, routeCache.DistanceKm, routeCache.CongestionLevel, routeCache.DelayMins, routeCache.Polyline, nil
 	}
 
-	// Cache miss - call Google Routes API
+	// Google keeps failing: serve the expired entry without spending budget
+	// or waiting on another failure
+	if !s.googleBreaker.allow(time.Now()) {
+		if _, found, _ := s.cache.GetWithMetadata(googleCacheKey, &routeCache); found {
+			logging.Warnw(ctx, "Google Routes paused after repeated failures, using stale route data", "road_id", monitoredRoad.ID, "cached_at", routeCache.CachedAt)
+			return routeCache.DurationMins, routeCache.DistanceKm, routeCache.CongestionLevel, routeCache.DelayMins, routeCache.Polyline, nil
+		}
+		return 0, 0, "unknown", 0, "", fmt.Errorf("google Routes paused after repeated failures: %w", upstream.ErrUpstreamUnavailable)
+	}
+
+	// Cache miss - call Google Routes API if the daily budget allows, else
+	// fall back to the expired entry
+	if !s.googleCalls.reserve(time.Now()) {
+		s.googleBreaker.release()
+		if _, found, _ := s.cache.GetWithMetadata(googleCacheKey, &routeCache); found {
+			logging.Warnw(ctx, "Google Routes daily budget spent, using stale route data", "road_id", monitoredRoad.ID, "cached_at", routeCache.CachedAt)
+			return routeCache.DurationMins, routeCache.DistanceKm, routeCache.CongestionLevel, routeCache.DelayMins, routeCache.Polyline, nil
+		}
+		return 0, 0, "unknown", 0, "", fmt.Errorf("google Routes daily call budget of %d spent", s.googleCalls.limit)
+	}
 	logging.Infow(ctx, "Calling Google Routes API", "road_id", monitoredRoad.ID)
 	roadData, err := s.googleClient.ComputeRoutes(ctx,
 		monitoredRoad.Origin.ToProto(),
 		monitoredRoad.Destination.ToProto())
+	s.recordGoogleOutcome(ctx, err)
 	if err != nil {
+		s.health.RecordError(SourceGoogleRoutes, err)
+		switch {
+		case upstream.Transient(err):
+			// Rate limited or Google is down; the last route is better than none
+			if _, found, _ := s.cache.GetWithMetadata(googleCacheKey, &routeCache); found {
+				logging.Warnw(ctx, "Google Routes unavailable, using stale route data", "road_id", monitoredRoad.ID, "error", err, "cached_at", routeCache.CachedAt)
+				return routeCache.DurationMins, routeCache.DistanceKm, routeCache.CongestionLevel, routeCache.DelayMins, routeCache.Polyline, nil
+			}
+		case errors.Is(err, upstream.ErrUnauthorized):
+			logging.Errorw(ctx, "Google Routes rejected the API key; check googleRoutes.apiKey", "road_id", monitoredRoad.ID)
+		}
 		return 0, 0, "unknown", 0, "", fmt.Errorf("failed to compute routes: %w", err)
 	}
+	s.health.RecordSuccess(SourceGoogleRoutes)
 
 	// Calculate real delay from Google's traffic-aware vs baseline durations
 	delaySeconds := roadData.DurationSeconds - roadData.StaticDurationSeconds
-	if delaySeconds < 0 {
-		delaySeconds = 0 // Shouldn't happen, but safety check
+	if delaySeconds < 0 || roadData.StaticDurationSeconds == 0 {
+		delaySeconds = 0 // No usable baseline to measure delay against
 	}
 
-	// Determine congestion level based on actual delay minutes
+	// Determine congestion level based on actual delay minutes. Without a
+	// baseline duration the delay is meaningless, so fall back to the share of
+	// the route Google reports as slow or jammed.
 	delayMins := int32(delaySeconds / 60)
-	congestionLevel := s.classifyCongestionByDelay(delayMins)
+	congestionLevel := classifyCongestionByDelay(delayMins, s.cfg().Roads.CongestionThresholdsFor(monitoredRoad))
+	if roadData.StaticDurationSeconds == 0 && len(roadData.SpeedReadings) > 0 {
+		congestionLevel = classifyCongestionBySpeed(roadData.SpeedReadings)
+	}
 
 	// Convert to user-friendly units
 	durationMins := int32(roadData.DurationSeconds / 60)
@@ -566,32 +940,89 @@ func (s *RoadsService) getTrafficDataWithPolyline(ctx context.Context, monitored
 		DelayMins:       delayMins,
 		Polyline:        roadData.Polyline,
 		CachedAt:        time.Now(),
+		SpeedReadings:   roadData.SpeedReadings,
 	}
 
-	// Cache Google Routes data for 45 minutes. With the 15m refresh loop this
-	// yields ~1 API call per road every 45 min (~32/day/road, ~3.9k/month for the
-	// 4 monitored roads) - comfortably under the Compute Routes Pro free tier of
-	// 5,000/month. Traffic data this old is fine for these rural highways.
-	if err := s.cache.Set(googleCacheKey, cache, 45*time.Minute, "google_routes"); err != nil {
+	// Cache Google Routes data well past the refresh interval to conserve
+	// quota (see config.DefaultGoogleRoutesCacheTTL). Traffic data this old is
+	// fine for these rural highways.
+	if err := s.cache.Set(googleCacheKey, cache, s.cfg().GoogleRoutes.RouteCacheTTL(), "google_routes"); err != nil {
 		logging.Errorw(ctx, "Failed to cache Google Routes data", "error", err, "road_id", monitoredRoad.ID)
 	}
 
 	return durationMins, distanceKm, congestionLevel, delayMins, roadData.Polyline, nil
 }
 
-// classifyCongestionByDelay determines congestion level based on actual delay minutes
-func (s *RoadsService) classifyCongestionByDelay(delayMins int32) string {
+// recordGoogleOutcome feeds a Compute Routes result to the circuit breaker.
+// A call abandoned because ctx ended says nothing about Google.
+func (s *RoadsService) recordGoogleOutcome(ctx context.Context, err error) {
+	if err != nil && ctx.Err() != nil {
+		s.googleBreaker.release()
+		return
+	}
+	opened, closed := s.googleBreaker.record(err == nil, time.Now())
 	switch {
-	case delayMins >= 20:
-		return "severe" // 20+ minutes delay
-	case delayMins >= 10:
-		return "heavy" // 10-19 minutes delay
-	case delayMins >= 5:
-		return "moderate" // 5-9 minutes delay
-	case delayMins >= 2:
-		return "light" // 2-4 minutes delay
+	case opened:
+		logging.Warnw(ctx, "Pausing Google Routes after repeated failures, serving last-known traffic",
+			"failures", s.googleBreaker.threshold, "cooldown", s.googleBreaker.cooldown)
+	case closed:
+		logging.Infow(ctx, "Google Routes recovered, resuming")
+	}
+}
+
+// classifyCongestionBySpeed determines congestion level from the fraction of
+// polyline points Google reports as TRAFFIC_JAM or SLOW. Used when delay can't
+// be computed because the static (no-traffic) duration is missing.
+func classifyCongestionBySpeed(readings []google.SpeedReading) string {
+	var total, slow, jammed int32
+	for _, r := range readings {
+		span := r.EndIndex - r.StartIndex
+		if span <= 0 {
+			continue
+		}
+		total += span
+		switch r.Speed {
+		case "TRAFFIC_JAM":
+			jammed += span
+		case "SLOW":
+			slow += span
+		}
+	}
+	if total == 0 {
+		return "clear"
+	}
+
+	jamShare := float64(jammed) / float64(total)
+	congestedShare := float64(jammed+slow) / float64(total)
+	switch {
+	case jamShare >= 0.25:
+		return "severe"
+	case jamShare >= 0.10:
+		return "heavy"
+	case congestedShare >= 0.25:
+		return "moderate"
+	case congestedShare >= 0.05:
+		return "light"
 	default:
-		return "clear" // 0-1 minutes delay
+		return "clear"
+	}
+}
+
+// classifyCongestionByDelay determines congestion level based on actual delay
+// minutes. Each threshold is the minimum delay for that level (defaults
+// 2/5/10/20 minutes, see config.DefaultCongestionThresholds).
+func classifyCongestionByDelay(delayMins int32, thresholds config.CongestionThresholds) string {
+	switch {
+	case delayMins >= thresholds.SevereMinutes:
+		return "severe"
+	case delayMins >= thresholds.HeavyMinutes:
+		return "heavy"
+	case delayMins >= thresholds.ModerateMinutes:
+		return "moderate"
+	case delayMins >= thresholds.LightMinutes:
+		return "light"
+	default:
+		return "clear"
 	}
 }
 
@@ -650,39 +1081,7 @@ func (s *RoadsService) mapChainControlStatus(status string) api.ChainControlStat
 // getCaltransDataWithRouteGeometry fetches road status, chain control, and alerts using actual route geometry
 // Returns: roadStatus, chainControlStatus, alerts, statusExplanation, chainControlInfo, error
 func (s *RoadsService) getCaltransDataWithRouteGeometry(ctx context.Context, monitoredRoad config.MonitoredRoad, googlePolyline string) (string, string, []*api.RoadAlert, string, *api.ChainControlInfo, error) {
-	// Create route definition for classification using actual Google polyline if available
-	var routePolyline geo.Polyline
-	if googlePolyline != "" {
-		// Decode Google polyline to get actual route points
-		decodedPoints, err := s.geoUtils.DecodePolyline(googlePolyline)
-		if err != nil {
-			logging.Errorw(ctx, "Failed to decode Google polyline", "road_id", monitoredRoad.ID, "error", err)
-			// Fall back to simple 2-point polyline
-			routePolyline = geo.Polyline{Points: []geo.Point{
-				{Latitude: monitoredRoad.Origin.Latitude, Longitude: monitoredRoad.Origin.Longitude},
-				{Latitude: monitoredRoad.Destination.Latitude, Longitude: monitoredRoad.Destination.Longitude},
-			}}
-		} else {
-			routePolyline = geo.Polyline{Points: decodedPoints}
-		}
-	} else {
-		// Use simple 2-point polyline as fallback
-		routePolyline = geo.Polyline{Points: []geo.Point{
-			{Latitude: monitoredRoad.Origin.Latitude, Longitude: monitoredRoad.Origin.Longitude},
-			{Latitude: monitoredRoad.Destination.Latitude, Longitude: monitoredRoad.Destination.Longitude},
-		}}
-	}
-
-	route := routing.Route{
-		ID:          monitoredRoad.ID,
-		Name:        monitoredRoad.Name,
-		Section:     monitoredRoad.Section,
-		Origin:      geo.Point{Latitude: monitoredRoad.Origin.Latitude, Longitude: monitoredRoad.Origin.Longitude},
-		Destination: geo.Point{Latitude: monitoredRoad.Destination.Latitude, Longitude: monitoredRoad.Destination.Longitude},
-		Polyline:    routePolyline,
-		MaxDistance: 5000, // Default 5 kilometers
-	}
-
+	route := s.buildRouteFromMonitoredRoad(ctx, monitoredRoad, googlePolyline)
 	return s.processCaltransDataWithRoute(ctx, route, monitoredRoad)
 }
 
@@ -690,7 +1089,7 @@ func (s *RoadsService) getCaltransDataWithRouteGeometry(ctx context.Context, mon
 // Returns: roadStatus, chainControlStatus, alerts, statusExplanation, chainControlInfo, error
 func (s *RoadsService) processCaltransDataWithRoute(ctx context.Context, route routing.Route, monitoredRoad config.MonitoredRoad) (string, string, []*api.RoadAlert, string, *api.ChainControlInfo, error) {
 
-	// Get all incidents from Caltrans (no geographic pre-filtering)
+	// Get all incidents from Caltrans (statewide; prefiltered by route bounds below)
 	laneClosures, _ := s.caltransClient.ParseLaneClosures(ctx)
 	chpIncidents, _ := s.caltransClient.ParseCHPIncidents(ctx)
 
@@ -707,54 +1106,15 @@ func (s *RoadsService) processCaltransDataWithRoute(ctx context.Context, route r
 		"chp_incidents", len(chpIncidents),
 		"chain_controls", len(chainControls))
 
-	// Combine all incidents
-	allIncidents := append(laneClosures, chpIncidents...)
+	// Combine all incidents; a failing extra feed is logged and skipped
+	additionalIncidents, _ := s.parseAdditionalFeeds(ctx)
+	allIncidents := append(append(laneClosures, chpIncidents...), additionalIncidents...)
 
 	// Convert Caltrans incidents to unclassified alerts
-	var unclassifiedAlerts []routing.UnclassifiedAlert
-	for _, incident := range allIncidents {
-		unclassifiedAlert := routing.UnclassifiedAlert{
-			ID:          fmt.Sprintf("%s_%d", incident.Name, incident.LastFetched.Unix()),
-			Title:       incident.Name, // Use actual Caltrans title (e.g., "CHP Incident 250911GG0206")
-			Location:    geo.Point{Latitude: incident.Coordinates.Latitude, Longitude: incident.Coordinates.Longitude},
-			Description: incident.DescriptionText,
-			Type:        s.mapCaltransTypeToString(incident.FeedType),
-			StyleUrl:    incident.StyleUrl,
-		}
-
-		// Add affected polyline if available
-		if incident.AffectedArea != nil {
-			geoPolyline := geo.Polyline{Points: make([]geo.Point, len(incident.AffectedArea.Points))}
-			for i, point := range incident.AffectedArea.Points {
-				geoPolyline.Points[i] = geo.Point{Latitude: point.Latitude, Longitude: point.Longitude}
-			}
-			unclassifiedAlert.AffectedPolyline = &geoPolyline
-		}
-
-		unclassifiedAlerts = append(unclassifiedAlerts, unclassifiedAlert)
-	}
+	unclassifiedAlerts := s.unclassifiedAlerts(ctx, allIncidents)
 
 	// Classify alerts using route-aware matching
-	var classifiedAlerts []routing.ClassifiedAlert
-	for _, unclassifiedAlert := range unclassifiedAlerts {
-		classifiedAlert, err := s.routeMatcher.ClassifyAlert(ctx, unclassifiedAlert, []routing.Route{route})
-		if err != nil {
-			logging.Errorw(ctx, "Error classifying alert",
-				"alert_id", unclassifiedAlert.ID,
-				"alert_title", unclassifiedAlert.Title,
-				"error", err)
-			continue
-		}
-
-		logging.Infow(ctx, "Classified alert",
-			"alert_title", unclassifiedAlert.Title,
-			"classification", string(classifiedAlert.Classification),
-			"distance_to_route", classifiedAlert.DistanceToRoute,
-			"lat", unclassifiedAlert.Location.Latitude,
-			"lon", unclassifiedAlert.Location.Longitude)
-
-		classifiedAlerts = append(classifiedAlerts, classifiedAlert)
-	}
+	classifiedAlerts := s.classifyAlertsForRoute(ctx, route, unclassifiedAlerts)
 
 	logging.Infow(ctx, "Alert classification complete",
 		"road_id", route.ID,
@@ -846,6 +1206,48 @@ func (s *RoadsService) processCaltransDataWithRoute(ctx context.Context, route r
 	return roadStatusStr, chainControlStr, enhancedAlerts, statusExplanation, chainControlInfo, nil
 }
 
+// classifyAlertsForRoute classifies alerts against one route. Alerts outside
+// the route's prefilter bounds are DISTANT and skipped without measuring
+// against the full polyline.
+func (s *RoadsService) classifyAlertsForRoute(ctx context.Context, route routing.Route, unclassifiedAlerts []routing.UnclassifiedAlert) []routing.ClassifiedAlert {
+	bounds := s.prefilterBounds(route)
+	skipped := 0
+
+	var classifiedAlerts []routing.ClassifiedAlert
+	for _, unclassifiedAlert := range unclassifiedAlerts {
+		// Routes without geometry still go to ClassifyAlert so the error is logged
+		if len(route.Polyline.Points) >= 2 && !bounds.Intersects(alertBoundingBox(unclassifiedAlert)) {
+			skipped++
+			continue
+		}
+
+		classifiedAlert, err := s.routeMatcher.ClassifyAlert(ctx, unclassifiedAlert, []routing.Route{route})
+		if err != nil {
+			logging.Errorw(ctx, "Error classifying alert",
+				"alert_id", unclassifiedAlert.ID,
+				"alert_title", unclassifiedAlert.Title,
+				"error", err)
+			continue
+		}
+
+		classifiedAlert.RoutePositionMeters = s.alertRoutePosition(route, unclassifiedAlert)
+
+		logging.Infow(ctx, "Classified alert",
+			"alert_title", unclassifiedAlert.Title,
+			"classification", string(classifiedAlert.Classification),
+			"distance_to_route", classifiedAlert.DistanceToRoute,
+			"lat", unclassifiedAlert.Location.Latitude,
+			"lon", unclassifiedAlert.Location.Longitude)
+
+		classifiedAlerts = append(classifiedAlerts, classifiedAlert)
+	}
+	if skipped > 0 {
+		logging.Infow(ctx, "Skipped alerts outside route bounds", "road_id", route.ID, "skipped", skipped)
+	}
+
+	return classifiedAlerts
+}
+
 // findChainControlForRoute finds the closest chain control point that applies to this route
 func (s *RoadsService) findChainControlForRoute(ctx context.Context, route routing.Route, chainControls []caltrans.ChainControlData) *api.ChainControlInfo {
 	if len(chainControls) == 0 {
@@ -965,37 +1367,94 @@ func (s *RoadsService) mapCaltransTypeToString(feedType caltrans.CaltransFeedTyp
 	case caltrans.CHP_INCIDENT:
 		return "incident"
 	default:
+		if alertType, ok := s.additionalFeedAlertType(feedType); ok {
+			return alertType
+		}
 		return "unknown"
 	}
 }
 
+// incidentToUnclassifiedAlert converts a Caltrans incident for route
+// classification, carrying its closure geometry when present
+func (s *RoadsService) incidentToUnclassifiedAlert(incident caltrans.CaltransIncident) routing.UnclassifiedAlert {
+	var location geo.Point
+	if incident.Coordinates != nil {
+		location = geo.Point{Latitude: incident.Coordinates.Latitude, Longitude: incident.Coordinates.Longitude}
+	}
+
+	unclassifiedAlert := routing.UnclassifiedAlert{
+		// Stable across fetches; matches Incident.id
+		ID:           incidentID(incident, extractLogNumber(incident, incident.DescriptionHtml)),
+		Title:        incident.Name, // Use actual Caltrans title (e.g., "CHP Incident 250911GG0206")
+		Location:     location,
+		Description:  incident.DescriptionText,
+		Type:         s.mapCaltransTypeToString(incident.FeedType),
+		StyleUrl:     incident.StyleUrl,
+		FeedSeverity: incident.Severity,
+	}
+
+	// Add affected polyline if available
+	if incident.AffectedArea != nil {
+		geoPolyline := geo.Polyline{Points: make([]geo.Point, len(incident.AffectedArea.Points))}
+		for i, point := range incident.AffectedArea.Points {
+			geoPolyline.Points[i] = geo.Point{Latitude: point.Latitude, Longitude: point.Longitude}
+		}
+		unclassifiedAlert.AffectedPolyline = &geoPolyline
+	}
+
+	return unclassifiedAlert
+}
+
 // buildEnhancedRoadAlert creates an enhanced API road alert from classified alert
 func (s *RoadsService) buildEnhancedRoadAlert(ctx context.Context, classifiedAlert routing.ClassifiedAlert, monitoredRoad config.MonitoredRoad) (*api.RoadAlert, *alerts.EnhancedAlert, error) {
 	// Build base alert (polylines kept internal for processing)
 	alertType := s.mapStringToAlertType(classifiedAlert.Type)
 	alert := &api.RoadAlert{
-		Id:                    logNumberFromText(classifiedAlert.Title, classifiedAlert.Description), // Stable id; matches Incident.id
+		Id:                    classifiedAlert.ID, // Stable id; matches Incident.id
 		Type:                  alertType,
-		Severity:              api.AlertSeverity_WARNING, // Default, will be updated after AI enhancement
+		Severity:              s.determineAlertSeverity(classifiedAlert.Classification, "", alertType, classifiedAlert.FeedSeverity), // From feed keywords; refined after AI enhancement
 		Classification:        s.mapRoutingToAPIClassification(classifiedAlert.Classification),
 		Title:                 classifiedAlert.Title,       // Use real Caltrans title (e.g., "CHP Incident 250911GG0206")
 		Description:           classifiedAlert.Description, // Will be enhanced below
-		StartTime:             nil,                         // Will be set from AI enhancement or fallback to current time
-		EndTime:               nil,
-		LastUpdated:           nil, // Will be set from AI enhancement or fallback to current time
+		StartTime:             nil,                         // Will be set from the CHP log or AI enhancement
+		EndTime:               nil,                         // Set from the closure's stated end time
+		LastUpdated:           nil,                         // Will be set from the CHP log or AI enhancement
 		Location:              &api.Coordinates{Latitude: classifiedAlert.Location.Latitude, Longitude: classifiedAlert.Location.Longitude},
 		DistanceToRouteMeters: classifiedAlert.DistanceToRoute, // Distance for client rendering
+		RoutePositionMeters:   classifiedAlert.RoutePositionMeters,
 		Metadata:              make(map[string]string),
 	}
 
+	if len(classifiedAlert.SourceFeeds) > 1 {
+		alert.Metadata[sourceFeedsMetadataKey] = strings.Join(classifiedAlert.SourceFeeds, ",")
+	}
+
+	// Lane counts parsed from the feed text; AI additional_info may refine them
+	if lanes := caltrans.ParseLaneClosure(classifiedAlert.Description); lanes.Known() {
+		alert.Metadata["lanes_affected"] = lanes.String()
+	}
+
+	// Report and update times from the CHP log; AI enhancement may refine them
+	reported, updated := caltrans.ParseCHPTimestamps(classifiedAlert.Description)
+	if !reported.IsZero() {
+		alert.TimeReported = timestamppb.New(reported)
+		alert.StartTime = timestamppb.New(reported)
+	}
+	if !updated.IsZero() {
+		alert.LastUpdated = timestamppb.New(updated)
+	}
+	if end := caltrans.ParseEndTime(classifiedAlert.Description); !end.IsZero() {
+		alert.EndTime = timestamppb.New(end)
+	}
+
 	var enhancedData *alerts.EnhancedAlert
 
 	// Enhance with AI if available
 	if s.alertEnhancer != nil {
-		enhanced, err := s.EnhanceAlertWithAI(ctx, classifiedAlert)
+		enhanced, err := s.enhanceForRefresh(ctx, classifiedAlert)
 		if err != nil {
 			logging.Errorw(ctx, "Alert enhancement failed, using original", "error", err)
-		} else {
+		} else if enhanced != nil {
 			enhancedData = enhanced
 			// Update alert with enhanced data at top level
 			alert.Description = enhanced.StructuredDescription.Details
@@ -1023,10 +1482,10 @@ func (s *RoadsService) buildEnhancedRoadAlert(ctx context.Context, classifiedAle
 				classifiedAlert.Classification,
 				enhanced.StructuredDescription.Impact,
 				alertType,
-				enhanced.StructuredDescription.Details,
+				classifiedAlert.FeedSeverity,
 			)
 
-			// Reserve metadata only for AI's additional_info
+			// Remaining metadata is AI's additional_info
 			for key, value := range enhanced.StructuredDescription.AdditionalInfo {
 				alert.Metadata[key] = value
 			}
@@ -1037,49 +1496,134 @@ func (s *RoadsService) buildEnhancedRoadAlert(ctx context.Context, classifiedAle
 }
 
 // EnhanceAlertWithAI uses the alert enhancer to improve alert descriptions with integrated caching
-// Made public for testing
+// Made public for testing. Returns nil without an error while the circuit
+// breaker has paused enhancement, or for an alert without a valid location
+// to key the cache on, so the raw alert is served.
 func (s *RoadsService) EnhanceAlertWithAI(ctx context.Context, classifiedAlert routing.ClassifiedAlert) (*alerts.EnhancedAlert, error) {
-	rawAlert := alerts.RawAlert{
-		ID:          classifiedAlert.ID,
-		Title:       classifiedAlert.Title,
-		Description: classifiedAlert.Description,
-		Location:    fmt.Sprintf("%s (%.4f, %.4f)", classifiedAlert.Title, classifiedAlert.Location.Latitude, classifiedAlert.Location.Longitude),
-		StyleUrl:    classifiedAlert.StyleUrl,
-		Timestamp:   time.Now(),
-	}
+	rawAlert := s.rawAlertFor(classifiedAlert)
 
 	// Generate content hash for cache key
 	contentHash := s.contentHasher.HashRawAlert(rawAlert)
+	if contentHash == "" {
+		logging.Warnw(ctx, "Not enhancing alert with an invalid location", "alert_id", classifiedAlert.ID, "location", rawAlert.Location)
+		return nil, nil
+	}
 
 	// Check cache first
-	var cachedAlert alerts.EnhancedAlert
-	key := fmt.Sprintf("enhanced_alert:%s", contentHash)
-	if found, err := s.cache.Get(key, &cachedAlert); err == nil && found {
+	markSeen(ctx, contentHash)
+	cachedAlert, found := s.cachedEnhancement(contentHash)
+	s.lookups.record(found, time.Now())
+	if found {
 		logging.Infow(ctx, "Cache hit for alert content hash", "hash", contentHash[:8])
-		return &cachedAlert, nil
+		return cachedAlert, nil
 	}
 
 	logging.Infow(ctx, "Cache miss for alert content hash - calling OpenAI", "hash", contentHash[:8])
+	return s.enhanceAndCache(ctx, classifiedAlert.Type, rawAlert, contentHash)
+}
+
+// enhanceAndCache calls the enhancer and caches the result under contentHash.
+// While the LLM keeps failing the circuit breaker skips the call and nil is
+// returned without an error.
+func (s *RoadsService) enhanceAndCache(ctx context.Context, alertType string, rawAlert alerts.RawAlert, contentHash string) (*alerts.EnhancedAlert, error) {
+	if !s.enhancerCalls.allow(time.Now()) {
+		return nil, nil
+	}
+	defer func() {
+		// A panicking enhancer gives up its call so a half-open breaker can
+		// probe again
+		if r := recover(); r != nil {
+			s.enhancerCalls.release()
+			panic(r)
+		}
+	}()
 
-	// Cache miss - call OpenAI enhancement
 	enhanced, err := s.alertEnhancer.EnhanceAlert(ctx, rawAlert)
+	if err != nil && ctx.Err() != nil {
+		// Cancelled (e.g. at shutdown): says nothing about the LLM's health
+		s.enhancerCalls.release()
+		return nil, err
+	}
+	opened, closed := s.enhancerCalls.record(err == nil, time.Now())
+	if opened {
+		logging.Warnw(ctx, "Pausing alert enhancement after repeated OpenAI failures, serving raw alerts",
+			"failures", s.enhancerCalls.threshold, "cooldown", s.enhancerCalls.cooldown)
+	} else if closed {
+		logging.Info(ctx, "OpenAI enhancement recovered, resuming")
+	}
 	if err != nil {
 		logging.Errorw(ctx, "OpenAI enhancement failed", "hash", contentHash[:8], "error", err)
 		return nil, err
 	}
 
-	// Cache the result with 24 hour TTL to prevent duplicate OpenAI calls
-	ttl := 24 * time.Hour
+	// Cache the result to prevent duplicate OpenAI calls; the TTL depends on
+	// how quickly this type of alert tends to change
+	ttl := s.cfg().Roads.EnhancedAlertCacheTTL(alertType)
 	if err := s.cache.SetEnhancedAlert(contentHash, enhanced, ttl); err != nil {
 		logging.Errorw(ctx, "Failed to cache enhanced alert", "error", err)
 		// Don't fail the request if caching fails
 	} else {
-		logging.Infow(ctx, "Cached enhanced alert for 24h", "hash", contentHash[:8])
+		logging.Infow(ctx, "Cached enhanced alert", "hash", contentHash[:8], "ttl", ttl)
 	}
 
 	return &enhanced, nil
 }
 
+// enhanceForRefresh returns the enhancement for a refresh. With background
+// enhancement running, a cache miss is queued and nil is returned so the
+// refresh serves the raw alert now and picks up the enhancement next time.
+func (s *RoadsService) enhanceForRefresh(ctx context.Context, classifiedAlert routing.ClassifiedAlert) (*alerts.EnhancedAlert, error) {
+	if s.enhancements == nil {
+		return s.EnhanceAlertWithAI(ctx, classifiedAlert)
+	}
+
+	rawAlert := s.rawAlertFor(classifiedAlert)
+	contentHash := s.contentHasher.HashRawAlert(rawAlert)
+	if contentHash == "" {
+		logging.Warnw(ctx, "Not enhancing alert with an invalid location", "alert_id", classifiedAlert.ID, "location", rawAlert.Location)
+		return nil, nil
+	}
+	markSeen(ctx, contentHash)
+	cachedAlert, found := s.cachedEnhancement(contentHash)
+	s.lookups.record(found, time.Now())
+	if found {
+		return cachedAlert, nil
+	}
+
+	queued, err := s.enhancements.enqueue(ctx, classifiedAlert, contentHash, requestid.FromContext(ctx), s.enhancementQueueWait())
+	switch {
+	case err != nil:
+		s.metrics.RecordEnhancementDropped()
+		logging.Warnw(ctx, "Alert enhancement queue full, retrying next refresh", "hash", contentHash[:8])
+	case queued:
+		logging.Infow(ctx, "Queued alert for background enhancement", "hash", contentHash[:8])
+	}
+	return nil, nil
+}
+
+// rawAlertFor converts a classified alert into the enhancer's input, asking
+// for the configured summary translations
+func (s *RoadsService) rawAlertFor(classifiedAlert routing.ClassifiedAlert) alerts.RawAlert {
+	return alerts.RawAlert{
+		ID:          classifiedAlert.ID,
+		Title:       classifiedAlert.Title,
+		Description: classifiedAlert.Description,
+		Location:    fmt.Sprintf("%s (%.4f, %.4f)", classifiedAlert.Title, classifiedAlert.Location.Latitude, classifiedAlert.Location.Longitude),
+		StyleUrl:    classifiedAlert.StyleUrl,
+		Timestamp:   time.Now(),
+		Languages:   s.cfg().OpenAI.SummaryLanguages,
+	}
+}
+
+// cachedEnhancement returns the fresh cached enhancement for a content hash
+func (s *RoadsService) cachedEnhancement(contentHash string) (*alerts.EnhancedAlert, bool) {
+	var cachedAlert alerts.EnhancedAlert
+	if found, err := s.cache.Get(enhancedAlertKeyPrefix+contentHash, &cachedAlert); err == nil && found {
+		return &cachedAlert, true
+	}
+	return nil, false
+}
+
 // mapAlertImpact maps the AI enhancer's impact string to the AlertImpact enum.
 func mapAlertImpact(impact string) api.AlertImpact {
 	switch strings.ToLower(strings.TrimSpace(impact)) {
@@ -1112,7 +1656,10 @@ func (s *RoadsService) mapStringToAlertType(typeStr string) api.AlertType {
 	}
 }
 
-func (s *RoadsService) determineAlertSeverity(classification routing.AlertClassification, impact string, alertType api.AlertType, description string) api.AlertSeverity {
+// determineAlertSeverity rates an alert from the AI's impact assessment,
+// falling back to the alert type and then to feedSeverity, the keyword
+// severity of the feed's own text
+func (s *RoadsService) determineAlertSeverity(classification routing.AlertClassification, impact string, alertType api.AlertType, feedSeverity api.AlertSeverity) api.AlertSeverity {
 	// Base severity on impact level first, then adjust by classification
 	var baseSeverity api.AlertSeverity
 
@@ -1126,12 +1673,11 @@ func (s *RoadsService) determineAlertSeverity(classification routing.AlertClassi
 	case "none":
 		baseSeverity = api.AlertSeverity_INFO
 	default:
-		// Fallback based on alert type and description
+		// Fallback based on alert type and the feed's keywords
 		if alertType == api.AlertType_CLOSURE {
 			baseSeverity = api.AlertSeverity_CRITICAL
-		} else if strings.Contains(strings.ToLower(description), "assistance") ||
-			strings.Contains(strings.ToLower(description), "maintenance") {
-			baseSeverity = api.AlertSeverity_INFO
+		} else if feedSeverity != api.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED {
+			baseSeverity = feedSeverity
 		} else {
 			baseSeverity = api.AlertSeverity_WARNING
 		}
@@ -1192,7 +1738,7 @@ func (s *RoadsService) fetchRoadConditions(ctx context.Context) map[string][]cal
 
 	// Collect unique highway numbers
 	seen := make(map[string]bool)
-	for _, road := range s.config.Roads.MonitoredRoads {
+	for _, road := range s.cfg().Roads.MonitoredRoads {
 		hwNum := extractHighwayNumber(road.Name)
 		if hwNum == "" || seen[hwNum] {
 			continue
@@ -0,0 +1,1013 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func TestClassifyCongestionByDelay_Defaults(t *testing.T) {
+	thresholds := config.RoadsConfig{}.CongestionThresholdsFor(config.MonitoredRoad{})
+	cases := map[int32]string{
+		0:  "clear",
+		1:  "clear",
+		2:  "light",
+		4:  "light",
+		5:  "moderate",
+		9:  "moderate",
+		10: "heavy",
+		19: "heavy",
+		20: "severe",
+		45: "severe",
+	}
+	for delay, want := range cases {
+		if got := classifyCongestionByDelay(delay, thresholds); got != want {
+			t.Errorf("delay %d = %q, want %q", delay, got, want)
+		}
+	}
+}
+
+// TestClassifyCongestionByDelay_CustomThresholds verifies configured cutoffs
+// change the level for the same delay, and that a per-road override wins over
+// the roads-wide section while unset cutoffs fall back.
+func TestClassifyCongestionByDelay_CustomThresholds(t *testing.T) {
+	roads := config.RoadsConfig{
+		CongestionThresholds: config.CongestionThresholds{
+			LightMinutes:    5,
+			ModerateMinutes: 10,
+			HeavyMinutes:    20,
+			SevereMinutes:   40,
+		},
+	}
+
+	global := roads.CongestionThresholdsFor(config.MonitoredRoad{})
+	if got := classifyCongestionByDelay(5, global); got != "light" {
+		t.Errorf("roads-wide: delay 5 = %q, want light (default would be moderate)", got)
+	}
+	if got := classifyCongestionByDelay(25, global); got != "heavy" {
+		t.Errorf("roads-wide: delay 25 = %q, want heavy (default would be severe)", got)
+	}
+
+	perRoad := roads.CongestionThresholdsFor(config.MonitoredRoad{
+		CongestionThresholds: config.CongestionThresholds{SevereMinutes: 25},
+	})
+	if perRoad.HeavyMinutes != 20 {
+		t.Errorf("per-road heavy = %d, want 20 inherited from roads-wide", perRoad.HeavyMinutes)
+	}
+	if got := classifyCongestionByDelay(25, perRoad); got != "severe" {
+		t.Errorf("per-road: delay 25 = %q, want severe", got)
+	}
+}
+
+// TestBuildRouteFromMonitoredRoad_MaxDistance verifies a per-road NEARBY radius
+// flows through to classification: an incident ~8km off the route is DISTANT
+// with the 5km default but NEARBY for a road configured with 10km.
+func TestBuildRouteFromMonitoredRoad_MaxDistance(t *testing.T) {
+	ctx := context.Background()
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: geo.NewGeoUtils()}
+	road := config.MonitoredRoad{
+		ID:          "hwy4-arnold-bearvalley",
+		Name:        "Hwy 4",
+		Origin:      config.Coordinates{Latitude: 38.265006, Longitude: -120.333654},
+		Destination: config.Coordinates{Latitude: 38.461045, Longitude: -120.042368},
+	}
+	// Due south of Arnold, ~8km from the straight-line fallback route.
+	alert := routing.UnclassifiedAlert{
+		ID:       "test",
+		Location: geo.Point{Latitude: 38.193, Longitude: -120.3337},
+		Type:     "incident",
+	}
+
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+	if route.MaxDistance != config.DefaultMaxDistanceMeters {
+		t.Fatalf("default MaxDistance = %v, want %v", route.MaxDistance, config.DefaultMaxDistanceMeters)
+	}
+	classified, err := s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if classified.Classification != routing.Distant {
+		t.Errorf("default radius: classification = %v (distance %.0fm), want distant",
+			classified.Classification, classified.DistanceToRoute)
+	}
+
+	road.MaxDistanceMeters = 10000
+	route = s.buildRouteFromMonitoredRoad(ctx, road, "")
+	classified, err = s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if classified.Classification != routing.Nearby {
+		t.Errorf("10km radius: classification = %v (distance %.0fm), want nearby",
+			classified.Classification, classified.DistanceToRoute)
+	}
+}
+
+// listRoadsFixture returns a RoadsService whose roads cache holds four roads in
+// (non-alphabetical) configured order.
+func listRoadsFixture(t *testing.T) *RoadsService {
+	t.Helper()
+	c := cache.NewCache()
+	roads := []*api.Road{
+		{Id: "hwy4-angels-murphys", Status: api.RoadStatus_OPEN},
+		{Id: "hwy4-murphys-arnold", Status: api.RoadStatus_CLOSED},
+		{Id: "hwy4-arnold-bearvalley", Status: api.RoadStatus_RESTRICTED},
+		{Id: "hwy49-angels-sonora", Status: api.RoadStatus_OPEN},
+	}
+	if err := c.Set("roads:all", roads, time.Hour, "roads"); err != nil {
+		t.Fatal(err)
+	}
+	return &RoadsService{cache: c, config: &config.Config{}}
+}
+
+func roadIDs(roads []*api.Road) []string {
+	ids := make([]string, len(roads))
+	for i, r := range roads {
+		ids[i] = r.Id
+	}
+	return ids
+}
+
+func TestListRoads_Pagination(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := listRoadsFixture(t)
+
+	// Unpaged requests keep configured order and return no token.
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := roadIDs(resp.Roads); len(got) != 4 || got[0] != "hwy4-angels-murphys" || got[1] != "hwy4-murphys-arnold" {
+		t.Errorf("unpaged order = %v, want configured order", got)
+	}
+	if resp.NextPageToken != "" {
+		t.Errorf("unpaged next_page_token = %q, want empty", resp.NextPageToken)
+	}
+
+	// Paged requests walk roads sorted by id.
+	var pages [][]string
+	token := ""
+	for i := 0; i < 5; i++ {
+		resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{PageSize: 3, PageToken: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, roadIDs(resp.Roads))
+		token = resp.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+	if len(pages) != 2 {
+		t.Fatalf("got %d pages %v, want 2", len(pages), pages)
+	}
+	want := []string{"hwy4-angels-murphys", "hwy4-arnold-bearvalley", "hwy4-murphys-arnold"}
+	for i, id := range want {
+		if pages[0][i] != id {
+			t.Errorf("page 1 = %v, want %v", pages[0], want)
+			break
+		}
+	}
+	if len(pages[1]) != 1 || pages[1][0] != "hwy49-angels-sonora" {
+		t.Errorf("page 2 = %v, want [hwy49-angels-sonora]", pages[1])
+	}
+
+	// A page that ends exactly on the last road returns no token.
+	resp, err = s.ListRoads(ctx, &api.ListRoadsRequest{PageSize: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Roads) != 4 || resp.NextPageToken != "" {
+		t.Errorf("page_size=4: %d roads, token %q; want 4 and no token", len(resp.Roads), resp.NextPageToken)
+	}
+
+	if _, err := s.ListRoads(ctx, &api.ListRoadsRequest{PageSize: 2, PageToken: "not base64!"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("bad token error = %v, want InvalidArgument", err)
+	}
+	if _, err := s.ListRoads(ctx, &api.ListRoadsRequest{PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("negative page_size error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestListRoads_StatusFilter(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := listRoadsFixture(t)
+
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{
+		StatusFilter: []api.RoadStatus{api.RoadStatus_CLOSED, api.RoadStatus_RESTRICTED},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := roadIDs(resp.Roads)
+	if len(got) != 2 || got[0] != "hwy4-murphys-arnold" || got[1] != "hwy4-arnold-bearvalley" {
+		t.Errorf("filtered roads = %v, want the closed and restricted roads", got)
+	}
+
+	// Filtering composes with paging.
+	resp, err = s.ListRoads(ctx, &api.ListRoadsRequest{
+		PageSize:     1,
+		StatusFilter: []api.RoadStatus{api.RoadStatus_CLOSED, api.RoadStatus_RESTRICTED},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := roadIDs(resp.Roads); len(got) != 1 || got[0] != "hwy4-arnold-bearvalley" || resp.NextPageToken == "" {
+		t.Errorf("filtered page 1 = %v token %q, want [hwy4-arnold-bearvalley] with a token", got, resp.NextPageToken)
+	}
+}
+
+func TestGetRoad_PerRoadCache(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	s := &RoadsService{cache: cache.NewCache(), config: cfg}
+	if err := s.cacheRoads(ctx, []*api.Road{
+		{Id: "hwy4-angels-murphys", Status: api.RoadStatus_OPEN, DurationMinutes: 12},
+		{Id: "hwy4-murphys-arnold", Status: api.RoadStatus_CLOSED, StatusExplanation: "Closed for paving"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "hwy4-murphys-arnold"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(resp.Road, list.Roads[1]) {
+		t.Errorf("GetRoad = %v, want the ListRoads entry %v", resp.Road, list.Roads[1])
+	}
+	if resp.LastUpdated == nil {
+		t.Error("last_updated is unset")
+	}
+	if _, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "hwy88"}); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown road error = %v, want NotFound", err)
+	}
+
+	// A hit doesn't read the full set: with roads:all gone there is nothing to
+	// refresh it from, so only the road's own entry can answer
+	s.cache.Delete("roads:all")
+	resp, err = s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "hwy4-angels-murphys"})
+	if err != nil {
+		t.Fatalf("GetRoad rebuilt the road list: %v", err)
+	}
+	if resp.Road.DurationMinutes != 12 {
+		t.Errorf("road = %v, want hwy4-angels-murphys", resp.Road)
+	}
+}
+
+func TestListRoads_AlertFilters(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	s := &RoadsService{cache: cache.NewCache(), config: cfg}
+	if err := s.cacheRoads(ctx, []*api.Road{{Id: "hwy4-murphys-arnold", Alerts: []*api.RoadAlert{
+		{Id: "info-on-route", Severity: api.AlertSeverity_INFO, Classification: api.AlertClassification_ON_ROUTE},
+		{Id: "critical-on-route", Severity: api.AlertSeverity_CRITICAL, Classification: api.AlertClassification_ON_ROUTE},
+		{Id: "warning-nearby", Severity: api.AlertSeverity_WARNING, Classification: api.AlertClassification_NEARBY},
+		{Id: "warning-on-route", Severity: api.AlertSeverity_WARNING, Classification: api.AlertClassification_ON_ROUTE},
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	alertIDs := func(road *api.Road) []string {
+		var ids []string
+		for _, alert := range road.Alerts {
+			ids = append(ids, alert.Id)
+		}
+		return ids
+	}
+
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{
+		MinSeverity:    api.AlertSeverity_WARNING,
+		Classification: []api.AlertClassification{api.AlertClassification_ON_ROUTE},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alertIDs(resp.Roads[0]); len(got) != 2 || got[0] != "critical-on-route" || got[1] != "warning-on-route" {
+		t.Errorf("filtered alerts = %v, want the WARNING and CRITICAL ON_ROUTE alerts", got)
+	}
+
+	road, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "hwy4-murphys-arnold", MinSeverity: api.AlertSeverity_CRITICAL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alertIDs(road.Road); len(got) != 1 || got[0] != "critical-on-route" {
+		t.Errorf("GetRoad alerts = %v, want only the CRITICAL alert", got)
+	}
+
+	// Defaults return everything, and filtering didn't change the cached roads
+	resp, err = s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alertIDs(resp.Roads[0]); len(got) != 4 {
+		t.Errorf("unfiltered alerts = %v, want all 4", got)
+	}
+}
+
+func TestListRoads_Since(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	s := &RoadsService{cache: cache.NewCache(), config: cfg}
+	refresh := func(murphysArnold api.RoadStatus) []*api.Road {
+		return []*api.Road{
+			{Id: "hwy4-angels-murphys", Status: api.RoadStatus_OPEN, Alerts: []*api.RoadAlert{{Id: "a", LastSeen: timestamppb.Now()}}},
+			{Id: "hwy4-murphys-arnold", Status: murphysArnold},
+		}
+	}
+
+	// Both roads first seen an hour ago
+	earlier := time.Now().Add(-time.Hour).Truncate(time.Second)
+	first := refresh(api.RoadStatus_OPEN)
+	s.recordRoadChanges(ctx, first, earlier)
+	if err := s.cacheRoads(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+
+	// Murphys-Arnold closes; Angels-Murphys only has its alert seen again
+	if err := s.cacheRoads(ctx, refresh(api.RoadStatus_CLOSED)); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{Since: timestamppb.New(earlier.Add(time.Minute))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := roadIDs(resp.Roads); len(got) != 1 || got[0] != "hwy4-murphys-arnold" {
+		t.Errorf("roads changed since = %v, want only the closed road", got)
+	}
+	if resp.ServerTime == nil || resp.ServerTime.AsTime().Before(resp.Roads[0].ChangedAt.AsTime()) {
+		t.Errorf("server_time %v is before the change it returned (%v)", resp.ServerTime, resp.Roads[0].ChangedAt)
+	}
+
+	// Nothing changed since the server_time of that poll
+	resp, err = s.ListRoads(ctx, &api.ListRoadsRequest{Since: resp.ServerTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Roads) != 0 {
+		t.Errorf("roads changed since the last poll = %v, want none", roadIDs(resp.Roads))
+	}
+
+	// Without since every road is returned, with its changed_at
+	resp, err = s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Roads) != 2 || !resp.Roads[0].ChangedAt.AsTime().Equal(earlier) {
+		t.Errorf("roads = %v, want both with the unchanged one still at %v", resp.Roads, earlier)
+	}
+}
+
+// pollingStore runs beforeSet just before each write, standing in for a
+// request that arrives while a refresh is being cached
+type pollingStore struct {
+	*cache.Cache
+	beforeSet func(key string)
+}
+
+func (p pollingStore) Set(key string, data interface{}, refreshInterval time.Duration, source string) error {
+	if p.beforeSet != nil {
+		p.beforeSet(key)
+	}
+	return p.Cache.Set(key, data, refreshInterval, source)
+}
+
+func TestListRoads_SinceDuringCaching(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	store := &pollingStore{Cache: cache.NewCache()}
+	s := &RoadsService{cache: store, config: cfg}
+	if err := s.cacheRoads(ctx, []*api.Road{{Id: "hwy4-murphys-arnold", Status: api.RoadStatus_OPEN}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Poll after the closure is stamped with changed_at but before it
+	// replaces roads:all: the poll still sees the open road
+	var during *api.ListRoadsResponse
+	store.beforeSet = func(key string) {
+		if key != "roads:all" {
+			return
+		}
+		resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		during = resp
+	}
+	if err := s.cacheRoads(ctx, []*api.Road{{Id: "hwy4-murphys-arnold", Status: api.RoadStatus_CLOSED}}); err != nil {
+		t.Fatal(err)
+	}
+	store.beforeSet = nil
+	if during == nil || during.Roads[0].Status != api.RoadStatus_OPEN {
+		t.Fatalf("poll during caching = %v, want the open road", during)
+	}
+
+	// Polling since that response's server_time returns the closure
+	resp, err := s.ListRoads(ctx, &api.ListRoadsRequest{Since: during.ServerTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Roads) != 1 || resp.Roads[0].Status != api.RoadStatus_CLOSED {
+		t.Errorf("roads changed since the poll = %v, want the closed road", resp.Roads)
+	}
+}
+
+func TestListRoads_Units(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.RefreshInterval = time.Hour
+	s := &RoadsService{cache: cache.NewCache(), config: cfg}
+	road := &api.Road{Id: "hwy4-murphys-arnold", DistanceKm: 16, Alerts: []*api.RoadAlert{
+		{Id: "chain-control", DistanceToRouteMeters: 1609.344},
+	}}
+	setRoadUnits(road, roadUnitsMetric)
+	if err := s.cacheRoads(ctx, []*api.Road{road}); err != nil {
+		t.Fatal(err)
+	}
+	near := func(got, want float64) bool { return math.Abs(got-want) < 1e-4 }
+	check := func(name string, road *api.Road, units string, distance, toRoute float64) {
+		t.Helper()
+		if road.Units != units || !near(float64(road.Distance), distance) || !near(road.Alerts[0].DistanceToRoute, toRoute) {
+			t.Errorf("%s: %s road distance %v, alert %v; want %s %v and %v",
+				name, road.Units, road.Distance, road.Alerts[0].DistanceToRoute, units, distance, toRoute)
+		}
+	}
+
+	list, err := s.ListRoads(ctx, &api.ListRoadsRequest{Units: "imperial"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("ListRoads imperial", list.Roads[0], roadUnitsImperial, 16/kmPerMile, 1)
+	got, err := s.GetRoad(ctx, &api.GetRoadRequest{RoadId: "hwy4-murphys-arnold", Units: "imperial"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("GetRoad imperial", got.Road, roadUnitsImperial, 16/kmPerMile, 1)
+
+	// Metric stays the default, and the cached road wasn't converted
+	list, err = s.ListRoads(ctx, &api.ListRoadsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("ListRoads default", list.Roads[0], roadUnitsMetric, 16, 1.609344)
+
+	if _, err := s.ListRoads(ctx, &api.ListRoadsRequest{Units: "furlongs"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown units: error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetProcessingMetrics(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	// No enhancer (or one without usage tracking) -> 501 rather than zeros
+	s := &RoadsService{}
+	if _, err := s.GetProcessingMetrics(ctx, &api.GetProcessingMetricsRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("err = %v, want Unimplemented", err)
+	}
+
+	s.alertEnhancer = alerts.NewAlertEnhancer("", "gpt-4o-mini")
+	if _, err := s.alertEnhancer.EnhanceAlert(ctx, alerts.RawAlert{ID: "a"}); err == nil {
+		t.Fatal("enhancer without a key should fail")
+	}
+
+	metrics, err := s.GetProcessingMetrics(ctx, &api.GetProcessingMetricsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.EnhancementFailures != 1 || metrics.EnhancedAlerts != 0 {
+		t.Errorf("failures=%d enhanced=%d, want 1 and 0", metrics.EnhancementFailures, metrics.EnhancedAlerts)
+	}
+}
+
+// arnold is a point on Hwy 4 for alerts whose position doesn't matter
+var arnold = geo.Point{Latitude: 38.2552, Longitude: -120.3513}
+
+// stubEnhancer returns a fixed enhancement for any alert
+type stubEnhancer struct{}
+
+func (stubEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	return alerts.EnhancedAlert{ID: raw.ID, OriginalDescription: raw.Description, CondensedSummary: "Chains required"}, nil
+}
+
+func (stubEnhancer) HealthCheck(ctx context.Context) error { return nil }
+
+func TestEnhanceAlertWithAI_ConfiguredTTL(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{Roads: config.RoadsConfig{
+		EnhancedAlertTTL:       time.Hour,
+		EnhancedAlertTTLByType: map[string]time.Duration{"weather": 50 * time.Millisecond},
+	}}
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        cfg,
+		alertEnhancer: stubEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+
+	enhance := func(alertType, description string) string {
+		alert := routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID: description, Title: "SR-4", Description: description, Type: alertType, Location: arnold,
+		}}
+		if _, err := s.EnhanceAlertWithAI(ctx, alert); err != nil {
+			t.Fatal(err)
+		}
+		return "enhanced_alert:" + s.contentHasher.HashRawAlert(alerts.RawAlert{
+			ID: alert.ID, Title: alert.Title, Description: alert.Description,
+			Location: "SR-4 (38.2552, -120.3513)",
+		})
+	}
+
+	chainKey := enhance("weather", "R2 chain controls in effect")
+	incidentKey := enhance("incident", "Vehicle in ditch")
+	if s.cache.IsStale(chainKey) || s.cache.IsStale(incidentKey) {
+		t.Fatal("freshly enhanced alerts should not be stale")
+	}
+
+	time.Sleep(80 * time.Millisecond)
+	if !s.cache.IsStale(chainKey) {
+		t.Error("chain control alert should be stale after its 50ms override")
+	}
+	if s.cache.IsStale(incidentKey) {
+		t.Error("incident alert should use the 1h global TTL")
+	}
+}
+
+// languageEnhancer summarizes in the languages it is asked for
+type languageEnhancer struct{}
+
+func (languageEnhancer) EnhanceAlert(ctx context.Context, raw alerts.RawAlert) (alerts.EnhancedAlert, error) {
+	summaries := map[string]string{alerts.DefaultSummaryLanguage: "Vehicle in ditch"}
+	for _, lang := range raw.Languages {
+		summaries[lang] = "Vehicle in ditch (" + lang + ")"
+	}
+	return alerts.EnhancedAlert{ID: raw.ID, CondensedSummary: "Vehicle in ditch", Summaries: summaries}, nil
+}
+
+func (languageEnhancer) HealthCheck(ctx context.Context) error { return nil }
+
+func TestEnhanceAlertWithAI_SummaryLanguages(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: languageEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+	alert := routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+		ID: "1", Title: "SR-4", Description: "Vehicle in ditch", Type: "incident", Location: arnold,
+	}}
+
+	if _, err := s.EnhanceAlertWithAI(ctx, alert); err != nil {
+		t.Fatal(err)
+	}
+
+	// Adding Spanish doesn't serve the cached English-only enhancement
+	s.config = &config.Config{OpenAI: config.OpenAIClient{SummaryLanguages: []string{"es"}}}
+	enhanced, err := s.EnhanceAlertWithAI(ctx, alert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := enhanced.Summaries["es"]; !ok {
+		t.Errorf("summaries = %v, want the configured Spanish translation", enhanced.Summaries)
+	}
+}
+
+func TestEnhanceAlertWithAI_InvalidLocation(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{
+		cache:         cache.NewCache(),
+		config:        &config.Config{},
+		alertEnhancer: stubEnhancer{},
+		contentHasher: alerts.NewContentHasher(),
+	}
+
+	// The feed reported no position, so there is no content hash to cache under
+	enhanced, err := s.EnhanceAlertWithAI(ctx, routing.ClassifiedAlert{UnclassifiedAlert: routing.UnclassifiedAlert{
+		ID: "1", Title: "SR-4", Description: "Vehicle in ditch", Type: "incident",
+	}})
+	if err != nil || enhanced != nil {
+		t.Errorf("got %v, %v; want the raw alert served", enhanced, err)
+	}
+	if got := s.cache.Stats().TotalEntries; got != 0 {
+		t.Errorf("cached %d entries, want none", got)
+	}
+
+	// Incidents without a log number still get an ID
+	in := caltrans.CaltransIncident{Name: "Lane Closure", DescriptionText: "Lane closed", Coordinates: &api.Coordinates{}}
+	if id := incidentID(in, ""); len(id) != 16 {
+		t.Errorf("id = %q, want a content hash", id)
+	}
+}
+
+func TestEnhancedAlertCacheTTL_Default(t *testing.T) {
+	if got := (config.RoadsConfig{}).EnhancedAlertCacheTTL("closure"); got != config.DefaultEnhancedAlertTTL {
+		t.Errorf("ttl = %v, want %v", got, config.DefaultEnhancedAlertTTL)
+	}
+}
+
+func TestIncidentToUnclassifiedAlert_StableID(t *testing.T) {
+	s := &RoadsService{}
+	chp := caltrans.CaltransIncident{
+		FeedType:        caltrans.CHP_INCIDENT,
+		Name:            "CHP Incident 250911GG0206",
+		DescriptionText: "Traffic collision, no injuries",
+		StyleUrl:        "#chp",
+		Coordinates:     &api.Coordinates{Latitude: 38.25, Longitude: -120.35},
+		LastFetched:     time.Now(),
+	}
+
+	alert := s.incidentToUnclassifiedAlert(chp)
+	if alert.ID != "250911GG0206" {
+		t.Errorf("ID = %q, want the CHP log number", alert.ID)
+	}
+	if alert.Title != chp.Name || alert.Description != chp.DescriptionText || alert.StyleUrl != "#chp" || alert.Type != "incident" {
+		t.Errorf("alert = %+v", alert)
+	}
+	if alert.Location.Latitude != 38.25 || alert.Location.Longitude != -120.35 {
+		t.Errorf("location = %+v", alert.Location)
+	}
+
+	// Without a log number the ID comes from the content, not fetch time
+	closure := caltrans.CaltransIncident{
+		FeedType:        caltrans.LANE_CLOSURE,
+		Name:            "Lane Closure SR-4",
+		DescriptionText: "One lane closed for paving",
+		Coordinates:     &api.Coordinates{Latitude: 38.3, Longitude: -120.2},
+		AffectedArea:    &api.Polyline{Points: []*api.Coordinates{{Latitude: 38.3, Longitude: -120.2}, {Latitude: 38.31, Longitude: -120.21}}},
+		LastFetched:     time.Now(),
+	}
+	refetched := closure
+	refetched.LastFetched = closure.LastFetched.Add(10 * time.Minute)
+	first, second := s.incidentToUnclassifiedAlert(closure), s.incidentToUnclassifiedAlert(refetched)
+	if first.ID == "" || first.ID != second.ID {
+		t.Errorf("IDs across fetches = %q and %q, want the same ID", first.ID, second.ID)
+	}
+	changed := refetched
+	changed.DescriptionText = "Both lanes closed for paving"
+	if id := s.incidentToUnclassifiedAlert(changed).ID; id == first.ID {
+		t.Errorf("changed closure kept ID %q, want a new one", id)
+	}
+	moved := refetched
+	moved.Coordinates = &api.Coordinates{Latitude: 38.35, Longitude: -120.2}
+	if id := s.incidentToUnclassifiedAlert(moved).ID; id == first.ID {
+		t.Errorf("closure with the same name elsewhere shares ID %q", id)
+	}
+	if first.AffectedPolyline == nil || len(first.AffectedPolyline.Points) != 2 {
+		t.Errorf("affected polyline = %+v, want 2 points", first.AffectedPolyline)
+	}
+
+	// Missing coordinates shouldn't panic
+	noCoords := closure
+	noCoords.Coordinates = nil
+	_ = s.incidentToUnclassifiedAlert(noCoords)
+}
+
+func TestNewRoadsService_OnRouteThreshold(t *testing.T) {
+	ctx := context.Background()
+	road := config.MonitoredRoad{
+		ID:          "test-road",
+		Origin:      config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination: config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+	}
+	// ~150m north of the route
+	alert := routing.UnclassifiedAlert{ID: "test", Location: geo.Point{Latitude: 38.00135, Longitude: -120.02}, Type: "incident"}
+
+	classify := func(cfg *config.Config) routing.AlertClassification {
+		s := NewRoadsService(nil, nil, cache.NewCache(), cfg, nil, nil)
+		route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+		classified, err := s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{route})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return classified.Classification
+	}
+
+	if got := classify(&config.Config{}); got != routing.Nearby {
+		t.Errorf("default threshold: classification = %v, want nearby", got)
+	}
+	cfg := &config.Config{}
+	cfg.Roads.OnRouteThresholdMeters = 250
+	if got := classify(cfg); got != routing.OnRoute {
+		t.Errorf("250m threshold: classification = %v, want on_route", got)
+	}
+}
+
+func TestProcessGlobalAlerts_SkipsExpiredIncidents(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: geo.NewGeoUtils()}
+	road := config.MonitoredRoad{
+		ID:          "test-road",
+		Origin:      config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination: config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+	}
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+
+	onRoute := &api.Coordinates{Latitude: 38.0, Longitude: -120.02}
+	incidents := []caltrans.CaltransIncident{
+		{
+			FeedType:        caltrans.LANE_CLOSURE,
+			Name:            "Lane Closure ended",
+			DescriptionText: "Expected to end at 3:01pm Sep 11, 2025",
+			Coordinates:     onRoute,
+			ParsedEndTime:   time.Now().Add(-time.Hour),
+		},
+		{
+			FeedType:        caltrans.LANE_CLOSURE,
+			Name:            "Lane Closure open-ended",
+			DescriptionText: "Due to Bridge Work",
+			Coordinates:     onRoute,
+		},
+	}
+
+	alertsByRoute, err := s.processGlobalAlerts(ctx, incidents, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := alertsByRoute[road.ID]
+	if len(got) != 1 || got[0].Title != "Lane Closure open-ended" {
+		t.Errorf("classified alerts = %+v, want only the open-ended closure", got)
+	}
+}
+
+func TestProcessGlobalAlerts_MergesCrossFeedDuplicates(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: geo.NewGeoUtils()}
+	road := config.MonitoredRoad{
+		ID:          "test-road",
+		Origin:      config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination: config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+	}
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+
+	// One collision, placed a few meters apart by the two feeds
+	incidents := []caltrans.CaltransIncident{
+		{
+			FeedType:        caltrans.LANE_CLOSURE,
+			Name:            "Lane Closure",
+			DescriptionText: "SR-4 eastbound, one lane closed",
+			Coordinates:     &api.Coordinates{Latitude: 38.00002, Longitude: -120.02001},
+		},
+		{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0001",
+			DescriptionText: "1183-Trfc Collision-Unkn Inj, Hwy 4 eastbound at Sheep Ranch Rd, fire department responding",
+			Coordinates:     &api.Coordinates{Latitude: 38.00004, Longitude: -120.02003},
+		},
+		{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0002",
+			DescriptionText: "1125-Traffic Hazard, SR-4 westbound",
+			Coordinates:     &api.Coordinates{Latitude: 38.0, Longitude: -120.04},
+		},
+	}
+
+	alertsByRoute, err := s.processGlobalAlerts(ctx, incidents, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := alertsByRoute[road.ID]
+	if len(got) != 2 {
+		t.Fatalf("got %d alerts, want the merged collision and the hazard: %+v", len(got), got)
+	}
+	merged := got[0]
+	if merged.ID != "251016ST0001" {
+		t.Errorf("merged alert = %s, want the more severe CHP report", merged.ID)
+	}
+	if len(merged.SourceFeeds) != 2 || merged.SourceFeeds[0] != "closure" || merged.SourceFeeds[1] != "incident" {
+		t.Errorf("source feeds = %v, want [closure incident]", merged.SourceFeeds)
+	}
+	if got[1].SourceFeeds != nil {
+		t.Errorf("unmerged alert has source feeds %v", got[1].SourceFeeds)
+	}
+
+	alert, _, err := s.buildEnhancedRoadAlert(ctx, merged, road)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alert.Metadata["source_feeds"]; got != "closure,incident" {
+		t.Errorf("source_feeds metadata = %q, want closure,incident", got)
+	}
+}
+
+func TestMergeCrossFeedDuplicates_AcrossCellBoundary(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{}
+	report := func(feedType caltrans.CaltransFeedType, lat, lon float64) caltrans.CaltransIncident {
+		return caltrans.CaltransIncident{
+			FeedType:        feedType,
+			Name:            "SR-4 incident",
+			DescriptionText: "SR-4 eastbound, one lane closed",
+			Coordinates:     &api.Coordinates{Latitude: lat, Longitude: lon},
+		}
+	}
+
+	// A few meters apart, but rounding to different grid cells
+	got := s.mergeCrossFeedDuplicates(ctx, []caltrans.CaltransIncident{
+		report(caltrans.LANE_CLOSURE, 38.00049, -120.02049),
+		report(caltrans.CHP_INCIDENT, 38.00051, -120.02051),
+	})
+	if len(got) != 1 {
+		t.Errorf("got %d alerts, want the two reports either side of a cell boundary merged", len(got))
+	}
+
+	// In neighbouring cells, but too far apart to be one event
+	got = s.mergeCrossFeedDuplicates(ctx, []caltrans.CaltransIncident{
+		report(caltrans.LANE_CLOSURE, 38.00051, -120.02),
+		report(caltrans.CHP_INCIDENT, 38.00249, -120.02),
+	})
+	if len(got) != 2 {
+		t.Errorf("got %d alerts, want reports ~220m apart kept separate", len(got))
+	}
+}
+
+func TestProcessGlobalAlerts_IgnoredIncidentPatterns(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.IgnoredIncidentPatterns = []string{`traffic hazard animal`, `^assist with construction`}
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: geo.NewGeoUtils(), config: cfg}
+	road := config.MonitoredRoad{
+		ID:          "test-road",
+		Origin:      config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination: config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+	}
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+
+	incident := func(n int, description string) caltrans.CaltransIncident {
+		return caltrans.CaltransIncident{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            fmt.Sprintf("CHP Incident 251016ST000%d", n),
+			DescriptionText: description,
+			Coordinates:     &api.Coordinates{Latitude: 38.0, Longitude: -120.01 - float64(n)*0.005},
+		}
+	}
+	incidents := []caltrans.CaltransIncident{
+		incident(1, "1125-Traffic Hazard-Animal, SR-4 at Six Mile Rd"),
+		incident(2, "1125-Traffic Hazard - ANIMAL, deer in roadway"),
+		incident(3, "1125-Traffic Hazard, rock slide"),
+		incident(4, "CZP-Assist with Construction"),
+		incident(5, "1182-Trfc Collision-No Inj, assist with construction traffic"),
+	}
+
+	alertsByRoute, err := s.processGlobalAlerts(ctx, incidents, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, alert := range alertsByRoute[road.ID] {
+		ids = append(ids, alert.ID)
+	}
+	if len(ids) != 2 || ids[0] != "251016ST0003" || ids[1] != "251016ST0005" {
+		t.Errorf("alerts = %v, want only the rock slide and the collision", ids)
+	}
+}
+
+func TestBuildEnhancedRoadAlert_LanesAffected(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{}
+	classified := routing.ClassifiedAlert{
+		UnclassifiedAlert: routing.UnclassifiedAlert{
+			Title:       "CHP Incident 251016ST0001",
+			Description: "Traffic Collision - No Details, BLOCKING 1 LN",
+			Type:        "incident",
+		},
+		Classification: routing.OnRoute,
+	}
+
+	alert, _, err := s.buildEnhancedRoadAlert(ctx, classified, config.MonitoredRoad{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := alert.Metadata["lanes_affected"]; got != "1" {
+		t.Errorf("lanes_affected = %q, want 1 without AI enhancement", got)
+	}
+}
+
+func TestBuildEnhancedRoadAlert_KeywordSeverity(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{}
+
+	tests := []struct {
+		description    string
+		classification routing.AlertClassification
+		expected       api.AlertSeverity
+	}{
+		{"Vehicle overturned, 1144-Fatality", routing.OnRoute, api.AlertSeverity_CRITICAL},
+		{"1125-Traffic Hazard, motorist assist", routing.OnRoute, api.AlertSeverity_INFO},
+		{"Traffic collision, no injuries", routing.OnRoute, api.AlertSeverity_WARNING},
+		{"Vehicle overturned, 1144-Fatality", routing.Distant, api.AlertSeverity_INFO},
+	}
+
+	for _, tt := range tests {
+		// The parser rates the incident; the alert keeps that rating
+		incident := caltrans.CaltransIncident{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0002",
+			DescriptionText: tt.description,
+			Severity:        caltrans.ClassifySeverity(tt.description),
+		}
+		classified := routing.ClassifiedAlert{
+			UnclassifiedAlert: s.incidentToUnclassifiedAlert(incident),
+			Classification:    tt.classification,
+		}
+		alert, _, err := s.buildEnhancedRoadAlert(ctx, classified, config.MonitoredRoad{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if alert.Severity != tt.expected {
+			t.Errorf("%q (%v): severity = %v, want %v without AI enhancement", tt.description, tt.classification, alert.Severity, tt.expected)
+		}
+	}
+}
+
+func TestBuildEnhancedRoadAlert_CHPTimestamps(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{}
+	classified := routing.ClassifiedAlert{
+		UnclassifiedAlert: routing.UnclassifiedAlert{
+			Title:       "CHP Incident 250911SA0438",
+			Description: "Sep 11 2025 10:41AM 1125-Traffic Hazard Sr65 S / Blue Oaks Blvd E Onr Sep 11 2025 10:42AM [1] TIRE TREAD IN 1 LN Last updated: 09/11/2025 11:00am",
+			Type:        "incident",
+		},
+		Classification: routing.OnRoute,
+	}
+
+	alert, _, err := s.buildEnhancedRoadAlert(ctx, classified, config.MonitoredRoad{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStart := time.Date(2025, 9, 11, 17, 41, 0, 0, time.UTC)
+	if alert.StartTime == nil || !alert.StartTime.AsTime().Equal(wantStart) {
+		t.Errorf("StartTime = %v, want %v without AI enhancement", alert.StartTime.AsTime(), wantStart)
+	}
+	wantUpdated := time.Date(2025, 9, 11, 18, 0, 0, 0, time.UTC)
+	if alert.LastUpdated == nil || !alert.LastUpdated.AsTime().Equal(wantUpdated) {
+		t.Errorf("LastUpdated = %v, want %v without AI enhancement", alert.LastUpdated.AsTime(), wantUpdated)
+	}
+}
+
+func TestNewRoadsService_ClosureOverlap(t *testing.T) {
+	ctx := context.Background()
+	road := config.MonitoredRoad{
+		ID:          "test-road",
+		Origin:      config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination: config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+	}
+	// A closure that runs ~350m alongside the route, ~150m north of it, then
+	// turns away for 4km: about 8% of its length runs along the route
+	closure := routing.UnclassifiedAlert{
+		ID:       "test",
+		Location: geo.Point{Latitude: 38.00135, Longitude: -120.02},
+		Type:     "closure",
+		AffectedPolyline: &geo.Polyline{Points: []geo.Point{
+			{Latitude: 38.00135, Longitude: -120.0200},
+			{Latitude: 38.00135, Longitude: -120.0240},
+			{Latitude: 38.0374, Longitude: -120.0240},
+		}},
+	}
+
+	classify := func(cfg *config.Config) routing.AlertClassification {
+		s := NewRoadsService(nil, nil, cache.NewCache(), cfg, nil, nil)
+		route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+		classified, err := s.routeMatcher.ClassifyAlert(ctx, closure, []routing.Route{route})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return classified.Classification
+	}
+
+	if got := classify(&config.Config{}); got != routing.Nearby {
+		t.Errorf("default 10%% cutoff: classification = %v, want nearby", got)
+	}
+	cfg := &config.Config{}
+	cfg.Roads.ClosureOverlapPercent = 5
+	if got := classify(cfg); got != routing.OnRoute {
+		t.Errorf("5%% cutoff: classification = %v, want on_route", got)
+	}
+	cfg.Roads.ClosureOverlapMeters = 120
+	if got := classify(cfg); got != routing.Nearby {
+		t.Errorf("5%% cutoff within 120m: classification = %v, want nearby", got)
+	}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// routeGeometryCacheKey holds every road's routeGeometry from the last refresh
+const routeGeometryCacheKey = "route_geometry:all"
+
+// routeGeometry is the route and classified alerts a road was built from,
+// plus Google's traffic speeds along the route polyline
+type routeGeometry struct {
+	Route         routing.Route             `json:"route"`
+	Alerts        []routing.ClassifiedAlert `json:"alerts"`
+	SpeedReadings []google.SpeedReading     `json:"speed_readings,omitempty"`
+}
+
+// cacheRouteGeometry stores the routes, alert classifications and speed
+// readings from a refresh so GetRouteGeometry serves the same geometry the
+// roads were built from
+func (s *RoadsService) cacheRouteGeometry(ctx context.Context, routes map[string]routing.Route, alertsByRoute map[string][]routing.ClassifiedAlert, readingsByRoad map[string][]google.SpeedReading) {
+	geometry := make(map[string]routeGeometry, len(routes))
+	for roadID, route := range routes {
+		geometry[roadID] = routeGeometry{Route: route, Alerts: alertsByRoute[route.ID], SpeedReadings: readingsByRoad[roadID]}
+	}
+	if err := s.cache.Set(routeGeometryCacheKey, geometry, s.cfg().Roads.RefreshInterval, "roads"); err != nil {
+		logging.Errorw(ctx, "Failed to cache route geometry", "error", err)
+	}
+}
+
+// GetRouteGeometry implements the gRPC method returning a road's route
+// polyline and the footprint of each alert classified against it
+func (s *RoadsService) GetRouteGeometry(ctx context.Context, req *api.GetRouteGeometryRequest) (*api.GetRouteGeometryResponse, error) {
+	logging.Infow(ctx, "GetRouteGeometry called", "road_id", req.RoadId)
+
+	if !s.isMonitoredRoad(req.RoadId) {
+		return nil, status.Errorf(codes.NotFound, "road not found: %s", req.RoadId)
+	}
+
+	// Serve cached geometry even if stale, like ListRoads
+	var geometry map[string]routeGeometry
+	entry, found, err := s.cache.GetWithMetadata(routeGeometryCacheKey, &geometry)
+	if err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", routeGeometryCacheKey)
+	}
+
+	lastUpdated := timestamppb.Now()
+	if found && entry != nil {
+		lastUpdated = timestamppb.New(entry.CreatedAt)
+	}
+	if !found {
+		// Nothing refreshed yet - perform synchronous refresh as fallback
+		logging.Info(ctx, "No cached route geometry - performing fallback refresh")
+		if _, err := s.refreshAndCache(ctx); err != nil {
+			return nil, fmt.Errorf("failed to refresh road data and no cached data available: %w", err)
+		}
+		if _, _, err := s.cache.GetWithMetadata(routeGeometryCacheKey, &geometry); err != nil {
+			return nil, fmt.Errorf("failed to read route geometry: %w", err)
+		}
+	}
+
+	road, ok := geometry[req.RoadId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no route geometry for road: %s", req.RoadId)
+	}
+
+	return s.routeGeometryResponse(req.RoadId, road, lastUpdated), nil
+}
+
+// cachedSpeedReadings returns the speed readings from a road's last Google
+// Routes result, which match the polyline the road's route was built from
+func (s *RoadsService) cachedSpeedReadings(roadID string) []google.SpeedReading {
+	var routeCache googleRouteCache
+	if _, found, err := s.cache.GetWithMetadata(fmt.Sprintf("google_routes_%s", roadID), &routeCache); err != nil || !found {
+		return nil
+	}
+	return routeCache.SpeedReadings
+}
+
+// isMonitoredRoad reports whether roadID is a configured road
+func (s *RoadsService) isMonitoredRoad(roadID string) bool {
+	for _, monitoredRoad := range s.cfg().Roads.MonitoredRoads {
+		if monitoredRoad.ID == roadID {
+			return true
+		}
+	}
+	return false
+}
+
+// routeGeometryResponse encodes a road's cached geometry for the API
+func (s *RoadsService) routeGeometryResponse(roadID string, geometry routeGeometry, lastUpdated *timestamppb.Timestamp) *api.GetRouteGeometryResponse {
+	route := geometry.Route
+	resp := &api.GetRouteGeometryResponse{
+		RoadId:          roadID,
+		EncodedPolyline: s.geoUtils.EncodePolyline(route.Polyline.Points),
+		Origin:          &api.Coordinates{Latitude: route.Origin.Latitude, Longitude: route.Origin.Longitude},
+		Destination:     &api.Coordinates{Latitude: route.Destination.Latitude, Longitude: route.Destination.Longitude},
+		LastUpdated:     lastUpdated,
+	}
+
+	for _, alert := range geometry.Alerts {
+		var affected string
+		if alert.AffectedPolyline != nil {
+			affected = alert.AffectedPolyline.EncodedPolyline
+			if affected == "" && len(alert.AffectedPolyline.Points) > 0 {
+				affected = s.geoUtils.EncodePolyline(alert.AffectedPolyline.Points)
+			}
+		}
+		resp.Alerts = append(resp.Alerts, &api.AlertGeometry{
+			Id:                    alert.ID, // Same id as the RoadAlert
+			Title:                 alert.Title,
+			Classification:        s.mapRoutingToAPIClassification(alert.Classification),
+			Location:              &api.Coordinates{Latitude: alert.Location.Latitude, Longitude: alert.Location.Longitude},
+			AffectedPolyline:      affected,
+			DistanceToRouteMeters: alert.DistanceToRoute,
+		})
+	}
+
+	resp.TrafficSegments = trafficSegments(route.Polyline.Points, geometry.SpeedReadings)
+
+	return resp
+}
+
+// trafficSegments maps speed readings onto the route polyline. Readings that
+// fall outside the polyline (e.g. the route fell back to a straight line) are
+// dropped.
+func trafficSegments(points []geo.Point, readings []google.SpeedReading) []*api.TrafficSegment {
+	var segments []*api.TrafficSegment
+	for _, r := range readings {
+		if r.StartIndex < 0 || r.StartIndex >= r.EndIndex || int(r.EndIndex) >= len(points) {
+			continue
+		}
+		start, end := points[r.StartIndex], points[r.EndIndex]
+		segments = append(segments, &api.TrafficSegment{
+			StartIndex: r.StartIndex,
+			EndIndex:   r.EndIndex,
+			Start:      &api.Coordinates{Latitude: start.Latitude, Longitude: start.Longitude},
+			End:        &api.Coordinates{Latitude: end.Latitude, Longitude: end.Longitude},
+			Speed:      mapTrafficSpeed(r.Speed),
+		})
+	}
+	return segments
+}
+
+// mapTrafficSpeed converts Google's speed category to the API enum
+func mapTrafficSpeed(speed string) api.TrafficSpeed {
+	switch speed {
+	case "NORMAL":
+		return api.TrafficSpeed_SPEED_NORMAL
+	case "SLOW":
+		return api.TrafficSpeed_SPEED_SLOW
+	case "TRAFFIC_JAM":
+		return api.TrafficSpeed_SPEED_TRAFFIC_JAM
+	default:
+		return api.TrafficSpeed_TRAFFIC_SPEED_UNSPECIFIED
+	}
+}
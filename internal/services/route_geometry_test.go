@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/google"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// TestGetRouteGeometry verifies the route polyline built during refresh is
+// returned encoded, decoding back to the same points, along with each alert's
+// affected extent.
+func TestGetRouteGeometry(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	road := config.MonitoredRoad{
+		ID:          "hwy4-arnold-bearvalley",
+		Name:        "Hwy 4",
+		Origin:      config.Coordinates{Latitude: 38.5, Longitude: -120.2},
+		Destination: config.Coordinates{Latitude: 43.252, Longitude: -126.453},
+	}
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{road}
+	cfg.Roads.RefreshInterval = time.Hour
+	s := &RoadsService{cache: cache.NewCache(), config: cfg, geoUtils: geo.NewGeoUtils()}
+
+	// Three-point Google polyline
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+	closure := routing.ClassifiedAlert{
+		UnclassifiedAlert: routing.UnclassifiedAlert{
+			ID:       "closure-1",
+			Title:    "Lane Closure",
+			Location: geo.Point{Latitude: 40.7, Longitude: -120.95},
+			Type:     "closure",
+			AffectedPolyline: &geo.Polyline{Points: []geo.Point{
+				{Latitude: 40.7, Longitude: -120.95},
+				{Latitude: 41.0, Longitude: -121.5},
+			}},
+		},
+		Classification:  routing.OnRoute,
+		RouteIDs:        []string{road.ID},
+		DistanceToRoute: 12,
+	}
+	s.cacheRouteGeometry(ctx,
+		map[string]routing.Route{road.ID: route},
+		map[string][]routing.ClassifiedAlert{road.ID: {closure}},
+		nil)
+
+	resp, err := s.GetRouteGeometry(ctx, &api.GetRouteGeometryRequest{RoadId: road.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points, err := s.geoUtils.DecodePolyline(resp.EncodedPolyline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 3 {
+		t.Errorf("route polyline decodes to %d points, want 3", len(points))
+	}
+	if resp.Origin.GetLatitude() != 38.5 || resp.Destination.GetLongitude() != -126.453 {
+		t.Errorf("origin/destination = %v/%v", resp.Origin, resp.Destination)
+	}
+
+	if len(resp.Alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(resp.Alerts))
+	}
+	alert := resp.Alerts[0]
+	if alert.Classification != api.AlertClassification_ON_ROUTE || alert.DistanceToRouteMeters != 12 {
+		t.Errorf("alert = %v", alert)
+	}
+	affected, err := s.geoUtils.DecodePolyline(alert.AffectedPolyline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(affected) != 2 {
+		t.Errorf("affected polyline decodes to %d points, want 2", len(affected))
+	}
+
+	_, err = s.GetRouteGeometry(ctx, &api.GetRouteGeometryRequest{RoadId: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unknown road: err = %v, want NotFound", err)
+	}
+}
+
+// TestGetRouteGeometry_TrafficSegments verifies Google's speed readings come
+// back as segments whose indices and endpoints line up with the decoded route
+// polyline.
+func TestGetRouteGeometry_TrafficSegments(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+
+	road := config.MonitoredRoad{ID: "seattle-portland", Name: "I-5"}
+	cfg := &config.Config{GoogleRoutes: config.GoogleRoutesClient{APIKey: "test-key"}}
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{road}
+	cfg.Roads.RefreshInterval = time.Hour
+	client := google.NewClientWithHTTPDoer("test-key", "https://routes.example.com",
+		fixtureRoutesAPI{path: "../../tests/testdata/google/seattle_portland_20250912_194108.json"})
+	client.SpeedReadings = true
+	s := NewRoadsService(client, nil, cache.NewCache(), cfg, nil, nil)
+
+	_, _, _, _, polyline, err := s.getTrafficDataWithPolyline(ctx, road)
+	if err != nil {
+		t.Fatal(err)
+	}
+	route := s.buildRouteFromMonitoredRoad(ctx, road, polyline)
+	s.cacheRouteGeometry(ctx,
+		map[string]routing.Route{road.ID: route},
+		nil,
+		map[string][]google.SpeedReading{road.ID: s.cachedSpeedReadings(road.ID)})
+
+	resp, err := s.GetRouteGeometry(ctx, &api.GetRouteGeometryRequest{RoadId: road.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, err := s.geoUtils.DecodePolyline(resp.EncodedPolyline)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.TrafficSegments) != 17 {
+		t.Fatalf("got %d traffic segments, want 17", len(resp.TrafficSegments))
+	}
+	var next int32
+	for i, segment := range resp.TrafficSegments {
+		if segment.StartIndex != next {
+			t.Errorf("segment %d starts at %d, want %d (contiguous)", i, segment.StartIndex, next)
+		}
+		next = segment.EndIndex
+		start, end := points[segment.StartIndex], points[segment.EndIndex]
+		if segment.Start.Latitude != start.Latitude || segment.Start.Longitude != start.Longitude ||
+			segment.End.Latitude != end.Latitude || segment.End.Longitude != end.Longitude {
+			t.Errorf("segment %d endpoints %v-%v don't match polyline points %v-%v", i, segment.Start, segment.End, start, end)
+		}
+	}
+	if int(next) != len(points)-1 {
+		t.Errorf("segments end at point %d, want the last polyline point %d", next, len(points)-1)
+	}
+	if got := resp.TrafficSegments[1].Speed; got != api.TrafficSpeed_SPEED_SLOW {
+		t.Errorf("segment 1 speed = %v, want SPEED_SLOW", got)
+	}
+}
+
+func TestTrafficSegments_DropsReadingsOffPolyline(t *testing.T) {
+	points := []geo.Point{{Latitude: 1, Longitude: 1}, {Latitude: 2, Longitude: 2}}
+	segments := trafficSegments(points, []google.SpeedReading{
+		{StartIndex: 0, EndIndex: 1, Speed: "TRAFFIC_JAM"},
+		{StartIndex: 1, EndIndex: 40, Speed: "NORMAL"},
+	})
+	if len(segments) != 1 || segments[0].Speed != api.TrafficSpeed_SPEED_TRAFFIC_JAM {
+		t.Errorf("segments = %v, want only the in-range TRAFFIC_JAM reading", segments)
+	}
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// decodedPolyline is a road's last decoded Google polyline
+type decodedPolyline struct {
+	encoded string
+	points  []geo.Point
+}
+
+// routePolylines memoizes each road's decoded Google polyline. The encoded
+// polyline is cached for 45 minutes (see getTrafficDataWithPolyline), so most
+// refreshes see the same string and skip decoding. Safe for concurrent use;
+// the zero value is ready to use.
+type routePolylines struct {
+	mutex  sync.Mutex
+	byRoad map[string]decodedPolyline
+}
+
+// decode returns the points of encoded, decoding only when it differs from
+// the road's last polyline. The returned slice is shared and must not be
+// modified.
+func (r *routePolylines) decode(geoUtils geo.GeoUtils, roadID, encoded string) ([]geo.Point, error) {
+	r.mutex.Lock()
+	cached, ok := r.byRoad[roadID]
+	r.mutex.Unlock()
+	if ok && cached.encoded == encoded {
+		return cached.points, nil
+	}
+
+	points, err := geoUtils.DecodePolyline(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.byRoad == nil {
+		r.byRoad = make(map[string]decodedPolyline)
+	}
+	r.byRoad[roadID] = decodedPolyline{encoded: encoded, points: points}
+	return points, nil
+}
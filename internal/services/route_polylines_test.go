@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// countingGeoUtils counts DecodePolyline calls
+type countingGeoUtils struct {
+	geo.GeoUtils
+	decodes int
+}
+
+func (c *countingGeoUtils) DecodePolyline(encoded string) ([]geo.Point, error) {
+	c.decodes++
+	return c.GeoUtils.DecodePolyline(encoded)
+}
+
+// polylineRoad is a monitored road whose Google polyline is testPolyline
+var polylineRoad = config.MonitoredRoad{
+	ID:          "hwy4-arnold-bearvalley",
+	Name:        "Hwy 4",
+	Origin:      config.Coordinates{Latitude: 38.5, Longitude: -120.2},
+	Destination: config.Coordinates{Latitude: 43.252, Longitude: -126.453},
+}
+
+// testPolyline is a three-point Google polyline
+const testPolyline = "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+// TestBuildRouteFromMonitoredRoad_DecodesOnce verifies an unchanged polyline
+// is decoded once across refreshes, a changed one is decoded again, and the
+// memoized route classifies alerts exactly as a freshly decoded one.
+func TestBuildRouteFromMonitoredRoad_DecodesOnce(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	counter := &countingGeoUtils{GeoUtils: geo.NewGeoUtils()}
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: counter}
+
+	first := s.buildRouteFromMonitoredRoad(ctx, polylineRoad, testPolyline)
+	second := s.buildRouteFromMonitoredRoad(ctx, polylineRoad, testPolyline)
+	if counter.decodes != 1 {
+		t.Errorf("decodes after two refreshes = %d, want 1", counter.decodes)
+	}
+
+	fresh := (&RoadsService{geoUtils: geo.NewGeoUtils()}).buildRouteFromMonitoredRoad(ctx, polylineRoad, testPolyline)
+	alerts := []routing.UnclassifiedAlert{
+		{ID: "on", Location: geo.Point{Latitude: 40.7, Longitude: -120.95}, Type: "incident"},
+		{ID: "near", Location: geo.Point{Latitude: 40.72, Longitude: -120.95}, Type: "incident"},
+		{ID: "far", Location: geo.Point{Latitude: 38.0, Longitude: -119.0}, Type: "incident"},
+	}
+	for _, alert := range alerts {
+		want, err := s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{fresh})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, route := range []routing.Route{first, second} {
+			got, err := s.routeMatcher.ClassifyAlert(ctx, alert, []routing.Route{route})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("alert %s: memoized classification %+v, want %+v", alert.ID, got, want)
+			}
+		}
+	}
+
+	// New geometry from Google Routes is decoded
+	s.buildRouteFromMonitoredRoad(ctx, polylineRoad, "_p~iF~ps|U_ulLnnqC")
+	if counter.decodes != 2 {
+		t.Errorf("decodes after polyline change = %d, want 2", counter.decodes)
+	}
+}
+
+// BenchmarkBuildRouteFromMonitoredRoad reports polyline decodes per refresh,
+// which is ~0 once the road's polyline is memoized.
+func BenchmarkBuildRouteFromMonitoredRoad(b *testing.B) {
+	ctx := logging.EnsureLogger(context.Background())
+	counter := &countingGeoUtils{GeoUtils: geo.NewGeoUtils()}
+	s := &RoadsService{geoUtils: counter}
+
+	for b.Loop() {
+		s.buildRouteFromMonitoredRoad(ctx, polylineRoad, testPolyline)
+	}
+	b.ReportMetric(float64(counter.decodes)/float64(b.N), "decodes/refresh")
+}
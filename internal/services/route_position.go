@@ -0,0 +1,56 @@
+package services
+
+import (
+	"math"
+
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// alertRoutePosition is how far along route, in meters from its origin, alert
+// lies: at the mile marker its description names when the route's markers
+// are configured and span it, otherwise where its location projects onto the
+// route. Feed coordinates can be off by a few hundred meters; a mile marker
+// keeps incidents in their order along the drive regardless.
+func (s *RoadsService) alertRoutePosition(route routing.Route, alert routing.UnclassifiedAlert) float64 {
+	if mileMarker, ok := caltrans.ParseMileMarker(alert.Description); ok {
+		if meters, _, ok := s.mileMarkerPosition(route, mileMarker); ok {
+			return meters
+		}
+	}
+	if s.geoUtils == nil {
+		return 0
+	}
+	along, err := s.geoUtils.DistanceAlongPolyline(alert.Location, route.Polyline)
+	if err != nil {
+		return 0
+	}
+	return along
+}
+
+// mileMarkerPosition maps a mile marker to the distance along route and the
+// point on its polyline there. The marker's share of the route's marker span
+// is taken of the polyline's length, so markers and Google's geometry agree
+// at both ends. ok is false without the route's markers or geometry, or for
+// a marker outside its span.
+func (s *RoadsService) mileMarkerPosition(route routing.Route, mileMarker float64) (meters float64, point geo.Point, ok bool) {
+	if !route.HasMileMarkers() || s.geoUtils == nil || len(route.Polyline.Points) < 2 {
+		return 0, geo.Point{}, false
+	}
+	fraction := (mileMarker - route.StartMileMarker) / (route.EndMileMarker - route.StartMileMarker)
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		return 0, geo.Point{}, false
+	}
+
+	length, err := s.geoUtils.PolylineLength(route.Polyline)
+	if err != nil {
+		return 0, geo.Point{}, false
+	}
+	meters = fraction * length
+	point, err = s.geoUtils.PointAlongPolyline(route.Polyline, meters)
+	if err != nil {
+		return 0, geo.Point{}, false
+	}
+	return meters, point, true
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+func TestProcessGlobalAlerts_RoutePositionFromMileMarkers(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	s := &RoadsService{routeMatcher: routing.NewRouteMatcher(), geoUtils: geo.NewGeoUtils()}
+	road := config.MonitoredRoad{
+		ID:              "test-road",
+		Origin:          config.Coordinates{Latitude: 38.0, Longitude: -120.0},
+		Destination:     config.Coordinates{Latitude: 38.0, Longitude: -120.05},
+		StartMileMarker: 20,
+		EndMileMarker:   22.5,
+	}
+	route := s.buildRouteFromMonitoredRoad(ctx, road, "")
+	length, err := s.geoUtils.PolylineLength(route.Polyline)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The feed coordinates put the two incidents in the wrong order along
+	// the drive; their mile markers don't
+	incidents := []caltrans.CaltransIncident{
+		{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0001",
+			DescriptionText: "1125-Traffic Hazard, SR4 WB MM 22",
+			Coordinates:     &api.Coordinates{Latitude: 38.0, Longitude: -120.005},
+		},
+		{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0002",
+			DescriptionText: "1182-Trfc Collision-No Inj, SR4 WB mile marker 20.5",
+			Coordinates:     &api.Coordinates{Latitude: 38.0, Longitude: -120.045},
+		},
+		{
+			FeedType:        caltrans.CHP_INCIDENT,
+			Name:            "CHP Incident 251016ST0003",
+			DescriptionText: "1125-Traffic Hazard, debris",
+			Coordinates:     &api.Coordinates{Latitude: 38.0, Longitude: -120.025},
+		},
+	}
+
+	alertsByRoute, err := s.processGlobalAlerts(ctx, incidents, []routing.Route{route})
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := make(map[string]float64)
+	for _, alert := range alertsByRoute[road.ID] {
+		positions[alert.ID] = alert.RoutePositionMeters
+	}
+	if len(positions) != 3 {
+		t.Fatalf("positions = %v, want all 3 incidents", positions)
+	}
+
+	mm22, mm20_5 := positions["251016ST0001"], positions["251016ST0002"]
+	if mm20_5 >= mm22 {
+		t.Errorf("MM 20.5 at %.0fm is not before MM 22 at %.0fm", mm20_5, mm22)
+	}
+	if want := length * 0.8; math.Abs(mm22-want) > 1 {
+		t.Errorf("MM 22 at %.0fm, want %.0fm (80%% of the route)", mm22, want)
+	}
+	if want := length * 0.2; math.Abs(mm20_5-want) > 1 {
+		t.Errorf("MM 20.5 at %.0fm, want %.0fm (20%% of the route)", mm20_5, want)
+	}
+
+	// Without a mile marker the location is projected onto the route
+	if want := length / 2; math.Abs(positions["251016ST0003"]-want) > 10 {
+		t.Errorf("unmarked incident at %.0fm, want about %.0fm", positions["251016ST0003"], want)
+	}
+}
+
+func TestMileMarkerPosition(t *testing.T) {
+	s := &RoadsService{geoUtils: geo.NewGeoUtils()}
+	route := routing.Route{
+		Polyline:        geo.Polyline{Points: []geo.Point{{Latitude: 38.0, Longitude: -120.0}, {Latitude: 38.0, Longitude: -120.05}}},
+		StartMileMarker: 31,
+		EndMileMarker:   29, // Markers can count down along the drive
+	}
+
+	meters, point, ok := s.mileMarkerPosition(route, 30)
+	if !ok {
+		t.Fatal("MM 30 wasn't placed on a route from MM 31 to MM 29")
+	}
+	length, _ := s.geoUtils.PolylineLength(route.Polyline)
+	if want := length / 2; math.Abs(meters-want) > 1 {
+		t.Errorf("MM 30 at %.0fm, want the midpoint %.0fm", meters, want)
+	}
+	if math.Abs(point.Longitude+120.025) > 1e-6 {
+		t.Errorf("MM 30 point = %v, want the midpoint", point)
+	}
+
+	if _, _, ok := s.mileMarkerPosition(route, 35); ok {
+		t.Error("MM 35 placed on a route from MM 31 to MM 29")
+	}
+	route.EndMileMarker = route.StartMileMarker
+	if _, _, ok := s.mileMarkerPosition(route, 31); ok {
+		t.Error("placed a marker on a route without configured markers")
+	}
+}
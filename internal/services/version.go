@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/buildinfo"
+	"github.com/dpup/info.ersn.net/server/internal/clients/caltrans"
+	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+)
+
+// GetVersion implements the gRPC method identifying the running build and
+// its configured upstreams. Feed URLs are the ones the Caltrans client was
+// created with, which a config reload doesn't change.
+func (s *RoadsService) GetVersion(ctx context.Context, req *api.GetVersionRequest) (*api.GetVersionResponse, error) {
+	info := buildinfo.Get()
+	resp := &api.GetVersionResponse{
+		Version:     info.Version,
+		Commit:      info.Commit,
+		BuildTime:   info.BuildTime,
+		GoVersion:   info.GoVersion,
+		DataSources: &api.DataSources{},
+	}
+
+	var urls caltrans.FeedURLs
+	if s.caltransClient != nil {
+		urls = s.caltransClient.URLs
+	}
+	sources := resp.DataSources
+	sources.ChainControlsUrl = orDefault(urls.ChainControls, caltrans.DefaultChainControlsURL)
+	sources.LaneClosuresUrl = orDefault(urls.LaneClosures, caltrans.DefaultLaneClosuresURL)
+	sources.ChpIncidentsUrl = orDefault(urls.CHPIncidents, caltrans.DefaultCHPIncidentsURL)
+	sources.RoadConditionsUrl = orDefault(urls.RoadConditions, caltrans.DefaultRoadConditionsURL)
+
+	if cfg := s.cfg(); cfg != nil {
+		sources.LlmProvider = orDefault(cfg.OpenAI.Provider, alerts.ProviderOpenAI)
+		sources.LlmModel = cfg.OpenAI.Model
+		sources.LlmFallbackModel = cfg.OpenAI.FallbackModel
+		sources.WeatherProviders = cfg.Weather.Providers()
+		sources.Offline = cfg.Offline.Enabled
+	}
+	return resp, nil
+}
+
+// orDefault returns value, or fallback when it is empty
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dpup/prefab/logging"
@@ -18,30 +19,47 @@ import (
 	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
 	"github.com/dpup/info.ersn.net/server/internal/config"
 	"github.com/dpup/info.ersn.net/server/internal/lib/alerts"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/metrics"
 )
 
 // WeatherService implements the gRPC WeatherService
 // Implementation per tasks.md T017 and data-model.md WeatherData entity
 type WeatherService struct {
 	api.UnimplementedWeatherServiceServer
-	weatherClient *weather.Client
+	weatherClient *weather.Client // Air quality; conditions too when it's among providers
 	nwsClient     *nws.Client
-	cache         *cache.Cache
-	config        *config.Config
+	providers     []weatherSource // Tried in order for conditions, forecasts and location alerts
+	cache         cache.Store
+	config        *config.Config // Read through cfg(): ApplyWeatherConfig swaps it
+	configMu      sync.RWMutex
 	alertEnhancer alerts.WeatherAlertEnhancer
+	health        *SourceHealth
+	geoUtils      geo.GeoUtils
+	metrics       *metrics.Metrics // nil unless ReportMetrics is called
 }
 
-// NewWeatherService creates a new WeatherService
-func NewWeatherService(weatherClient *weather.Client, nwsClient *nws.Client, cache *cache.Cache, config *config.Config, alertEnhancer alerts.WeatherAlertEnhancer) *WeatherService {
+// NewWeatherService creates a new WeatherService. Conditions, forecasts and
+// location alerts come from weather.provider, falling back to
+// weather.fallbackProvider; changing either takes a restart.
+func NewWeatherService(weatherClient *weather.Client, nwsClient *nws.Client, cache cache.Store, config *config.Config, alertEnhancer alerts.WeatherAlertEnhancer, health *SourceHealth) *WeatherService {
 	return &WeatherService{
 		weatherClient: weatherClient,
 		nwsClient:     nwsClient,
+		providers:     newWeatherSources(config, weatherClient, nwsClient),
 		cache:         cache,
 		config:        config,
 		alertEnhancer: alertEnhancer,
+		health:        health,
+		geoUtils:      geo.NewGeoUtils(),
 	}
 }
 
+// ReportMetrics times weather refreshes in m. Call before the first refresh.
+func (s *WeatherService) ReportMetrics(m *metrics.Metrics) {
+	s.metrics = m
+}
+
 // ListWeather implements the gRPC method defined in contracts/weather.proto lines 12-17
 func (s *WeatherService) ListWeather(ctx context.Context, req *api.ListWeatherRequest) (*api.ListWeatherResponse, error) {
 	logging.Info(ctx, "ListWeather called")
@@ -72,8 +90,9 @@ func (s *WeatherService) ListWeather(ctx context.Context, req *api.ListWeatherRe
 		}, nil
 	}
 
-	// Cache miss or stale - refresh from external API
-	logging.Info(ctx, "Refreshing weather data from OpenWeatherMap API")
+	// Cache miss or stale - refresh from the weather providers
+	logging.Info(ctx, "Refreshing weather data from weather providers")
+	cfg := s.cfg()
 	weatherData, err := s.refreshWeatherData(ctx)
 	if err != nil {
 		// If refresh fails but we have stale cached data, return it
@@ -94,8 +113,10 @@ func (s *WeatherService) ListWeather(ctx context.Context, req *api.ListWeatherRe
 		return nil, fmt.Errorf("failed to refresh weather data: %w", err)
 	}
 
-	// Cache the refreshed data
-	if err := s.cache.Set(cacheKey, weatherData, s.config.Weather.RefreshInterval, "weather"); err != nil {
+	// Cache the refreshed data, unless the locations were reloaded meanwhile
+	if s.cfg() != cfg {
+		logging.Info(ctx, "Configuration reloaded during refresh - not caching weather data")
+	} else if err := s.cacheWeather(weatherData); err != nil {
 		logging.Errorw(ctx, "Failed to cache weather data", "error", err)
 	}
 
@@ -130,6 +151,168 @@ func (s *WeatherService) GetLocationWeather(ctx context.Context, req *api.GetLoc
 	return nil, status.Errorf(codes.NotFound, "location not found: %s", req.LocationId)
 }
 
+// GetLocationForecast implements the gRPC method for retrieving the hourly
+// and/or daily forecast for a configured location
+func (s *WeatherService) GetLocationForecast(ctx context.Context, req *api.GetLocationForecastRequest) (*api.GetLocationForecastResponse, error) {
+	logging.Infow(ctx, "GetLocationForecast called", "location_id", req.LocationId)
+
+	location := s.findLocation(req.LocationId)
+	if location == nil {
+		return nil, status.Errorf(codes.NotFound, "location not found: %s", req.LocationId)
+	}
+
+	opts := weather.ForecastOptions{Hourly: req.IncludeHourly, Daily: req.IncludeDaily}
+	if !opts.Hourly && !opts.Daily {
+		opts.Hourly, opts.Daily = true, true
+	}
+
+	// Forecasts are cached per location and per requested block combination
+	var cachedForecast *api.WeatherForecast
+	cacheKey := fmt.Sprintf("weather:forecast:%s:hourly=%t:daily=%t", location.ID, opts.Hourly, opts.Daily)
+
+	found, err := s.cache.Get(cacheKey, &cachedForecast)
+	if err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", cacheKey)
+	}
+
+	if found && !s.cache.IsStale(cacheKey) {
+		return s.forecastResponse(location, cachedForecast, cacheKey), nil
+	}
+
+	// Cache miss or stale - refresh from external API
+	forecast, err := s.fetchForecast(ctx, location, opts)
+	if err != nil {
+		// If refresh fails but we have stale cached data, return it
+		if found && !s.cache.IsVeryStale(cacheKey) {
+			logging.Errorw(ctx, "Refresh failed, returning stale cached forecast", "error", err, "location_id", location.ID)
+			return s.forecastResponse(location, cachedForecast, cacheKey), nil
+		}
+		return nil, fmt.Errorf("failed to get forecast: %w", err)
+	}
+
+	if err := s.cache.Set(cacheKey, forecast, s.cfg().Weather.RefreshInterval, "weather_forecast"); err != nil {
+		logging.Errorw(ctx, "Failed to cache forecast", "error", err)
+	}
+
+	return &api.GetLocationForecastResponse{
+		LocationId:   location.ID,
+		LocationName: location.Name,
+		Forecast:     forecast,
+		LastUpdated:  timestamppb.Now(),
+	}, nil
+}
+
+// fetchForecast retrieves a forecast from the weather providers, recording
+// source health
+func (s *WeatherService) fetchForecast(ctx context.Context, location *config.WeatherLocation, opts weather.ForecastOptions) (*api.WeatherForecast, error) {
+	return fetchWeather(ctx, s, func(provider WeatherProvider) (*api.WeatherForecast, error) {
+		return provider.GetForecast(ctx, location.ToProto(), opts)
+	})
+}
+
+// forecastResponse builds a response from a cached forecast
+func (s *WeatherService) forecastResponse(location *config.WeatherLocation, forecast *api.WeatherForecast, cacheKey string) *api.GetLocationForecastResponse {
+	entry, _, _ := s.cache.GetWithMetadata(cacheKey, nil)
+	var lastUpdated *timestamppb.Timestamp
+	if entry != nil {
+		lastUpdated = timestamppb.New(entry.CreatedAt)
+	}
+
+	return &api.GetLocationForecastResponse{
+		LocationId:   location.ID,
+		LocationName: location.Name,
+		Forecast:     forecast,
+		LastUpdated:  lastUpdated,
+	}
+}
+
+// GetLocationAirQuality implements the gRPC method for retrieving current air
+// quality for a configured location
+func (s *WeatherService) GetLocationAirQuality(ctx context.Context, req *api.GetLocationAirQualityRequest) (*api.GetLocationAirQualityResponse, error) {
+	logging.Infow(ctx, "GetLocationAirQuality called", "location_id", req.LocationId)
+
+	location := s.findLocation(req.LocationId)
+	if location == nil {
+		return nil, status.Errorf(codes.NotFound, "location not found: %s", req.LocationId)
+	}
+
+	var cachedAirQuality *api.AirQuality
+	cacheKey := "weather:air_quality:" + location.ID
+
+	found, err := s.cache.Get(cacheKey, &cachedAirQuality)
+	if err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", cacheKey)
+	}
+
+	if found && !s.cache.IsStale(cacheKey) {
+		return s.airQualityResponse(location, cachedAirQuality, cacheKey), nil
+	}
+
+	// Cache miss or stale - refresh from external API
+	airQuality, err := s.fetchAirQuality(ctx, location)
+	if err != nil {
+		// If refresh fails but we have stale cached data, return it
+		if found && !s.cache.IsVeryStale(cacheKey) {
+			logging.Errorw(ctx, "Refresh failed, returning stale cached air quality", "error", err, "location_id", location.ID)
+			return s.airQualityResponse(location, cachedAirQuality, cacheKey), nil
+		}
+		return nil, fmt.Errorf("failed to get air quality: %w", err)
+	}
+
+	if err := s.cache.Set(cacheKey, airQuality, s.cfg().Weather.RefreshInterval, "air_quality"); err != nil {
+		logging.Errorw(ctx, "Failed to cache air quality", "error", err)
+	}
+
+	return &api.GetLocationAirQualityResponse{
+		LocationId:   location.ID,
+		LocationName: location.Name,
+		AirQuality:   airQuality,
+		LastUpdated:  timestamppb.Now(),
+	}, nil
+}
+
+// fetchAirQuality retrieves air quality from OpenWeatherMap, recording source health
+func (s *WeatherService) fetchAirQuality(ctx context.Context, location *config.WeatherLocation) (*api.AirQuality, error) {
+	if s.cfg().OpenWeather.APIKey == "" {
+		return nil, fmt.Errorf("OpenWeatherMap API key not configured")
+	}
+
+	airQuality, err := s.weatherClient.GetAirQuality(ctx, location.ToProto())
+	if err != nil {
+		s.health.RecordError(SourceOpenWeather, err)
+		return nil, err
+	}
+	s.health.RecordSuccess(SourceOpenWeather)
+	return airQuality, nil
+}
+
+// airQualityResponse builds a response from cached air quality
+func (s *WeatherService) airQualityResponse(location *config.WeatherLocation, airQuality *api.AirQuality, cacheKey string) *api.GetLocationAirQualityResponse {
+	entry, _, _ := s.cache.GetWithMetadata(cacheKey, nil)
+	var lastUpdated *timestamppb.Timestamp
+	if entry != nil {
+		lastUpdated = timestamppb.New(entry.CreatedAt)
+	}
+
+	return &api.GetLocationAirQualityResponse{
+		LocationId:   location.ID,
+		LocationName: location.Name,
+		AirQuality:   airQuality,
+		LastUpdated:  lastUpdated,
+	}
+}
+
+// findLocation returns the configured weather location with the given ID, or nil
+func (s *WeatherService) findLocation(id string) *config.WeatherLocation {
+	locations := s.cfg().Weather.Locations
+	for i := range locations {
+		if locations[i].ID == id {
+			return &locations[i]
+		}
+	}
+	return nil
+}
+
 // ListWeatherAlerts implements the gRPC method for retrieving weather alerts
 func (s *WeatherService) ListWeatherAlerts(ctx context.Context, req *api.ListWeatherAlertsRequest) (*api.ListWeatherAlertsResponse, error) {
 	logging.Info(ctx, "ListWeatherAlerts called")
@@ -160,6 +343,7 @@ func (s *WeatherService) ListWeatherAlerts(ctx context.Context, req *api.ListWea
 
 	// Cache miss or stale - refresh alerts from external API
 	logging.Info(ctx, "Refreshing weather alerts (NWS zone alerts + OpenWeatherMap)")
+	cfg := s.cfg()
 	alerts, err := s.refreshWeatherAlerts(ctx)
 	if err != nil {
 		// If refresh fails but we have stale cached data, return it
@@ -179,8 +363,10 @@ func (s *WeatherService) ListWeatherAlerts(ctx context.Context, req *api.ListWea
 		return nil, fmt.Errorf("failed to refresh weather alerts: %w", err)
 	}
 
-	// Cache the refreshed alerts
-	if err := s.cache.Set(cacheKey, alerts, s.config.Weather.RefreshInterval, "weather_alerts"); err != nil {
+	// Cache the refreshed alerts, unless the locations were reloaded meanwhile
+	if s.cfg() != cfg {
+		logging.Info(ctx, "Configuration reloaded during refresh - not caching weather alerts")
+	} else if err := s.cacheWeatherAlerts(alerts); err != nil {
 		logging.Errorw(ctx, "Failed to cache weather alerts", "error", err)
 	}
 
@@ -190,8 +376,21 @@ func (s *WeatherService) ListWeatherAlerts(ctx context.Context, req *api.ListWea
 	}, nil
 }
 
-// refreshWeatherData fetches fresh weather data from OpenWeatherMap for all configured locations
-func (s *WeatherService) refreshWeatherData(ctx context.Context) ([]*api.WeatherData, error) {
+// cacheWeather stores the combined weather list served by ListWeather
+func (s *WeatherService) cacheWeather(weatherData []*api.WeatherData) error {
+	return s.cache.Set("weather:all", weatherData, s.cfg().Weather.RefreshInterval, "weather")
+}
+
+// cacheWeatherAlerts stores the combined alert list served by ListWeatherAlerts
+func (s *WeatherService) cacheWeatherAlerts(alerts []*api.WeatherAlert) error {
+	return s.cache.Set("weather:alerts", alerts, s.cfg().Weather.RefreshInterval, "weather_alerts")
+}
+
+// refreshWeatherData fetches fresh weather data from the weather providers for all configured locations
+func (s *WeatherService) refreshWeatherData(ctx context.Context) (_ []*api.WeatherData, err error) {
+	start := time.Now()
+	defer func() { s.metrics.ObserveRefresh("weather", time.Since(start), err) }()
+
 	var weatherDataList []*api.WeatherData
 
 	// Get existing cached data to preserve on per-location failures
@@ -204,10 +403,11 @@ func (s *WeatherService) refreshWeatherData(ctx context.Context) ([]*api.Weather
 		}
 	}
 
-	logging.Infow(ctx, "Starting weather refresh", "location_count", len(s.config.Weather.Locations))
+	locations := s.cfg().Weather.Locations
+	logging.Infow(ctx, "Starting weather refresh", "location_count", len(locations))
 
 	// Process each configured location
-	for i, location := range s.config.Weather.Locations {
+	for i, location := range locations {
 		logging.Infow(ctx, "Processing weather location", "index", i, "location_id", location.ID, "location_name", location.Name)
 
 		weatherData, err := s.processWeatherLocation(ctx, location)
@@ -228,7 +428,7 @@ func (s *WeatherService) refreshWeatherData(ctx context.Context) ([]*api.Weather
 	}
 
 	logging.Infow(ctx, "Weather refresh complete",
-		"total_locations", len(s.config.Weather.Locations),
+		"total_locations", len(locations),
 		"successful_locations", len(weatherDataList))
 
 	if len(weatherDataList) == 0 {
@@ -242,12 +442,15 @@ func (s *WeatherService) refreshWeatherData(ctx context.Context) ([]*api.Weather
 func (s *WeatherService) processWeatherLocation(ctx context.Context, location config.WeatherLocation) (*api.WeatherData, error) {
 	logging.Infow(ctx, "Processing weather for location", "location_id", location.ID)
 
-	if s.config.OpenWeather.APIKey == "" {
-		return nil, fmt.Errorf("OpenWeatherMap API key not configured")
-	}
+	coords := location.ToProto()
+	ttl := s.cfg().Weather.LocationTTL()
 
-	// Get current weather data
-	weatherData, err := s.weatherClient.GetCurrentWeather(ctx, location.ToProto())
+	// Get current weather data (reused per location for LocationTTL)
+	weatherData, err := cachedLocationFetch(ctx, s.cache, locationCacheKey("current", coords), ttl, func() (*api.WeatherData, error) {
+		return fetchWeather(ctx, s, func(provider WeatherProvider) (*api.WeatherData, error) {
+			return provider.GetCurrentWeather(ctx, coords)
+		})
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current weather: %w", err)
 	}
@@ -257,16 +460,21 @@ func (s *WeatherService) processWeatherLocation(ctx context.Context, location co
 	weatherData.LocationName = location.Name
 
 	// Get weather alerts for this location
-	locationAlerts, err := s.weatherClient.GetWeatherAlerts(ctx, location.ToProto())
+	locationAlerts, err := s.cachedLocationAlerts(ctx, coords)
 	if err != nil {
 		logging.Errorw(ctx, "Failed to get weather alerts", "location_id", location.ID, "error", err)
 		// Continue without alerts rather than failing
 		locationAlerts = nil
 	}
 
-	// Enhance alerts with AI if enhancer is available
+	// Enhance alerts with AI if enhancer is available. NWS alerts keep their
+	// global IDs and official wording.
 	for _, alert := range locationAlerts {
+		if alert.Source == api.AlertSource_NWS {
+			continue
+		}
 		alert.Id = fmt.Sprintf("%s_%s", location.ID, alert.Id)
+		alert.Category = weatherAlertCategory(alert)
 		if s.alertEnhancer != nil {
 			s.enhanceWeatherAlert(ctx, alert)
 		}
@@ -278,41 +486,82 @@ func (s *WeatherService) processWeatherLocation(ctx context.Context, location co
 }
 
 // refreshWeatherAlerts builds the combined weather-alerts list. Authoritative
-// NWS zone alerts (issue #4) are listed first, followed by OpenWeatherMap
-// per-location alerts. Each alert is tagged with its source so consumers can
-// prefer NWS.
-func (s *WeatherService) refreshWeatherAlerts(ctx context.Context) ([]*api.WeatherAlert, error) {
+// NWS zone alerts (issue #4) are listed first, followed by the weather
+// providers' per-location alerts. Each alert is tagged with its source so
+// consumers can prefer NWS.
+func (s *WeatherService) refreshWeatherAlerts(ctx context.Context) (_ []*api.WeatherAlert, err error) {
+	start := time.Now()
+	defer func() { s.metrics.ObserveRefresh("weather_alerts", time.Since(start), err) }()
+
 	var allAlerts []*api.WeatherAlert
 
 	// Authoritative NWS zone alerts for the service area.
-	allAlerts = append(allAlerts, nwsAlertsToProto(s.getNWSAlerts(ctx))...)
+	zoneAlerts := nwsAlertsToProto(s.getNWSAlerts(ctx))
+	allAlerts = append(allAlerts, zoneAlerts...)
+	listed := make(map[string]bool, len(zoneAlerts))
+	for _, alert := range zoneAlerts {
+		listed[alert.Id] = true
+	}
+
+	// NWS winter products along the monitored roads, for chain relevance
+	for _, alert := range nwsAlertsToProto(s.getRoadWinterAlerts(ctx)) {
+		if !listed[alert.Id] {
+			listed[alert.Id] = true
+			allAlerts = append(allAlerts, alert)
+		}
+	}
 
-	// OpenWeatherMap per-location alerts (AI-enhanced, tagged as such).
-	for _, location := range s.config.Weather.Locations {
-		locationAlerts, err := s.weatherClient.GetWeatherAlerts(ctx, location.ToProto())
+	// Per-location alerts: OpenWeatherMap's are AI-enhanced and tagged as such
+	for _, location := range s.cfg().Weather.Locations {
+		coords := location.ToProto()
+		locationAlerts, err := s.cachedLocationAlerts(ctx, coords)
 		if err != nil {
 			logging.Errorw(ctx, "Failed to get weather alerts for location", "location_id", location.ID, "error", err)
 			// Continue processing other locations even if one fails
 			continue
 		}
 
-		// Add location context to alert IDs and enhance each alert
 		for _, alert := range locationAlerts {
+			// NWS alerts for a point repeat the zone alerts, and neighboring
+			// locations share them
+			if alert.Source == api.AlertSource_NWS {
+				if !listed[alert.Id] {
+					listed[alert.Id] = true
+					allAlerts = append(allAlerts, alert)
+				}
+				continue
+			}
+
+			// Add location context to alert IDs and enhance each alert
 			alert.Id = fmt.Sprintf("%s_%s", location.ID, alert.Id)
 			alert.Source = api.AlertSource_OPENWEATHERMAP
+			alert.Category = weatherAlertCategory(alert)
 
 			// Enhance the alert with AI if enhancer is available
 			if s.alertEnhancer != nil {
 				s.enhanceWeatherAlert(ctx, alert)
 			}
+			allAlerts = append(allAlerts, alert)
 		}
-
-		allAlerts = append(allAlerts, locationAlerts...)
 	}
 
 	return allAlerts, nil
 }
 
+// cachedLocationAlerts returns the alerts for coords, reused for LocationTTL
+func (s *WeatherService) cachedLocationAlerts(ctx context.Context, coords *api.Coordinates) ([]*api.WeatherAlert, error) {
+	return cachedLocationFetch(ctx, s.cache, locationCacheKey("alerts", coords), s.cfg().Weather.LocationTTL(), func() ([]*api.WeatherAlert, error) {
+		return s.locationAlerts(ctx, coords)
+	})
+}
+
+// locationAlerts fetches the alerts for coords from the weather providers
+func (s *WeatherService) locationAlerts(ctx context.Context, coords *api.Coordinates) ([]*api.WeatherAlert, error) {
+	return fetchWeather(ctx, s, func(provider WeatherProvider) ([]*api.WeatherAlert, error) {
+		return provider.GetWeatherAlerts(ctx, coords)
+	})
+}
+
 // filterAlertsByZones constrains zone-scoped (NWS) alerts to the requested
 // forecast zones. Non-NWS alerts (e.g. OpenWeatherMap, which are location-based
 // rather than zone-based) are NOT zone-scoped and always pass through, so the
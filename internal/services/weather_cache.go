@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+)
+
+// locationCacheKey keys an OpenWeatherMap response by coordinates rounded to
+// two decimals (~1km), so nearby locations share a single upstream call.
+func locationCacheKey(kind string, coords *api.Coordinates) string {
+	return fmt.Sprintf("weather:%s:%.2f,%.2f", kind, coords.Latitude, coords.Longitude)
+}
+
+// cachedLocationFetch serves key from the cache while it's fresh, otherwise
+// calls fetch and caches the result for ttl. When fetch fails a stale (but not
+// very stale) entry is returned instead, mirroring RoadsService.ListRoads.
+func cachedLocationFetch[T any](ctx context.Context, c cache.Store, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	var cached T
+	_, found, err := c.GetWithMetadata(key, &cached)
+	if err != nil {
+		logging.Errorw(ctx, "Cache error", "error", err, "cache_key", key)
+		found = false
+	}
+
+	if found && !c.IsStale(key) {
+		return cached, nil
+	}
+
+	fresh, err := fetch()
+	if err != nil {
+		if found && !c.IsVeryStale(key) {
+			logging.Errorw(ctx, "Refresh failed, returning stale cached weather", "error", err, "cache_key", key)
+			return cached, nil
+		}
+		var zero T
+		return zero, err
+	}
+
+	if err := c.Set(key, fresh, ttl, "openweather"); err != nil {
+		logging.Errorw(ctx, "Failed to cache weather", "error", err, "cache_key", key)
+	}
+	return fresh, nil
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// countingDoer answers OpenWeatherMap requests with canned bodies and counts calls per path.
+type countingDoer struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (d *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	d.calls[req.URL.Path]++
+	d.mu.Unlock()
+
+	body := `{"lat": 38.14, "lon": -120.46, "alerts": []}`
+	if req.URL.Path == "/data/2.5/weather" {
+		body = `{"weather": [{"main": "Clear", "description": "clear sky", "icon": "01d"}],
+			"main": {"temp": 21.5, "feels_like": 20.9, "humidity": 30}, "wind": {"speed": 2.0, "deg": 180},
+			"visibility": 10000, "name": "Murphys"}`
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+}
+
+func (d *countingDoer) count(path string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls[path]
+}
+
+func newCachedWeatherService(doer *countingDoer, ttl time.Duration) *WeatherService {
+	cfg := &config.Config{}
+	cfg.OpenWeather.APIKey = "test-key"
+	cfg.Weather.LocationCacheTTL = ttl
+	cfg.Weather.Locations = []config.WeatherLocation{
+		{ID: "murphys", Name: "Murphys", Coordinates: config.Coordinates{Latitude: 38.1377, Longitude: -120.4627}},
+	}
+	client := weather.NewClientWithHTTPDoer("test-key", "https://owm.test", doer)
+	return NewWeatherService(client, nil, cache.NewCache(), cfg, nil, nil)
+}
+
+func TestProcessWeatherLocation_CachesWithinTTL(t *testing.T) {
+	doer := &countingDoer{calls: map[string]int{}}
+	s := newCachedWeatherService(doer, 10*time.Minute)
+	location := s.config.Weather.Locations[0]
+	ctx := logging.EnsureLogger(context.Background())
+
+	first, err := s.processWeatherLocation(ctx, location)
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	second, err := s.processWeatherLocation(ctx, location)
+	if err != nil {
+		t.Fatalf("second call: %v", err)
+	}
+
+	if got := doer.count("/data/2.5/weather"); got != 1 {
+		t.Errorf("current weather requests = %d, want 1 (second call should hit the cache)", got)
+	}
+	if got := doer.count("/data/3.0/onecall"); got != 1 {
+		t.Errorf("alert requests = %d, want 1 (second call should hit the cache)", got)
+	}
+	if first.TemperatureCelsius != 21 || second.TemperatureCelsius != 21 {
+		t.Errorf("temperatures = %d, %d; want 21, 21", first.TemperatureCelsius, second.TemperatureCelsius)
+	}
+	if second.LocationId != "murphys" {
+		t.Errorf("cached response location_id = %q, want murphys", second.LocationId)
+	}
+}
+
+func TestProcessWeatherLocation_RefetchesAfterTTL(t *testing.T) {
+	doer := &countingDoer{calls: map[string]int{}}
+	s := newCachedWeatherService(doer, time.Millisecond)
+	location := s.config.Weather.Locations[0]
+	ctx := logging.EnsureLogger(context.Background())
+
+	if _, err := s.processWeatherLocation(ctx, location); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := s.processWeatherLocation(ctx, location); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := doer.count("/data/2.5/weather"); got != 2 {
+		t.Errorf("current weather requests = %d, want 2 after the TTL expired", got)
+	}
+}
+
+func TestLocationCacheKey_RoundsCoordinates(t *testing.T) {
+	a := locationCacheKey("current", (&config.Coordinates{Latitude: 38.1377, Longitude: -120.4627}).ToProto())
+	b := locationCacheKey("current", (&config.Coordinates{Latitude: 38.1401, Longitude: -120.4649}).ToProto())
+	if a != b {
+		t.Errorf("nearby coordinates should share a key: %q vs %q", a, b)
+	}
+	if a != "weather:current:38.14,-120.46" {
+		t.Errorf("key = %q", a)
+	}
+}
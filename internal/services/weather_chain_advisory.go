@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// winterWeatherEventRe matches weather alert events that bring snow or ice
+var winterWeatherEventRe = regexp.MustCompile(`(?i)\b(?:winter\s+storm|blizzard|ice\s+storm|snow|freezing\s+rain|winter\s+weather)\b`)
+
+// CorrelateWeatherAlerts lets refreshes raise a chain-control advisory on
+// roads under a severe winter weather alert, fetched through the weather
+// service's providers. No-op unless roads.weatherChainAdvisories is enabled.
+// Call before the first refresh.
+func (s *RoadsService) CorrelateWeatherAlerts(weather *WeatherService) {
+	if cfg := s.cfg(); cfg == nil || !cfg.Roads.WeatherChainAdvisories {
+		return
+	}
+	s.chainWeather = weather
+}
+
+// applyWeatherChainAdvisory marks a road chains ADVISED when a severe winter
+// weather alert covers its origin or destination and Caltrans hasn't
+// reported chain control, noting the alert in the status explanation
+func (s *RoadsService) applyWeatherChainAdvisory(ctx context.Context, route routing.Route, chainControl *api.ChainControlStatus, statusExplanation *string) {
+	if s.chainWeather == nil || *chainControl != api.ChainControlStatus_NONE {
+		return
+	}
+
+	for _, point := range []geo.Point{route.Origin, route.Destination} {
+		coords := &api.Coordinates{Latitude: point.Latitude, Longitude: point.Longitude}
+		weatherAlerts, err := s.chainWeather.cachedLocationAlerts(ctx, coords)
+		if err != nil {
+			logging.Errorw(ctx, "Failed to get weather alerts for chain advisory", "road_id", route.ID, "error", err)
+			continue
+		}
+
+		for _, alert := range weatherAlerts {
+			if !isSevereWinterAlert(alert) {
+				continue
+			}
+			*chainControl = api.ChainControlStatus_ADVISED
+			note := alert.Event + " in effect; chains may be required"
+			if *statusExplanation == "" {
+				*statusExplanation = note
+			} else {
+				*statusExplanation += ". " + note
+			}
+			logging.Infow(ctx, "Weather alert: chains advised", "road_id", route.ID, "event", alert.Event)
+			return
+		}
+	}
+}
+
+// weatherAlertCategory classifies an alert by hazard. NWS alerts are matched
+// on their product name; others on their event text and tags.
+func weatherAlertCategory(alert *api.WeatherAlert) api.WeatherAlertCategory {
+	if alert.Source == api.AlertSource_NWS {
+		if nws.IsWinterProduct(alert.Event) {
+			return api.WeatherAlertCategory_WINTER
+		}
+		return api.WeatherAlertCategory_WEATHER_ALERT_CATEGORY_UNSPECIFIED
+	}
+
+	winter := winterWeatherEventRe.MatchString(alert.Event)
+	for _, tag := range alert.Tags {
+		if strings.EqualFold(tag, "snow") || strings.EqualFold(tag, "ice") {
+			winter = true
+		}
+	}
+	if winter {
+		return api.WeatherAlertCategory_WINTER
+	}
+	return api.WeatherAlertCategory_WEATHER_ALERT_CATEGORY_UNSPECIFIED
+}
+
+// isSevereWinterAlert reports whether a weather alert is a snow or ice
+// warning (not a watch or advisory)
+func isSevereWinterAlert(alert *api.WeatherAlert) bool {
+	if weatherAlertCategory(alert) != api.WeatherAlertCategory_WINTER {
+		return false
+	}
+	switch alert.Severity {
+	case api.AlertSeverity_WARNING, api.AlertSeverity_CRITICAL:
+		return true
+	}
+	return strings.Contains(strings.ToLower(alert.Event), "warning")
+}
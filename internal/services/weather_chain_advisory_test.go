@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+	"github.com/dpup/info.ersn.net/server/internal/lib/routing"
+)
+
+// snowAlertDoer answers One Call requests with a Winter Storm Warning
+type snowAlertDoer struct{}
+
+func (snowAlertDoer) Do(req *http.Request) (*http.Response, error) {
+	body := `{"lat": 38.43, "lon": -120.05, "alerts": [{
+		"sender_name": "NWS Sacramento CA",
+		"event": "Winter Storm Warning",
+		"start": 1760650000,
+		"end": 1760736400,
+		"description": "Heavy snow expected above 5000 feet. Total accumulations of 1 to 2 feet.",
+		"tags": ["Snow"]
+	}]}`
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+}
+
+func TestBuildRoad_WeatherChainAdvisory(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	road := config.MonitoredRoad{ID: "hwy4-arnold-bearvalley", Name: "Hwy 4"}
+	route := routing.Route{
+		ID:          road.ID,
+		Origin:      geo.Point{Latitude: 38.2552, Longitude: -120.3513},
+		Destination: geo.Point{Latitude: 38.4685, Longitude: -120.0410},
+	}
+
+	for _, tt := range []struct {
+		name        string
+		enabled     bool
+		want        api.ChainControlStatus
+		explanation string
+	}{
+		{"enabled", true, api.ChainControlStatus_ADVISED, "Winter Storm Warning in effect; chains may be required"},
+		{"disabled", false, api.ChainControlStatus_NONE, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.OpenWeather.APIKey = "test-key"
+			cfg.Roads.WeatherChainAdvisories = tt.enabled
+			c := cache.NewCache()
+			s := NewRoadsService(nil, nil, c, cfg, nil, nil)
+			s.CorrelateWeatherAlerts(NewWeatherService(weather.NewClientWithHTTPDoer("test-key", "https://owm.test", snowAlertDoer{}), nil, c, cfg, nil, nil))
+
+			got, err := s.buildRoadFromRouteAndAlerts(ctx, road, route, nil, trafficData{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ChainControl != tt.want {
+				t.Errorf("chain control = %v, want %v", got.ChainControl, tt.want)
+			}
+			if got.StatusExplanation != tt.explanation {
+				t.Errorf("status explanation = %q, want %q", got.StatusExplanation, tt.explanation)
+			}
+		})
+	}
+}
+
+func TestApplyWeatherChainAdvisory_KeepsRequired(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.OpenWeather.APIKey = "test-key"
+	cfg.Roads.WeatherChainAdvisories = true
+	c := cache.NewCache()
+	s := NewRoadsService(nil, nil, c, cfg, nil, nil)
+	s.CorrelateWeatherAlerts(NewWeatherService(weather.NewClientWithHTTPDoer("test-key", "https://owm.test", snowAlertDoer{}), nil, c, cfg, nil, nil))
+
+	chainControl := api.ChainControlStatus_REQUIRED
+	explanation := ""
+	s.applyWeatherChainAdvisory(ctx, routing.Route{ID: "r"}, &chainControl, &explanation)
+	if chainControl != api.ChainControlStatus_REQUIRED || explanation != "" {
+		t.Errorf("got %v %q, want REQUIRED left untouched", chainControl, explanation)
+	}
+}
+
+func TestApplyWeatherChainAdvisory_FallbackProvider(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.OpenWeather.APIKey = "test-key"
+	cfg.Weather.FallbackProvider = config.WeatherProviderNWS
+	cfg.Roads.WeatherChainAdvisories = true
+	c := cache.NewCache()
+	health := NewSourceHealth(nil)
+	s := NewRoadsService(nil, nil, c, cfg, nil, health)
+	s.CorrelateWeatherAlerts(NewWeatherService(
+		weather.NewClientWithHTTPDoer("test-key", "https://owm.test", downDoer{}),
+		nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", nwsAPI{}),
+		c, cfg, nil, health))
+
+	point := geo.Point{Latitude: murphys.Latitude, Longitude: murphys.Longitude}
+	// OpenWeatherMap is down, so the NWS Winter Storm Warning raises the advisory
+	chainControl := api.ChainControlStatus_NONE
+	explanation := ""
+	s.applyWeatherChainAdvisory(ctx, routing.Route{ID: "hwy4", Origin: point, Destination: point}, &chainControl, &explanation)
+	if chainControl != api.ChainControlStatus_ADVISED {
+		t.Errorf("chain control = %v, want ADVISED from the NWS fallback", chainControl)
+	}
+	if !health.status(SourceOpenWeather).Failing || health.status(SourceNWS).Failing {
+		t.Errorf("health: openweather %+v, nws %+v; want openweather failing, nws healthy",
+			health.status(SourceOpenWeather), health.status(SourceNWS))
+	}
+}
+
+func TestIsSevereWinterAlert(t *testing.T) {
+	tests := []struct {
+		alert *api.WeatherAlert
+		want  bool
+	}{
+		{&api.WeatherAlert{Event: "Winter Storm Warning"}, true},
+		{&api.WeatherAlert{Event: "Blizzard Warning"}, true},
+		{&api.WeatherAlert{Event: "Winter Weather Advisory"}, false},
+		{&api.WeatherAlert{Event: "Winter Storm Watch"}, false},
+		{&api.WeatherAlert{Event: "Special Weather Statement", Tags: []string{"snow"}, Severity: api.AlertSeverity_WARNING}, true},
+		{&api.WeatherAlert{Event: "Red Flag Warning"}, false},
+	}
+	for _, tt := range tests {
+		if got := isSevereWinterAlert(tt.alert); got != tt.want {
+			t.Errorf("isSevereWinterAlert(%q) = %v, want %v", tt.alert.Event, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+	"github.com/dpup/info.ersn.net/server/internal/lib/geo"
+)
+
+// nearestLocation returns the configured weather location closest to point
+// and no more than maxMeters from it, or nil if there is none
+func (s *WeatherService) nearestLocation(point config.Coordinates, maxMeters float64) *config.WeatherLocation {
+	from := geo.Point{Latitude: point.Latitude, Longitude: point.Longitude}
+
+	var nearest *config.WeatherLocation
+	nearestMeters := maxMeters
+	locations := s.cfg().Weather.Locations
+	for i := range locations {
+		c := locations[i].Coordinates
+		meters, err := s.geoUtils.PointToPoint(from, geo.Point{Latitude: c.Latitude, Longitude: c.Longitude})
+		if err == nil && meters <= nearestMeters {
+			nearest, nearestMeters = &locations[i], meters
+		}
+	}
+	return nearest
+}
+
+// weatherNear returns the current weather and alerts for arbitrary
+// coordinates. The nearest configured location within maxMeters answers from
+// the cached weather list; otherwise at, which names the point, is fetched on
+// demand (and cached per coordinates like any location).
+func (s *WeatherService) weatherNear(ctx context.Context, at config.WeatherLocation, maxMeters float64) (*api.WeatherData, error) {
+	if nearest := s.nearestLocation(at.Coordinates, maxMeters); nearest != nil {
+		resp, err := s.GetLocationWeather(ctx, &api.GetLocationWeatherRequest{LocationId: nearest.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get weather for %s: %w", nearest.ID, err)
+		}
+		return resp.WeatherData, nil
+	}
+	return s.processWeatherLocation(ctx, at)
+}
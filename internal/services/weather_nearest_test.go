@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+func TestWeatherNear_ResolvesClosestLocation(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	doer := &countingDoer{calls: map[string]int{}}
+	s := newCachedWeatherService(doer, time.Hour)
+	s.config.Weather.Locations = append(s.config.Weather.Locations, config.WeatherLocation{
+		ID: "arnold", Name: "Arnold", Coordinates: config.Coordinates{Latitude: 38.2555, Longitude: -120.3516},
+	})
+
+	// Between Murphys and Arnold, about 4 km from Arnold and 12 km from Murphys
+	between := config.Coordinates{Latitude: 38.2300, Longitude: -120.3800}
+	if got := s.nearestLocation(between, 25000); got == nil || got.ID != "arnold" {
+		t.Fatalf("nearest location = %v, want arnold", got)
+	}
+	if got := s.nearestLocation(between, 1000); got != nil {
+		t.Errorf("nearest location within 1 km = %v, want none", got)
+	}
+
+	data, err := s.weatherNear(ctx, config.WeatherLocation{ID: "point", Coordinates: between}, 25000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.LocationId != "arnold" || data.LocationName != "Arnold" {
+		t.Errorf("weather for %s (%s), want arnold", data.LocationId, data.LocationName)
+	}
+
+	// With no location in range the point itself is fetched
+	data, err = s.weatherNear(ctx, config.WeatherLocation{ID: "point", Name: "Somewhere", Coordinates: between}, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.LocationId != "point" || data.LocationName != "Somewhere" {
+		t.Errorf("weather for %s (%s), want the requested point", data.LocationId, data.LocationName)
+	}
+}
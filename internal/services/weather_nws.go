@@ -10,13 +10,15 @@ import (
 
 	api "github.com/dpup/info.ersn.net/server/api/v1"
 	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/config"
 )
 
 // getNWSAlerts returns the active NWS alerts for the configured service-area
 // zones, caching the raw alert list so it is fetched at most once per weather
 // refresh and shared between zone-alert listing and fire-weather classification.
 func (s *WeatherService) getNWSAlerts(ctx context.Context) []nws.Alert {
-	if s.nwsClient == nil || len(s.config.Weather.NWS.Zones) == 0 {
+	zones := s.cfg().Weather.NWS.Zones
+	if s.nwsClient == nil || len(zones) == 0 {
 		return nil
 	}
 
@@ -26,8 +28,9 @@ func (s *WeatherService) getNWSAlerts(ctx context.Context) []nws.Alert {
 		return cached
 	}
 
-	alerts, err := s.nwsClient.GetActiveZoneAlerts(ctx, s.config.Weather.NWS.Zones)
+	alerts, err := s.nwsClient.GetActiveZoneAlerts(ctx, zones)
 	if err != nil {
+		s.health.RecordError(SourceNWS, err)
 		logging.Errorw(ctx, "Failed to fetch NWS zone alerts", "error", err)
 		// Fall back to stale cache rather than dropping alerts on a transient error.
 		if cached != nil {
@@ -35,11 +38,12 @@ func (s *WeatherService) getNWSAlerts(ctx context.Context) []nws.Alert {
 		}
 		return nil
 	}
+	s.health.RecordSuccess(SourceNWS)
 
-	if err := s.cache.Set(cacheKey, alerts, s.config.Weather.RefreshInterval, "nws_alerts"); err != nil {
+	if err := s.cache.Set(cacheKey, alerts, s.cfg().Weather.RefreshInterval, "nws_alerts"); err != nil {
 		logging.Errorw(ctx, "Failed to cache NWS alerts", "error", err)
 	}
-	logging.Infow(ctx, "Fetched NWS zone alerts", "zones", s.config.Weather.NWS.Zones, "count", len(alerts))
+	logging.Infow(ctx, "Fetched NWS zone alerts", "zones", zones, "count", len(alerts))
 	return alerts
 }
 
@@ -61,6 +65,7 @@ func nwsAlertsToProto(alerts []nws.Alert) []*api.WeatherAlert {
 			Severity:    mapNWSSeverity(a.Severity),
 			Zones:       a.Zones,
 		}
+		wa.Category = weatherAlertCategory(wa)
 		if !a.Effective.IsZero() {
 			wa.StartTime = timestamppb.New(a.Effective)
 		}
@@ -72,6 +77,63 @@ func nwsAlertsToProto(alerts []nws.Alert) []*api.WeatherAlert {
 	return out
 }
 
+// getRoadWinterAlerts returns the active NWS winter products for the forecast
+// zones the monitored roads start and end in, which reach up to the passes
+// beyond the service-area zones. Cached like the zone alerts; when the zones
+// or their alerts can't be fetched the stale cache is served.
+func (s *WeatherService) getRoadWinterAlerts(ctx context.Context) []nws.Alert {
+	if s.nwsClient == nil || len(s.cfg().Roads.MonitoredRoads) == 0 {
+		return nil
+	}
+
+	cacheKey := "nws:winter_alerts"
+	var cached []nws.Alert
+	if _, found, _ := s.cache.GetWithMetadata(cacheKey, &cached); found && !s.cache.IsStale(cacheKey) {
+		return cached
+	}
+
+	zones, err := s.roadZones(ctx)
+	var alerts []nws.Alert
+	if err == nil {
+		alerts, err = s.nwsClient.GetWinterAlerts(ctx, zones)
+	}
+	if err != nil {
+		s.health.RecordError(SourceNWS, err)
+		logging.Errorw(ctx, "Failed to fetch NWS winter alerts for monitored roads", "error", err)
+		return cached
+	}
+	s.health.RecordSuccess(SourceNWS)
+
+	if err := s.cache.Set(cacheKey, alerts, s.cfg().Weather.RefreshInterval, "nws_alerts"); err != nil {
+		logging.Errorw(ctx, "Failed to cache NWS winter alerts", "error", err)
+	}
+	logging.Infow(ctx, "Fetched NWS winter alerts for monitored roads", "zones", zones, "count", len(alerts))
+	return alerts
+}
+
+// roadZones returns the forecast zones of the monitored roads' origins and
+// destinations. Points NWS can't place are skipped, but if none can be placed
+// the lookup failed: no zones would read as no alerts.
+func (s *WeatherService) roadZones(ctx context.Context) ([]string, error) {
+	var zones []string
+	var lastErr error
+	for _, road := range s.cfg().Roads.MonitoredRoads {
+		for _, point := range []config.Coordinates{road.Origin, road.Destination} {
+			zone, err := s.nwsClient.GetForecastZone(ctx, point.Latitude, point.Longitude)
+			if err != nil {
+				logging.Errorw(ctx, "Failed to find NWS forecast zone for road", "road_id", road.ID, "error", err)
+				lastErr = err
+				continue
+			}
+			zones = append(zones, zone)
+		}
+	}
+	if len(zones) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("no NWS forecast zone found for the monitored roads: %w", lastErr)
+	}
+	return zones, nil
+}
+
 func nwsAlertID(a nws.Alert) string {
 	if a.ID != "" {
 		return a.ID
@@ -83,7 +145,7 @@ func nwsAlertID(a nws.Alert) string {
 // area from the shared NWS alert list. Fire-weather products are regional, so a
 // single classification applies to every monitored location.
 func (s *WeatherService) computeRegionFireWeather(ctx context.Context) *api.FireWeather {
-	fw := nws.ClassifyFireWeather(s.getNWSAlerts(ctx), s.config.Weather.NWS.Zones)
+	fw := nws.ClassifyFireWeather(s.getNWSAlerts(ctx), s.cfg().Weather.NWS.Zones)
 	out := &api.FireWeather{
 		State:       mapFireWeatherState(fw.State),
 		SourceEvent: fw.SourceEvent,
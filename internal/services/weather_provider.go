@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// WeatherProvider supplies current conditions, alerts and forecasts for a
+// point in the API's weather model. *weather.Client (OpenWeatherMap)
+// implements it directly; nwsWeatherProvider adapts the NWS client.
+type WeatherProvider interface {
+	GetCurrentWeather(ctx context.Context, coordinates *api.Coordinates) (*api.WeatherData, error)
+	GetWeatherAlerts(ctx context.Context, coordinates *api.Coordinates) ([]*api.WeatherAlert, error)
+	GetForecast(ctx context.Context, coordinates *api.Coordinates, opts weather.ForecastOptions) (*api.WeatherForecast, error)
+}
+
+var _ WeatherProvider = (*weather.Client)(nil)
+
+// errNoWeatherProvider is returned when weather.provider and
+// weather.fallbackProvider name no usable provider
+var errNoWeatherProvider = errors.New("no weather provider available: OpenWeatherMap API key not configured")
+
+// weatherSource is a provider and the health source its fetches are recorded
+// under
+type weatherSource struct {
+	name     string
+	provider WeatherProvider
+}
+
+// newWeatherSources returns the configured providers in the order they are
+// tried. OpenWeatherMap is skipped without an API key, NWS without a client.
+func newWeatherSources(cfg *config.Config, weatherClient *weather.Client, nwsClient *nws.Client) []weatherSource {
+	if cfg == nil {
+		return nil
+	}
+	var sources []weatherSource
+	for _, name := range cfg.Weather.Providers() {
+		switch name {
+		case config.WeatherProviderOpenWeather:
+			if weatherClient != nil && cfg.OpenWeather.APIKey != "" {
+				sources = append(sources, weatherSource{name: SourceOpenWeather, provider: weatherClient})
+			}
+		case config.WeatherProviderNWS:
+			if nwsClient != nil {
+				sources = append(sources, weatherSource{name: SourceNWS, provider: nwsWeatherProvider{client: nwsClient}})
+			}
+		}
+	}
+	return sources
+}
+
+// fetchWeather calls fetch on each weather provider in turn until one
+// succeeds, recording every outcome under the provider's health source
+func fetchWeather[T any](ctx context.Context, s *WeatherService, fetch func(WeatherProvider) (T, error)) (T, error) {
+	var zero T
+	if len(s.providers) == 0 {
+		s.health.RecordError(SourceOpenWeather, errNoWeatherProvider)
+		return zero, errNoWeatherProvider
+	}
+
+	var errs []error
+	for i, source := range s.providers {
+		result, err := fetch(source.provider)
+		if err == nil {
+			s.health.RecordSuccess(source.name)
+			return result, nil
+		}
+		s.health.RecordError(source.name, err)
+		errs = append(errs, fmt.Errorf("%s: %w", source.name, err))
+		if i+1 < len(s.providers) {
+			logging.Warnw(ctx, "Weather provider failed, trying fallback",
+				"provider", source.name, "fallback", s.providers[i+1].name, "error", err)
+		}
+	}
+	return zero, errors.Join(errs...)
+}
+
+// nwsHourlyPeriods matches the 48 hours of OpenWeatherMap's hourly forecast;
+// NWS forecasts a week of hours
+const nwsHourlyPeriods = 48
+
+// nwsWeatherProvider adapts the NWS client to WeatherProvider. Conditions come
+// from the observation station nearest the point. NWS has no icon codes, so
+// WeatherIcon is left empty and WeatherMain is derived from the description.
+type nwsWeatherProvider struct {
+	client *nws.Client
+}
+
+// GetCurrentWeather returns the latest station observation in metric units
+func (p nwsWeatherProvider) GetCurrentWeather(ctx context.Context, coordinates *api.Coordinates) (*api.WeatherData, error) {
+	obs, err := p.client.GetLatestObservation(ctx, coordinates.Latitude, coordinates.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	if obs.TemperatureC == nil {
+		return nil, fmt.Errorf("NWS station %s reported no temperature", obs.StationID)
+	}
+
+	temperature := *obs.TemperatureC
+	feelsLike := temperature
+	switch {
+	case obs.WindChillC != nil:
+		feelsLike = *obs.WindChillC
+	case obs.HeatIndexC != nil:
+		feelsLike = *obs.HeatIndexC
+	}
+	windKmh := valueOrZero(obs.WindSpeedKmh)
+
+	return &api.WeatherData{
+		LocationName:         obs.City,
+		WeatherMain:          weatherMain(obs.Description),
+		WeatherDescription:   strings.ToLower(obs.Description),
+		TemperatureCelsius:   int32(temperature),
+		FeelsLikeCelsius:     int32(feelsLike),
+		HumidityPercent:      int32(math.Round(valueOrZero(obs.HumidityPercent))),
+		WindSpeedKmh:         int32(windKmh),
+		WindDirectionDegrees: int32(valueOrZero(obs.WindDirectionDeg)),
+		VisibilityKm:         int32(valueOrZero(obs.VisibilityMeters) / 1000),
+		Units:                string(weather.UnitsMetric),
+		Temperature:          float32(temperature),
+		FeelsLike:            float32(feelsLike),
+		WindSpeed:            float32(windKmh / 3.6), // m/s, as OpenWeatherMap reports metric
+		WindGustKmh:          roundedOrNil(obs.WindGustKmh),
+		DewPointCelsius:      roundedOrNil(obs.DewpointC),
+	}, nil
+}
+
+// GetWeatherAlerts returns the active NWS alerts covering the point
+func (p nwsWeatherProvider) GetWeatherAlerts(ctx context.Context, coordinates *api.Coordinates) ([]*api.WeatherAlert, error) {
+	alerts, err := p.client.GetPointAlerts(ctx, coordinates.Latitude, coordinates.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	return nwsAlertsToProto(alerts), nil
+}
+
+// GetForecast returns the hourly and/or daily forecast for the point
+func (p nwsWeatherProvider) GetForecast(ctx context.Context, coordinates *api.Coordinates, opts weather.ForecastOptions) (*api.WeatherForecast, error) {
+	if !opts.Hourly && !opts.Daily {
+		opts.Hourly, opts.Daily = true, true
+	}
+
+	forecast := &api.WeatherForecast{}
+	if opts.Hourly {
+		periods, err := p.client.GetForecast(ctx, coordinates.Latitude, coordinates.Longitude, true)
+		if err != nil {
+			return nil, err
+		}
+		if len(periods) > nwsHourlyPeriods {
+			periods = periods[:nwsHourlyPeriods]
+		}
+		for _, period := range periods {
+			forecast.Hourly = append(forecast.Hourly, nwsForecastPeriod(period))
+		}
+	}
+	if opts.Daily {
+		periods, err := p.client.GetForecast(ctx, coordinates.Latitude, coordinates.Longitude, false)
+		if err != nil {
+			return nil, err
+		}
+		forecast.Daily = nwsDailyPeriods(periods)
+	}
+	return forecast, nil
+}
+
+// nwsForecastPeriod converts one NWS forecast period
+func nwsForecastPeriod(p nws.ForecastPeriod) *api.ForecastPeriod {
+	return &api.ForecastPeriod{
+		Time:                            timestamppb.New(p.Start),
+		TemperatureCelsius:              int32(p.TemperatureC),
+		PrecipitationProbabilityPercent: p.PrecipitationPercent,
+		WindSpeedKmh:                    p.WindSpeedKmh,
+		WindDirectionDegrees:            compassDegrees(p.WindDirection),
+		WeatherMain:                     weatherMain(p.ShortForecast),
+		WeatherDescription:              strings.ToLower(p.ShortForecast),
+	}
+}
+
+// nwsDailyPeriods folds NWS's 12-hour day and night periods into days: the
+// daytime temperature is the day's high and the following night's its low. A
+// leading night (the forecast was fetched after dark) is a day of its own.
+func nwsDailyPeriods(periods []nws.ForecastPeriod) []*api.ForecastPeriod {
+	var days []*api.ForecastPeriod
+	for i := 0; i < len(periods); i++ {
+		p := periods[i]
+		day := nwsForecastPeriod(p)
+		day.TemperatureMaxCelsius = day.TemperatureCelsius
+		day.TemperatureMinCelsius = day.TemperatureCelsius
+		day.Summary = p.DetailedForecast
+
+		if p.IsDaytime && i+1 < len(periods) && !periods[i+1].IsDaytime {
+			night := periods[i+1]
+			day.TemperatureMinCelsius = int32(night.TemperatureC)
+			day.PrecipitationProbabilityPercent = max(day.PrecipitationProbabilityPercent, night.PrecipitationPercent)
+			i++
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// weatherMain maps an NWS description such as "Chance Rain And Snow" onto
+// OpenWeatherMap's condition groups ("Snow"), so clients can treat both
+// providers alike. Unrecognized descriptions are passed through.
+func weatherMain(description string) string {
+	text := strings.ToLower(description)
+	for _, group := range []struct {
+		main     string
+		keywords []string
+	}{
+		{"Thunderstorm", []string{"thunder"}},
+		{"Snow", []string{"snow", "sleet", "flurr", "blizzard"}},
+		{"Drizzle", []string{"drizzle"}},
+		{"Rain", []string{"rain", "shower"}},
+		{"Fog", []string{"fog"}},
+		{"Smoke", []string{"smoke"}},
+		{"Haze", []string{"haze"}},
+		{"Mist", []string{"mist"}},
+		{"Dust", []string{"dust"}},
+		{"Clouds", []string{"cloud", "overcast"}},
+		{"Clear", []string{"clear", "sunny", "fair"}},
+	} {
+		for _, keyword := range group.keywords {
+			if strings.Contains(text, keyword) {
+				return group.main
+			}
+		}
+	}
+	return description
+}
+
+// compassPoints are the 16 compass points NWS reports wind direction in,
+// clockwise from north
+var compassPoints = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+// compassDegrees converts a compass point to degrees, or 0 when unknown
+func compassDegrees(point string) int32 {
+	for i, p := range compassPoints {
+		if p == point {
+			return int32(math.Round(float64(i) * 22.5))
+		}
+	}
+	return 0
+}
+
+func valueOrZero(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// roundedOrNil rounds a reading that may not have been reported
+func roundedOrNil(v *float64) *int32 {
+	if v == nil {
+		return nil
+	}
+	return proto.Int32(int32(math.Round(*v)))
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/cache"
+	"github.com/dpup/info.ersn.net/server/internal/clients/nws"
+	"github.com/dpup/info.ersn.net/server/internal/clients/weather"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+// nwsAPI answers api.weather.gov requests for a point near Murphys with
+// canned responses, by path
+type nwsAPI struct{}
+
+var nwsResponses = map[string]string{
+	"/points/38.1377,-120.4627": `{"properties": {
+		"forecast": "https://api.weather.gov/gridpoints/STO/80,43/forecast",
+		"forecastHourly": "https://api.weather.gov/gridpoints/STO/80,43/forecast/hourly",
+		"observationStations": "https://api.weather.gov/gridpoints/STO/80,43/stations",
+		"forecastZone": "https://api.weather.gov/zones/forecast/CAZ069",
+		"relativeLocation": {"properties": {"city": "Murphys", "state": "CA"}}}}`,
+	"/gridpoints/STO/80,43/stations": `{"observationStations": [
+		"https://api.weather.gov/stations/KCPU", "https://api.weather.gov/stations/KO22"]}`,
+	"/stations/KCPU/observations/latest": `{"properties": {
+		"timestamp": "2025-12-24T17:55:00+00:00",
+		"textDescription": "Light Snow",
+		"temperature": {"unitCode": "wmoUnit:degC", "value": -1.7},
+		"dewpoint": {"unitCode": "wmoUnit:degC", "value": -2.6},
+		"windChill": {"unitCode": "wmoUnit:degC", "value": -6.2},
+		"heatIndex": {"unitCode": "wmoUnit:degC", "value": null},
+		"relativeHumidity": {"unitCode": "wmoUnit:percent", "value": 92.6},
+		"windSpeed": {"unitCode": "wmoUnit:km_h-1", "value": 18},
+		"windGust": {"unitCode": "wmoUnit:km_h-1", "value": null},
+		"windDirection": {"unitCode": "wmoUnit:degree_(angle)", "value": 230},
+		"visibility": {"unitCode": "wmoUnit:m", "value": 4020}}}`,
+	"/gridpoints/STO/80,43/forecast": `{"properties": {"periods": [
+		{"name": "Today", "startTime": "2025-12-24T10:00:00-08:00", "endTime": "2025-12-24T18:00:00-08:00",
+		 "isDaytime": true, "temperature": 3, "temperatureUnit": "C",
+		 "probabilityOfPrecipitation": {"value": 60}, "windSpeed": "10 to 20 km/h", "windDirection": "SW",
+		 "shortForecast": "Rain And Snow", "detailedForecast": "Rain and snow. High near 3."},
+		{"name": "Tonight", "startTime": "2025-12-24T18:00:00-08:00", "endTime": "2025-12-25T06:00:00-08:00",
+		 "isDaytime": false, "temperature": -4, "temperatureUnit": "C",
+		 "probabilityOfPrecipitation": {"value": 80}, "windSpeed": "15 km/h", "windDirection": "W",
+		 "shortForecast": "Snow", "detailedForecast": "Snow. Low around -4."},
+		{"name": "Christmas Day", "startTime": "2025-12-25T06:00:00-08:00", "endTime": "2025-12-25T18:00:00-08:00",
+		 "isDaytime": true, "temperature": 41, "temperatureUnit": "F",
+		 "probabilityOfPrecipitation": {"value": null}, "windSpeed": "5 mph", "windDirection": "N",
+		 "shortForecast": "Mostly Sunny", "detailedForecast": "Mostly sunny, with a high near 41."}]}}`,
+	"/gridpoints/STO/80,43/forecast/hourly": `{"properties": {"periods": [
+		{"startTime": "2025-12-24T10:00:00-08:00", "isDaytime": true, "temperature": 1, "temperatureUnit": "C",
+		 "probabilityOfPrecipitation": {"value": 55}, "windSpeed": "13 km/h", "windDirection": "SSW",
+		 "shortForecast": "Chance Rain And Snow"}]}}`,
+	"/alerts/active": `{"features": [{"properties": {
+		"id": "urn:oid:2.49.0.1.840.0.wsw", "event": "Winter Storm Warning", "severity": "Severe",
+		"headline": "Winter Storm Warning until 4 AM", "description": "Heavy snow above 3000 feet.",
+		"senderName": "NWS Sacramento CA", "effective": "2025-12-24T03:00:00-08:00",
+		"expires": "2025-12-26T04:00:00-08:00", "geocode": {"UGC": ["CAZ069"]}}}]}`,
+}
+
+func (nwsAPI) Do(req *http.Request) (*http.Response, error) {
+	body, ok := nwsResponses[req.URL.Path]
+	status := http.StatusOK
+	if !ok {
+		status, body = http.StatusNotFound, `{"title": "Not Found"}`
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+}
+
+var murphys = &api.Coordinates{Latitude: 38.1377, Longitude: -120.4627}
+
+func TestNWSWeatherProvider_MapsToWeatherModel(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	provider := nwsWeatherProvider{client: nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", nwsAPI{})}
+
+	current, err := provider.GetCurrentWeather(ctx, murphys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &api.WeatherData{
+		LocationName:         "Murphys",
+		WeatherMain:          "Snow",
+		WeatherDescription:   "light snow",
+		TemperatureCelsius:   -1,
+		FeelsLikeCelsius:     -6,
+		HumidityPercent:      93,
+		WindSpeedKmh:         18,
+		WindDirectionDegrees: 230,
+		VisibilityKm:         4,
+		Units:                "metric",
+		Temperature:          -1.7,
+		FeelsLike:            -6.2,
+		WindSpeed:            5,
+		DewPointCelsius:      proto.Int32(-3),
+	}
+	if !proto.Equal(current, want) {
+		t.Errorf("current weather =\n%v\nwant\n%v", current, want)
+	}
+
+	forecast, err := provider.GetForecast(ctx, murphys, weather.ForecastOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(forecast.Hourly) != 1 {
+		t.Fatalf("got %d hourly periods, want 1", len(forecast.Hourly))
+	}
+	if h := forecast.Hourly[0]; h.TemperatureCelsius != 1 || h.PrecipitationProbabilityPercent != 55 ||
+		h.WindSpeedKmh != 13 || h.WindDirectionDegrees != 203 || h.WeatherMain != "Snow" {
+		t.Errorf("hourly period = %v", h)
+	}
+
+	// Today and Tonight fold into one day; Christmas Day has no night yet
+	if len(forecast.Daily) != 2 {
+		t.Fatalf("got %d daily periods, want 2", len(forecast.Daily))
+	}
+	today := forecast.Daily[0]
+	if today.TemperatureMaxCelsius != 3 || today.TemperatureMinCelsius != -4 ||
+		today.PrecipitationProbabilityPercent != 80 || today.WindSpeedKmh != 20 || today.WindDirectionDegrees != 225 ||
+		today.Summary != "Rain and snow. High near 3." {
+		t.Errorf("today = %v", today)
+	}
+	if !today.Time.AsTime().Equal(time.Date(2025, 12, 24, 18, 0, 0, 0, time.UTC)) {
+		t.Errorf("today starts %v, want 10:00 PST", today.Time.AsTime())
+	}
+	christmas := forecast.Daily[1]
+	if christmas.TemperatureMaxCelsius != 5 || christmas.WindSpeedKmh != 8 || christmas.WeatherMain != "Clear" {
+		t.Errorf("christmas = %v, want 41°F as 5°C, 5 mph as 8 km/h, Clear", christmas)
+	}
+
+	alerts, err := provider.GetWeatherAlerts(ctx, murphys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Source != api.AlertSource_NWS || alerts[0].Severity != api.AlertSeverity_CRITICAL ||
+		alerts[0].Id != "urn:oid:2.49.0.1.840.0.wsw" {
+		t.Errorf("alerts = %v, want the Winter Storm Warning from NWS", alerts)
+	}
+}
+
+// downDoer fails every request with a 503
+type downDoer struct{}
+
+func (downDoer) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("down")), Header: make(http.Header)}, nil
+}
+
+func TestProcessWeatherLocation_FallsBackToNWS(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.OpenWeather.APIKey = "test-key"
+	cfg.Weather.FallbackProvider = config.WeatherProviderNWS
+	location := config.WeatherLocation{ID: "murphys", Name: "Murphys", Coordinates: config.Coordinates{Latitude: murphys.Latitude, Longitude: murphys.Longitude}}
+
+	health := NewSourceHealth(nil)
+	s := NewWeatherService(
+		weather.NewClientWithHTTPDoer("test-key", "https://owm.test", downDoer{}),
+		nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", nwsAPI{}),
+		cache.NewCache(), cfg, nil, health)
+
+	data, err := s.processWeatherLocation(ctx, location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.LocationId != "murphys" || data.WeatherMain != "Snow" || data.TemperatureCelsius != -1 {
+		t.Errorf("weather = %v, want NWS conditions for murphys", data)
+	}
+	if len(data.Alerts) != 1 || data.Alerts[0].Source != api.AlertSource_NWS {
+		t.Errorf("alerts = %v, want the NWS alert", data.Alerts)
+	}
+	if !health.status(SourceOpenWeather).Failing || health.status(SourceNWS).Failing {
+		t.Errorf("health: openweather %+v, nws %+v; want openweather failing, nws healthy",
+			health.status(SourceOpenWeather), health.status(SourceNWS))
+	}
+
+	// Without a fallback the OpenWeatherMap failure is returned
+	cfg.Weather.FallbackProvider = ""
+	s = NewWeatherService(weather.NewClientWithHTTPDoer("test-key", "https://owm.test", downDoer{}), nil, cache.NewCache(), cfg, nil, nil)
+	if _, err := s.processWeatherLocation(ctx, location); err == nil {
+		t.Error("expected an error with OpenWeatherMap down and no fallback")
+	}
+}
+
+func TestRefreshWeatherAlerts_RoadWinterAlerts(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{{
+		ID:          "hwy4",
+		Origin:      config.Coordinates{Latitude: murphys.Latitude, Longitude: murphys.Longitude},
+		Destination: config.Coordinates{Latitude: murphys.Latitude, Longitude: murphys.Longitude},
+	}}
+
+	s := NewWeatherService(nil, nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", nwsAPI{}), cache.NewCache(), cfg, nil, nil)
+	alerts, err := s.refreshWeatherAlerts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("got %d alerts, want the Winter Storm Warning for the road's zone: %v", len(alerts), alerts)
+	}
+	if alerts[0].Category != api.WeatherAlertCategory_WINTER || alerts[0].Zones[0] != "CAZ069" {
+		t.Errorf("alert = %v, want a WINTER alert for CAZ069", alerts[0])
+	}
+
+	// Reloaded roads replace the old ones and their cached alerts
+	s.ApplyMonitoredRoads(nil)
+	alerts, err = s.refreshWeatherAlerts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("got %d alerts after the roads were removed, want none", len(alerts))
+	}
+}
+
+func TestGetRoadWinterAlerts_ZoneLookupFails(t *testing.T) {
+	ctx := logging.EnsureLogger(context.Background())
+	cfg := &config.Config{}
+	cfg.Weather.RefreshInterval = time.Millisecond
+	cfg.Roads.MonitoredRoads = []config.MonitoredRoad{{
+		ID:          "hwy4",
+		Origin:      config.Coordinates{Latitude: murphys.Latitude, Longitude: murphys.Longitude},
+		Destination: config.Coordinates{Latitude: murphys.Latitude, Longitude: murphys.Longitude},
+	}}
+	health := NewSourceHealth(nil)
+	s := NewWeatherService(nil, nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", nwsAPI{}), cache.NewCache(), cfg, nil, health)
+	if got := s.getRoadWinterAlerts(ctx); len(got) != 1 {
+		t.Fatalf("got %d winter alerts, want the Winter Storm Warning", len(got))
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	// NWS goes down: no zone can be found, which isn't "no alerts"
+	s.nwsClient = nws.NewClientWithHTTPDoer("test", "https://api.weather.gov", downDoer{})
+	if got := s.getRoadWinterAlerts(ctx); len(got) != 1 {
+		t.Errorf("got %d winter alerts during the outage, want the stale Winter Storm Warning", len(got))
+	}
+	if !health.status(SourceNWS).Failing {
+		t.Errorf("NWS health = %+v, want failing", health.status(SourceNWS))
+	}
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/dpup/prefab/errors"
+	"github.com/dpup/prefab/logging"
+
+	"github.com/dpup/info.ersn.net/server/internal/lib/requestid"
+)
+
+// WeatherRefreshService keeps weather data warm the way PeriodicRefreshService
+// does for roads, so the first request after a quiet period doesn't pay for
+// OpenWeatherMap + NWS round trips
+type WeatherRefreshService struct {
+	weatherService *WeatherService
+
+	// Background refresh control: stopChan is closed to stop the running
+	// loop, and nil when none is running; cancel cancels the loop's context,
+	// and with it a refresh in progress; done is closed once the loop has
+	// exited
+	mutex    sync.Mutex
+	stopChan chan struct{}
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewWeatherRefreshService creates a new weather refresh service
+func NewWeatherRefreshService(weatherService *WeatherService) *WeatherRefreshService {
+	return &WeatherRefreshService{weatherService: weatherService}
+}
+
+// StartPeriodicRefresh begins refreshing all configured weather locations on
+// the weather refresh interval in effect when it starts
+func (w *WeatherRefreshService) StartPeriodicRefresh(ctx context.Context) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.stopChan != nil {
+		return nil // Already running
+	}
+
+	stop, done := make(chan struct{}), make(chan struct{})
+	loopCtx, cancel := context.WithCancel(ctx)
+	w.stopChan, w.cancel, w.done = stop, cancel, done
+
+	// Use weather refresh interval from config (default 5 minutes)
+	interval := w.weatherService.cfg().Weather.RefreshInterval
+
+	logging.Infow(ctx, "Starting periodic weather refresh", "interval", interval)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+		defer func() {
+			// Recover from any panics in the weather refresh goroutine
+			if r := recover(); r != nil {
+				err, _ := errors.ParseStack(debug.Stack())
+				skipFrames := 3
+				numFrames := 5
+				logging.Errorw(ctx, "Weather refresh: recovered from panic",
+					"error", r, "error.stack_trace", err.MinimalStack(skipFrames, numFrames))
+			}
+			w.mutex.Lock()
+			if w.stopChan == stop {
+				w.stopChan = nil
+			}
+			w.mutex.Unlock()
+		}()
+
+		w.refreshLoop(loopCtx, interval, stop)
+	}()
+
+	return nil
+}
+
+// Stop gracefully stops the weather refresh. A refresh in progress is cancelled,
+// caching nothing, and Stop returns once the loop has exited.
+func (w *WeatherRefreshService) Stop() {
+	w.mutex.Lock()
+	if w.stopChan == nil {
+		w.mutex.Unlock()
+		return
+	}
+
+	// The loop logs its own shutdown; there is no logger-bearing context here.
+	close(w.stopChan)
+	w.cancel()
+	w.stopChan = nil
+	done := w.done
+	w.mutex.Unlock()
+
+	// The loop's exit takes the mutex, so wait without holding it
+	<-done
+}
+
+// Restart stops the weather refresh, cancelling a refresh in progress, and
+// starts it again on the current refresh interval, refreshing immediately
+func (w *WeatherRefreshService) Restart(ctx context.Context) error {
+	w.Stop()
+	return w.StartPeriodicRefresh(ctx)
+}
+
+// refreshLoop runs the weather refresh in background until stop is closed
+func (w *WeatherRefreshService) refreshLoop(ctx context.Context, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Do initial refresh immediately
+	w.refreshCacheData(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logging.Info(ctx, "Weather refresh stopping due to context cancellation")
+			return
+		case <-stop:
+			logging.Info(ctx, "Weather refresh stopping due to stop signal")
+			return
+		case <-ticker.C:
+			w.refreshCacheData(ctx)
+		}
+	}
+}
+
+// refreshCacheData refreshes current conditions and alerts for every
+// configured location. Upstream calls go through the per-location cache, so a
+// tick shorter than weather.locationCacheTTL doesn't add OpenWeatherMap load.
+func (w *WeatherRefreshService) refreshCacheData(ctx context.Context) {
+	// Correlate this refresh's log lines the way a request's are
+	ctx = requestid.With(ctx, requestid.New())
+	logging.Info(ctx, "Weather refresh: starting data refresh")
+
+	refreshCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	cfg := w.weatherService.cfg()
+	weatherData, err := w.weatherService.refreshWeatherData(refreshCtx)
+	if ctx.Err() != nil {
+		logging.Info(ctx, "Weather refresh: stopped during refresh, discarding weather")
+		return
+	}
+	if err != nil {
+		logging.Errorw(ctx, "Weather refresh: failed to refresh weather data", "error", err)
+	} else if w.weatherService.cfg() != cfg {
+		// The restarted refresh caches the reloaded locations
+		logging.Info(ctx, "Weather refresh: configuration reloaded during refresh, discarding weather")
+		return
+	} else if err := w.weatherService.cacheWeather(weatherData); err != nil {
+		logging.Errorw(ctx, "Weather refresh: failed to cache weather data", "error", err)
+	} else {
+		logging.Infow(ctx, "Weather refresh: successfully cached weather", "location_count", len(weatherData))
+	}
+
+	alerts, err := w.weatherService.refreshWeatherAlerts(refreshCtx)
+	if ctx.Err() != nil {
+		logging.Info(ctx, "Weather refresh: stopped during refresh, discarding alerts")
+		return
+	}
+	if err != nil {
+		logging.Errorw(ctx, "Weather refresh: failed to refresh weather alerts", "error", err)
+		return
+	}
+	if err := w.weatherService.cacheWeatherAlerts(alerts); err != nil {
+		logging.Errorw(ctx, "Weather refresh: failed to cache weather alerts", "error", err)
+	}
+}
+
+// IsRunning returns whether weather refresh is active
+func (w *WeatherRefreshService) IsRunning() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.stopChan != nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpup/prefab/logging"
+
+	api "github.com/dpup/info.ersn.net/server/api/v1"
+	"github.com/dpup/info.ersn.net/server/internal/config"
+)
+
+func TestWeatherRefreshService_PopulatesCache(t *testing.T) {
+	doer := &countingDoer{calls: map[string]int{}}
+	s := newCachedWeatherService(doer, 10*time.Minute)
+	s.config.Weather.RefreshInterval = time.Hour // only the immediate first tick runs
+	s.config.Weather.Locations = append(s.config.Weather.Locations, config.WeatherLocation{
+		ID: "arnold", Name: "Arnold", Coordinates: config.Coordinates{Latitude: 38.2555, Longitude: -120.3516},
+	})
+
+	ctx, cancel := context.WithCancel(logging.EnsureLogger(context.Background()))
+	defer cancel()
+
+	refresher := NewWeatherRefreshService(s)
+	if err := refresher.StartPeriodicRefresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait for the first tick to cache the combined list
+	deadline := time.Now().Add(2 * time.Second)
+	for s.cache.IsStale("weather:all") && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	var cached []*api.WeatherData
+	if found, err := s.cache.Get("weather:all", &cached); err != nil || !found {
+		t.Fatalf("weather:all not cached after first tick (found=%v, err=%v)", found, err)
+	}
+	if len(cached) != 2 {
+		t.Fatalf("cached %d locations, want 2", len(cached))
+	}
+	for _, location := range s.config.Weather.Locations {
+		if s.cache.IsStale(locationCacheKey("current", location.ToProto())) {
+			t.Errorf("per-location cache missing for %s", location.ID)
+		}
+	}
+
+	// Requests are now served without another upstream call
+	if _, err := s.ListWeather(ctx, &api.ListWeatherRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := doer.count("/data/2.5/weather"); got != 2 {
+		t.Errorf("current weather requests = %d, want 2 (one per location)", got)
+	}
+
+	refresher.Stop()
+	if refresher.IsRunning() {
+		t.Error("refresher should not be running after Stop")
+	}
+	refresher.Stop() // idempotent
+}